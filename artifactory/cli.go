@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"github.com/jfrog/jfrog-cli/utils/accesstoken"
+	"io"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jfrog/gofrog/version"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/transferinstall"
@@ -39,18 +41,50 @@ import (
 	corecommon "github.com/jfrog/jfrog-cli-core/v2/docs/common"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/accessconfig"
+	aqlcommand "github.com/jfrog/jfrog-cli/artifactory/commands/aql"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builddiff"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builddiscard"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildenv"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildexport"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildgates"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildpromoteset"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildprovenance"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildqueue"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildsbom"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/replications"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/repoconfig"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/trash"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/usersgroups"
 	"github.com/jfrog/jfrog-cli/buildtools"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/accessapply"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/accessexport"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/accesstokencreate"
+	aqldocs "github.com/jfrog/jfrog-cli/docs/artifactory/aql"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildadddependencies"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildaddgit"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildappend"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildclean"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildcollectenv"
-	"github.com/jfrog/jfrog-cli/docs/artifactory/builddiscard"
+	builddiffdoc "github.com/jfrog/jfrog-cli/docs/artifactory/builddiff"
+	builddiscarddoc "github.com/jfrog/jfrog-cli/docs/artifactory/builddiscard"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/builddockercreate"
+	buildexportdoc "github.com/jfrog/jfrog-cli/docs/artifactory/buildexport"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/buildimport"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/buildlist"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildpromote"
+	buildpromotesetdoc "github.com/jfrog/jfrog-cli/docs/artifactory/buildpromoteset"
+	buildprovenancedoc "github.com/jfrog/jfrog-cli/docs/artifactory/buildprovenance"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/buildprovenanceverify"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildpublish"
+	buildqueuedoc "github.com/jfrog/jfrog-cli/docs/artifactory/buildqueue"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/buildqueuedrop"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/buildqueueflush"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/buildqueuelist"
+	buildsbomdoc "github.com/jfrog/jfrog-cli/docs/artifactory/buildsbom"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/buildscan"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/buildshow"
 	copydocs "github.com/jfrog/jfrog-cli/docs/artifactory/copy"
 	curldocs "github.com/jfrog/jfrog-cli/docs/artifactory/curl"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/delete"
@@ -70,6 +104,8 @@ import (
 	"github.com/jfrog/jfrog-cli/docs/artifactory/groupaddusers"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/groupcreate"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/groupdelete"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/groupremoveusers"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/groupslist"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/move"
 	mvndoc "github.com/jfrog/jfrog-cli/docs/artifactory/mvn"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/mvnconfig"
@@ -92,24 +128,34 @@ import (
 	"github.com/jfrog/jfrog-cli/docs/artifactory/podmanpush"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/replicationcreate"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/replicationdelete"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/replicationlist"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/replicationrun"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/replicationstatus"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/replicationtemplate"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/repoapply"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/repocreate"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/repodelete"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/repoexport"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/repotemplate"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/repoupdate"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/restore"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/search"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/setprops"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/transferconfig"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/transferconfigmerge"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/transferfiles"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/transfersettings"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/trashlist"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/upload"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/usercreate"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/userscreate"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/usersdelete"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/usersexport"
+	"github.com/jfrog/jfrog-cli/docs/artifactory/userslist"
 	yarndocs "github.com/jfrog/jfrog-cli/docs/artifactory/yarn"
 	"github.com/jfrog/jfrog-cli/docs/artifactory/yarnconfig"
 	"github.com/jfrog/jfrog-cli/docs/common"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
 	"github.com/jfrog/jfrog-cli/utils/cliutils"
 	buildinfocmd "github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -177,6 +223,26 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       deleteCmd,
 		},
+		{
+			Name:         "restore",
+			Flags:        cliutils.GetCommandFlags(cliutils.Restore),
+			Usage:        restore.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt restore", restore.GetDescription(), restore.Usage),
+			UsageText:    restore.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(restore.EnvVar),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       restoreCmd,
+		},
+		{
+			Name:         "trash-list",
+			Flags:        cliutils.GetCommandFlags(cliutils.TrashList),
+			Usage:        trashlist.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt trash-list", trashlist.GetDescription(), trashlist.Usage),
+			UsageText:    trashlist.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       trashListCmd,
+		},
 		{
 			Name:         "search",
 			Flags:        cliutils.GetCommandFlags(cliutils.Search),
@@ -188,6 +254,16 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       searchCmd,
 		},
+		{
+			Name:         "aql",
+			Flags:        cliutils.GetCommandFlags(cliutils.Aql),
+			Usage:        aqldocs.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt aql", aqldocs.GetDescription(), aqldocs.Usage),
+			UsageText:    aqldocs.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(aqldocs.EnvVar),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       aqlCmd,
+		},
 		{
 			Name:         "set-props",
 			Flags:        cliutils.GetCommandFlags(cliutils.Properties),
@@ -228,7 +304,7 @@ func GetCommands() []cli.Command {
 			Usage:        buildcollectenv.GetDescription(),
 			HelpName:     corecommon.CreateUsage("rt build-collect-env", buildcollectenv.GetDescription(), buildcollectenv.Usage),
 			UsageText:    buildcollectenv.GetArguments(),
-			ArgsUsage:    common.CreateEnvVars(),
+			ArgsUsage:    common.CreateEnvVars(common.JfrogCliContainerImage),
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       buildCollectEnvCmd,
 		},
@@ -300,17 +376,153 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       buildPromoteCmd,
 		},
+		{
+			Name:         "build-promote-set",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildPromoteSet),
+			Aliases:      []string{"bps"},
+			Usage:        buildpromotesetdoc.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-promote-set", buildpromotesetdoc.GetDescription(), buildpromotesetdoc.Usage),
+			UsageText:    buildpromotesetdoc.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildPromoteSetCmd,
+		},
 		{
 			Name:         "build-discard",
 			Flags:        cliutils.GetCommandFlags(cliutils.BuildDiscard),
 			Aliases:      []string{"bdi"},
-			Usage:        builddiscard.GetDescription(),
-			HelpName:     corecommon.CreateUsage("rt build-discard", builddiscard.GetDescription(), builddiscard.Usage),
-			UsageText:    builddiscard.GetArguments(),
+			Usage:        builddiscarddoc.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-discard", builddiscarddoc.GetDescription(), builddiscarddoc.Usage),
+			UsageText:    builddiscarddoc.GetArguments(),
 			ArgsUsage:    common.CreateEnvVars(),
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       buildDiscardCmd,
 		},
+		{
+			Name:         "build-diff",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildDiff),
+			Aliases:      []string{"bdiff"},
+			Usage:        builddiffdoc.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-diff", builddiffdoc.GetDescription(), builddiffdoc.Usage),
+			UsageText:    builddiffdoc.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildDiffCmd,
+		},
+		{
+			Name:         "build-show",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildShow),
+			Aliases:      []string{"bsh"},
+			Usage:        buildshow.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-show", buildshow.GetDescription(), buildshow.Usage),
+			UsageText:    buildshow.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildShowCmd,
+		},
+		{
+			Name:         "build-list",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildList),
+			Aliases:      []string{"bls"},
+			Usage:        buildlist.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-list", buildlist.GetDescription(), buildlist.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildListCmd,
+		},
+		{
+			Name:         "build-sbom",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildSbom),
+			Aliases:      []string{"bsbom"},
+			Usage:        buildsbomdoc.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-sbom", buildsbomdoc.GetDescription(), buildsbomdoc.Usage),
+			UsageText:    buildsbomdoc.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildSbomCmd,
+		},
+		{
+			Name:         "build-provenance",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildProvenance),
+			Aliases:      []string{"bprov"},
+			Usage:        buildprovenancedoc.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-provenance", buildprovenancedoc.GetDescription(), buildprovenancedoc.Usage),
+			UsageText:    buildprovenancedoc.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(common.JfrogCliProvenanceSigningKey),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildProvenanceCmd,
+			Subcommands: []cli.Command{
+				{
+					Name:         "verify",
+					Flags:        cliutils.GetCommandFlags(cliutils.BuildProvenanceVerify),
+					Usage:        buildprovenanceverify.GetDescription(),
+					HelpName:     corecommon.CreateUsage("rt build-provenance verify", buildprovenanceverify.GetDescription(), buildprovenanceverify.Usage),
+					UsageText:    buildprovenanceverify.GetArguments(),
+					ArgsUsage:    common.CreateEnvVars(),
+					BashComplete: corecommon.CreateBashCompletionFunc(),
+					Action:       buildProvenanceVerifyCmd,
+				},
+			},
+		},
+		{
+			Name:         "build-export",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildExport),
+			Aliases:      []string{"bexp"},
+			Usage:        buildexportdoc.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-export", buildexportdoc.GetDescription(), buildexportdoc.Usage),
+			UsageText:    buildexportdoc.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildExportCmd,
+		},
+		{
+			Name:         "build-import",
+			Flags:        cliutils.GetCommandFlags(cliutils.BuildImport),
+			Aliases:      []string{"bimp"},
+			Usage:        buildimport.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt build-import", buildimport.GetDescription(), buildimport.Usage),
+			UsageText:    buildimport.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       buildImportCmd,
+		},
+		{
+			Name:     "build-queue",
+			Aliases:  []string{"bq"},
+			Usage:    buildqueuedoc.GetDescription(),
+			HelpName: corecommon.CreateUsage("rt build-queue", buildqueuedoc.GetDescription(), buildqueuedoc.Usage),
+			Subcommands: []cli.Command{
+				{
+					Name:         "list",
+					Flags:        cliutils.GetCommandFlags(cliutils.BuildQueueList),
+					Usage:        buildqueuelist.GetDescription(),
+					HelpName:     corecommon.CreateUsage("rt build-queue list", buildqueuelist.GetDescription(), buildqueuelist.Usage),
+					ArgsUsage:    common.CreateEnvVars(),
+					BashComplete: corecommon.CreateBashCompletionFunc(),
+					Action:       buildQueueListCmd,
+				},
+				{
+					Name:         "flush",
+					Flags:        cliutils.GetCommandFlags(cliutils.BuildQueueFlush),
+					Usage:        buildqueueflush.GetDescription(),
+					HelpName:     corecommon.CreateUsage("rt build-queue flush", buildqueueflush.GetDescription(), buildqueueflush.Usage),
+					UsageText:    buildqueueflush.GetArguments(),
+					ArgsUsage:    common.CreateEnvVars(),
+					BashComplete: corecommon.CreateBashCompletionFunc(),
+					Action:       buildQueueFlushCmd,
+				},
+				{
+					Name:         "drop",
+					Flags:        cliutils.GetCommandFlags(cliutils.BuildQueueDrop),
+					Usage:        buildqueuedrop.GetDescription(),
+					HelpName:     corecommon.CreateUsage("rt build-queue drop", buildqueuedrop.GetDescription(), buildqueuedrop.Usage),
+					UsageText:    buildqueuedrop.GetArguments(),
+					ArgsUsage:    common.CreateEnvVars(),
+					BashComplete: corecommon.CreateBashCompletionFunc(),
+					Action:       buildQueueDropCmd,
+				},
+			},
+		},
 		{
 			Name:         "git-lfs-clean",
 			Flags:        cliutils.GetCommandFlags(cliutils.GitLfsClean),
@@ -743,6 +955,28 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       repoDeleteCmd,
 		},
+		{
+			Name:         "repo-export",
+			Aliases:      []string{"rex"},
+			Flags:        cliutils.GetCommandFlags(cliutils.RepoExport),
+			Usage:        repoexport.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt rex", repoexport.GetDescription(), repoexport.Usage),
+			UsageText:    repoexport.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       repoExportCmd,
+		},
+		{
+			Name:         "repo-apply",
+			Aliases:      []string{"rap"},
+			Flags:        cliutils.GetCommandFlags(cliutils.RepoApply),
+			Usage:        repoapply.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt rap", repoapply.GetDescription(), repoapply.Usage),
+			UsageText:    repoapply.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       repoApplyCmd,
+		},
 		{
 			Name:         "replication-template",
 			Aliases:      []string{"rplt"},
@@ -776,6 +1010,38 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       replicationDeleteCmd,
 		},
+		{
+			Name:         "replication-list",
+			Aliases:      []string{"rpll"},
+			Flags:        cliutils.GetCommandFlags(cliutils.ReplicationList),
+			Usage:        replicationlist.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt rpll", replicationlist.GetDescription(), replicationlist.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       replicationListCmd,
+		},
+		{
+			Name:         "replication-status",
+			Aliases:      []string{"rpls"},
+			Flags:        cliutils.GetCommandFlags(cliutils.ReplicationStatus),
+			Usage:        replicationstatus.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt rpls", replicationstatus.GetDescription(), replicationstatus.Usage),
+			UsageText:    replicationstatus.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       replicationStatusCmd,
+		},
+		{
+			Name:         "replication-run",
+			Aliases:      []string{"rplr"},
+			Flags:        cliutils.GetCommandFlags(cliutils.ReplicationRun),
+			Usage:        replicationrun.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt rplr", replicationrun.GetDescription(), replicationrun.Usage),
+			UsageText:    replicationrun.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       replicationRunCmd,
+		},
 		{
 			Name:         "permission-target-template",
 			Aliases:      []string{"ptt"},
@@ -849,6 +1115,26 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       usersDeleteCmd,
 		},
+		{
+			Name:         "users-list",
+			Aliases:      []string{"ul"},
+			Flags:        cliutils.GetCommandFlags(cliutils.UsersList),
+			Usage:        userslist.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt ul", userslist.GetDescription(), userslist.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       usersListCmd,
+		},
+		{
+			Name:         "users-export",
+			Aliases:      []string{"uex"},
+			Flags:        cliutils.GetCommandFlags(cliutils.UsersExport),
+			Usage:        usersexport.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt uex", usersexport.GetDescription(), usersexport.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       usersExportCmd,
+		},
 		{
 			Name:         "group-create",
 			Aliases:      []string{"gc"},
@@ -871,6 +1157,27 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       groupAddUsersCmd,
 		},
+		{
+			Name:         "group-remove-users",
+			Aliases:      []string{"grmu"},
+			Flags:        cliutils.GetCommandFlags(cliutils.GroupRemoveUsers),
+			Usage:        groupremoveusers.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt grmu", groupremoveusers.GetDescription(), groupremoveusers.Usage),
+			UsageText:    groupremoveusers.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       groupRemoveUsersCmd,
+		},
+		{
+			Name:         "groups-list",
+			Aliases:      []string{"gl"},
+			Flags:        cliutils.GetCommandFlags(cliutils.GroupsList),
+			Usage:        groupslist.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt gl", groupslist.GetDescription(), groupslist.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       groupsListCmd,
+		},
 		{
 			Name:         "group-delete",
 			Aliases:      []string{"gdel"},
@@ -882,6 +1189,26 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       groupDeleteCmd,
 		},
+		{
+			Name:         "access-export",
+			Flags:        cliutils.GetCommandFlags(cliutils.AccessExport),
+			Usage:        accessexport.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt access-export", accessexport.GetDescription(), accessexport.Usage),
+			UsageText:    accessexport.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       accessExportCmd,
+		},
+		{
+			Name:         "access-apply",
+			Flags:        cliutils.GetCommandFlags(cliutils.AccessApply),
+			Usage:        accessapply.GetDescription(),
+			HelpName:     corecommon.CreateUsage("rt access-apply", accessapply.GetDescription(), accessapply.Usage),
+			UsageText:    accessapply.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       accessApplyCmd,
+		},
 		{
 			Name:         "access-token-create",
 			Aliases:      []string{"atc"},
@@ -1058,6 +1385,7 @@ func containerPushCmd(c *cli.Context, containerManagerType containerutils.Contai
 	if err != nil {
 		return
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	dockerPushCommand := container.NewPushCommand(containerManagerType)
 	threads, err := cliutils.GetThreadsCount(c)
 	if err != nil {
@@ -1093,6 +1421,7 @@ func containerPullCmd(c *cli.Context, containerManagerType containerutils.Contai
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	dockerPullCommand := container.NewPullCommand(containerManagerType)
 	dockerPullCommand.SetCmdParams([]string{"pull", imageTag}).SetSkipLogin(skipLogin).SetImageTag(imageTag).SetRepo(sourceRepo).SetServerDetails(artDetails).SetBuildConfiguration(buildConfiguration)
 	err = cliutils.ShowDockerDeprecationMessageIfNeeded(containerManagerType, dockerPullCommand.IsGetRepoSupported)
@@ -1119,6 +1448,7 @@ func BuildDockerCreateCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	buildDockerCreateCommand := container.NewBuildDockerCreateCommand()
 	if err := buildDockerCreateCommand.SetImageNameWithDigest(imageNameWithDigestFile); err != nil {
 		return err
@@ -1151,6 +1481,7 @@ func ocStartBuildCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 
 	// Extract repo
 	flagIndex, valueIndex, repo, err := coreutils.FindFlag("--repo", filteredOcArgs)
@@ -1246,6 +1577,7 @@ func downloadCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	retries, err := getRetries(c)
 	if err != nil {
 		return err
@@ -1303,6 +1635,7 @@ func uploadCmd(c *cli.Context) (err error) {
 	if err != nil {
 		return
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	retries, err := getRetries(c)
 	if err != nil {
 		return
@@ -1449,8 +1782,20 @@ func deleteCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.IsSet("trash-repo") && !c.Bool("to-trash") {
+		return cliutils.PrintHelpAndReturnError("The --trash-repo option can be used only together with the --to-trash option.", c)
+	}
 
-	deleteCommand := generic.NewDeleteCommand()
+	// When --to-trash is set, the deleted items are kept restorable by moving them to the trash.
+	var deleteCommand *generic.DeleteCommand
+	var command commands.Command
+	if c.Bool("to-trash") {
+		trashDeleteCommand := trash.NewDeleteCommand().SetTrashRepo(c.String("trash-repo"))
+		deleteCommand, command = &trashDeleteCommand.DeleteCommand, trashDeleteCommand
+	} else {
+		deleteCommand = generic.NewDeleteCommand()
+		command = deleteCommand
+	}
 	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
@@ -1469,11 +1814,65 @@ func deleteCmd(c *cli.Context) error {
 		return err
 	}
 	deleteCommand.SetThreads(threads).SetQuiet(cliutils.GetQuietValue(c)).SetDryRun(c.Bool("dry-run")).SetServerDetails(rtDetails).SetSpec(deleteSpec).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
-	err = commands.Exec(deleteCommand)
+	err = commands.Exec(command)
 	result := deleteCommand.Result()
 	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), cliutils.IsFailNoOp(c), err)
 }
 
+func restoreCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	before, err := getBeforeFlag(c)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	retries, err := getRetries(c)
+	if err != nil {
+		return err
+	}
+	restoreCommand := trash.NewRestoreCommand()
+	restoreCommand.SetServerDetails(rtDetails).SetPattern(c.Args().Get(0)).SetTrashRepo(c.String("trash-repo")).SetBefore(before).
+		SetDryRun(c.Bool("dry-run")).SetQuiet(cliutils.GetQuietValue(c)).SetRetries(retries)
+	err = commands.Exec(restoreCommand)
+	return printBriefSummaryAndGetError(restoreCommand.SuccessCount(), restoreCommand.FailCount(), cliutils.IsFailNoOp(c), err)
+}
+
+func trashListCmd(c *cli.Context) error {
+	if c.NArg() > 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	before, err := getBeforeFlag(c)
+	if err != nil {
+		return err
+	}
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	trashListCommand := trash.NewListCommand()
+	trashListCommand.SetServerDetails(rtDetails).SetPattern(c.Args().Get(0)).SetTrashRepo(c.String("trash-repo")).SetBefore(before)
+	if err = commands.Exec(trashListCommand); err != nil {
+		return err
+	}
+	return trash.PrintItems(trashListCommand.Items(), outputFormat)
+}
+
+func getBeforeFlag(c *cli.Context) (before time.Time, err error) {
+	if c.String("before") == "" {
+		return
+	}
+	return trash.ParseTimestamp(c.String("before"))
+}
+
 func prepareSearchCommand(c *cli.Context) (*spec.SpecFiles, error) {
 	if c.NArg() > 0 && c.IsSet("spec") {
 		return nil, cliutils.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
@@ -1544,6 +1943,64 @@ func searchCmd(c *cli.Context) (err error) {
 	return nil
 }
 
+func aqlCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	query, err := readAqlQuery(c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	if c.IsSet("spec-vars") {
+		query = coreutils.ReplaceVars(query, coreutils.SpecVarsStringToMap(c.String("spec-vars")))
+	}
+	outputFormat, err := aqlcommand.GetOutputFormat(c.String("format"))
+	if err != nil {
+		return err
+	}
+	pageSize := aqlcommand.DefaultPageSize
+	if c.String("page-size") != "" {
+		pageSize, err = strconv.Atoi(c.String("page-size"))
+		if err != nil || pageSize < 0 {
+			return errors.New("The '--page-size' option should have a non-negative numeric value. " + cliutils.GetDocumentationMessage())
+		}
+	}
+	var columns []string
+	if c.String("columns") != "" {
+		columns = strings.Split(c.String("columns"), ",")
+	}
+	retries, err := getRetries(c)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	aqlCommand := aqlcommand.NewAqlCommand()
+	aqlCommand.SetServerDetails(rtDetails).SetQuery(string(query)).SetPageSize(pageSize).SetOutputFormat(outputFormat).
+		SetColumns(columns).SetRetries(retries)
+	if err = commands.Exec(aqlCommand); err != nil {
+		return err
+	}
+	return cliutils.GetCliError(nil, aqlCommand.ResultsCount(), 0, cliutils.IsFailNoOp(c))
+}
+
+// Reads the AQL query from a file, or from the standard input if the path is '-'.
+func readAqlQuery(queryPath string) ([]byte, error) {
+	var query []byte
+	var err error
+	if queryPath == "-" {
+		query, err = io.ReadAll(os.Stdin)
+	} else {
+		query, err = os.ReadFile(queryPath)
+	}
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return query, nil
+}
+
 func preparePropsCmd(c *cli.Context) (*generic.PropsCommand, error) {
 	if c.NArg() > 1 && c.IsSet("spec") {
 		return nil, cliutils.PrintHelpAndReturnError("Only the 'artifact properties' argument should be sent when the spec option is used.", c)
@@ -1646,6 +2103,12 @@ func buildPublishCmd(c *cli.Context) error {
 	buildPublishCmd := buildinfo.NewBuildPublishCommand().SetServerDetails(rtDetails).SetBuildConfiguration(buildConfiguration).SetConfig(buildInfoConfiguration).SetDetailedSummary(c.Bool("detailed-summary"))
 
 	err = commands.Exec(buildPublishCmd)
+	if err != nil && c.Bool("queue-on-failure") && !buildInfoConfiguration.DryRun {
+		return queueBuild(buildConfiguration, buildInfoConfiguration, rtDetails, err)
+	}
+	if err == nil && c.Bool("provenance") {
+		err = addBuildProvenance(c, buildConfiguration, buildInfoConfiguration, rtDetails)
+	}
 	if buildPublishCmd.IsDetailedSummary() {
 		if summary := buildPublishCmd.GetSummary(); summary != nil {
 			return cliutils.PrintBuildInfoSummaryReport(summary.IsSucceeded(), summary.GetSha256(), err)
@@ -1654,6 +2117,47 @@ func buildPublishCmd(c *cli.Context) error {
 	return err
 }
 
+// Queues the local build-info, which failed to be published with publishErr, so that it can be published later by 'rt bq flush'.
+// Only builds which failed with a transient error are queued. Otherwise, or if the build-info can't be queued, publishErr is returned,
+// and the build-info is kept locally. The command fails even if the build-info is queued, since the build wasn't published.
+func queueBuild(buildConfiguration *build.BuildConfiguration, buildInfoConfiguration *buildinfocmd.Configuration, rtDetails *coreConfig.ServerDetails, publishErr error) error {
+	if !buildqueue.IsTransientError(publishErr) {
+		log.Info("The build-info isn't queued, since it failed to be published with an error which would recur.")
+		return publishErr
+	}
+	log.Warn("Failed to publish the build-info: " + publishErr.Error())
+	err := buildqueue.NewEnqueueCommand().SetServerDetails(rtDetails).SetBuildConfiguration(buildConfiguration).SetBuildUrl(buildInfoConfiguration.BuildUrl).
+		SetEnvInclude(buildInfoConfiguration.EnvInclude).SetEnvExclude(buildInfoConfiguration.EnvExclude).SetPublishError(publishErr).Run()
+	if err != nil {
+		log.Error("Failed to queue the build-info: " + err.Error())
+		return publishErr
+	}
+	return errorutils.CheckErrorf("the build-info failed to be published, so it was queued. Run 'jf rt build-queue flush' to publish it")
+}
+
+// Adds the provenance of the published build-info to its artifacts. The provenance is generated only once the build-info is published,
+// so that no attestation is uploaded for a build which failed to be published, and publishing the build again replaces its provenance.
+func addBuildProvenance(c *cli.Context, buildConfiguration *build.BuildConfiguration, buildInfoConfiguration *buildinfocmd.Configuration, rtDetails *coreConfig.ServerDetails) error {
+	if buildInfoConfiguration.DryRun {
+		log.Info("Skipping the provenance, since the build-info isn't published in dry run.")
+		return nil
+	}
+	buildName, err := buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	err = buildprovenance.NewProvenanceCommand().SetServerDetails(rtDetails).SetBuild(buildName, buildNumber, buildConfiguration.GetProject()).
+		SetSigningKeyPath(cliutils.GetProvenanceSigningKey(c.String("signing-key"))).SetTarget(c.String("provenance-target")).Run()
+	if err != nil {
+		return errorutils.CheckErrorf("the build-info was published, but its provenance failed to be generated. Run 'jf rt build-provenance' to generate it: %s", err.Error())
+	}
+	return nil
+}
+
 func buildAppendCmd(c *cli.Context) error {
 	if c.NArg() != 4 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
@@ -1662,6 +2166,7 @@ func buildAppendCmd(c *cli.Context) error {
 	if err := buildConfiguration.ValidateBuildParams(); err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	buildNameToAppend, buildNumberToAppend := c.Args().Get(2), c.Args().Get(3)
 	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
@@ -1682,6 +2187,7 @@ func buildAddDependenciesCmd(c *cli.Context) error {
 	if err := buildConfiguration.ValidateBuildParams(); err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	// Odd number of args - Use pattern arg
 	// Even number of args - Use spec flag
 	if c.NArg() > 3 || !(c.NArg()%2 == 1 || (c.NArg()%2 == 0 && c.IsSet("spec"))) {
@@ -1721,9 +2227,17 @@ func buildCollectEnvCmd(c *cli.Context) error {
 	if err := buildConfiguration.ValidateBuildParams(); err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	buildCollectEnvCmd := buildinfo.NewBuildCollectEnvCommand().SetBuildConfiguration(buildConfiguration)
-
-	return commands.Exec(buildCollectEnvCmd)
+	if err := commands.Exec(buildCollectEnvCmd); err != nil {
+		return err
+	}
+	if !c.Bool("tools") && !c.Bool("host") {
+		return nil
+	}
+	collectFactsCmd := buildenv.NewCollectFactsCommand().SetBuildConfiguration(buildConfiguration).SetTools(c.Bool("tools")).SetHost(c.Bool("host")).
+		SetExcludePatterns(createBuildInfoConfiguration(c).EnvExclude).SetContainerImage(os.Getenv(cliutils.ContainerImage))
+	return commands.Exec(collectFactsCmd)
 }
 
 func buildAddGitCmd(c *cli.Context) error {
@@ -1734,6 +2248,7 @@ func buildAddGitCmd(c *cli.Context) error {
 	if err := buildConfiguration.ValidateBuildParams(); err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 
 	buildAddGitConfigurationCmd := buildinfo.NewBuildAddGitCommand().SetBuildConfiguration(buildConfiguration).SetConfigFilePath(c.String("config")).SetServerId(c.String("server-id"))
 	if c.NArg() == 3 {
@@ -1799,66 +2314,384 @@ func buildPromoteCmd(c *cli.Context) error {
 	if err := buildConfiguration.ValidateBuildParams(); err != nil {
 		return err
 	}
+	if c.String("gates") != "" {
+		if err = evaluateBuildPromoteGates(c.String("gates"), rtDetails, buildConfiguration); err != nil {
+			return err
+		}
+	}
 	buildPromotionCmd := buildinfo.NewBuildPromotionCommand().SetDryRun(c.Bool("dry-run")).SetServerDetails(rtDetails).SetPromotionParams(configuration).SetBuildConfiguration(buildConfiguration)
 	return commands.Exec(buildPromotionCmd)
 }
 
+// Evaluates the gates of the build, and returns an error if any of them failed, so that the build isn't promoted.
+func evaluateBuildPromoteGates(gatesFile string, rtDetails *coreConfig.ServerDetails, buildConfiguration *build.BuildConfiguration) error {
+	gates, err := buildgates.ReadGates(gatesFile)
+	if err != nil {
+		return err
+	}
+	buildName, err := buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	evaluateCmd := buildgates.NewEvaluateCommand().SetServerDetails(rtDetails).SetGates(gates).SetBuild(buildName, buildNumber, buildConfiguration.GetProject())
+	if err = commands.Exec(evaluateCmd); err != nil {
+		return err
+	}
+	if err = buildgates.PrintReport(evaluateCmd.Results()); err != nil {
+		return err
+	}
+	if !evaluateCmd.Passed() {
+		return errorutils.CheckErrorf("build %s/%s didn't pass the promotion gates and wasn't promoted", buildName, buildNumber)
+	}
+	log.Info("The build passed all the promotion gates.")
+	return nil
+}
+
+func buildPromoteSetCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	set, err := buildpromoteset.ReadPromotionSet(c.Args().Get(0), cliutils.GetProject(c))
+	if err != nil {
+		return err
+	}
+	buildPromoteSetCmd := buildpromoteset.NewPromoteSetCommand().SetServerDetails(rtDetails).SetPromotionSet(set).SetMove(c.Bool("move")).SetDryRun(c.Bool("dry-run"))
+	err = commands.Exec(buildPromoteSetCmd)
+	if printErr := buildpromoteset.PrintReport(buildPromoteSetCmd.Results()); err == nil {
+		err = printErr
+	}
+	return err
+}
+
 func buildDiscardCmd(c *cli.Context) error {
 	if c.NArg() > 1 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
 	}
 	configuration := createBuildDiscardConfiguration(c)
-	if configuration.BuildName == "" {
+	namePattern := c.String("build-name-pattern")
+	if namePattern != "" {
+		if c.NArg() > 0 {
+			return cliutils.PrintHelpAndReturnError("The build name argument can't be used with the --build-name-pattern option.", c)
+		}
+		configuration.BuildName = ""
+	} else if configuration.BuildName == "" {
 		return cliutils.PrintHelpAndReturnError("Build name is expected as a command argument or environment variable.", c)
 	}
-	buildDiscardCmd := buildinfo.NewBuildDiscardCommand()
 	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
+	if namePattern != "" && !c.Bool("dry-run") {
+		// The builds of all the matching build names are discarded, so they're listed for confirmation first.
+		if err = execBuildDiscard(rtDetails, configuration, namePattern, true); err != nil {
+			return err
+		}
+		if !cliutils.GetQuietValue(c) && !coreutils.AskYesNo("The builds listed above will be discarded. Are you sure you want to continue?", false) {
+			return nil
+		}
+	}
+	if namePattern != "" || c.Bool("dry-run") {
+		return execBuildDiscard(rtDetails, configuration, namePattern, c.Bool("dry-run"))
+	}
+	buildDiscardCmd := buildinfo.NewBuildDiscardCommand()
 	buildDiscardCmd.SetServerDetails(rtDetails).SetDiscardBuildsParams(configuration)
 
 	return commands.Exec(buildDiscardCmd)
 }
 
-func gitLfsCleanCmd(c *cli.Context) error {
-	if c.NArg() > 1 {
+// Discards the builds, or only lists the builds to discard in a dry run, and prints a report of the build names which succeeded,
+// even if other build names failed.
+func execBuildDiscard(rtDetails *coreConfig.ServerDetails, configuration services.DiscardBuildsParams, namePattern string, dryRun bool) error {
+	discardCmd := builddiscard.NewDiscardCommand().SetServerDetails(rtDetails).SetDiscardBuildsParams(configuration).
+		SetBuildNamePattern(namePattern).SetDryRun(dryRun)
+	err := commands.Exec(discardCmd)
+	if len(discardCmd.Summaries()) > 0 {
+		if printErr := builddiscard.PrintReport(discardCmd); printErr != nil {
+			return errors.Join(err, printErr)
+		}
+	}
+	return err
+}
+
+func buildDiffCmd(c *cli.Context) error {
+	if c.NArg() != 3 && c.NArg() != 4 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
 	}
-	configuration := createGitLfsCleanConfiguration(c)
-	retries, err := getRetries(c)
+	outputFormat, err := builddiff.GetOutputFormat(c.String("format"))
 	if err != nil {
 		return err
 	}
-	retryWaitTime, err := getRetryWaitTime(c)
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	gitLfsCmd := generic.NewGitLfsCommand()
-	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
-	if err != nil {
+	buildName, buildNumber := c.Args().Get(0), c.Args().Get(1)
+	otherBuildName, otherBuildNumber := buildName, c.Args().Get(2)
+	if c.NArg() == 4 {
+		otherBuildName, otherBuildNumber = c.Args().Get(2), c.Args().Get(3)
+	}
+	// The build info configuration holds the default filters of the environment variables.
+	envConfiguration := createBuildInfoConfiguration(c)
+	buildDiffCmd := builddiff.NewBuildDiffCommand()
+	buildDiffCmd.SetServerDetails(rtDetails).SetFrom(buildName, buildNumber).SetTo(otherBuildName, otherBuildNumber).
+		SetProject(cliutils.GetProject(c)).SetEnvInclude(envConfiguration.EnvInclude).SetEnvExclude(envConfiguration.EnvExclude)
+	if err = commands.Exec(buildDiffCmd); err != nil {
 		return err
 	}
-	gitLfsCmd.SetConfiguration(configuration).SetServerDetails(rtDetails).SetDryRun(c.Bool("dry-run")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
-
-	return commands.Exec(gitLfsCmd)
+	return builddiff.PrintDiff(buildDiffCmd.Diff(), outputFormat)
 }
 
-func curlCmd(c *cli.Context) error {
-	if show, err := cliutils.ShowCmdHelpIfNeeded(c, c.Args()); show || err != nil {
-		return err
-	}
-	if c.NArg() < 1 {
+func buildShowCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
 	}
-	rtCurlCommand, err := newRtCurlCommand(c)
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
 	if err != nil {
 		return err
 	}
-	return commands.Exec(rtCurlCommand)
-}
-
-func newRtCurlCommand(c *cli.Context) (*curl.RtCurlCommand, error) {
+	buildShowCmd := builds.NewShowCommand().SetBuild(c.Args().Get(0), c.Args().Get(1), cliutils.GetProject(c)).SetLocal(c.Bool("local"))
+	if c.Bool("local") {
+		// The local build-info doesn't require a server. If one is configured, its user is the principal of the build, as in 'jf rt bp'.
+		configuration := createBuildInfoConfiguration(c)
+		buildShowCmd.SetBuildUrl(configuration.BuildUrl).SetEnvInclude(configuration.EnvInclude).SetEnvExclude(configuration.EnvExclude)
+		if serverDetails, err := coreConfig.GetSpecificConfig(c.String("server-id"), true, false); err == nil {
+			buildShowCmd.SetServerDetails(serverDetails)
+		}
+		if err = buildShowCmd.Run(); err != nil {
+			return err
+		}
+	} else {
+		rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+		if err != nil {
+			return err
+		}
+		if err = commands.Exec(buildShowCmd.SetServerDetails(rtDetails)); err != nil {
+			return err
+		}
+	}
+	return builds.PrintBuildInfo(buildShowCmd.BuildInfo(), outputFormat)
+}
+
+func buildListCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
+	if err != nil {
+		return err
+	}
+	limit, err := cliutils.GetIntFlagValue(c, "limit", 10)
+	if err != nil {
+		return err
+	}
+	buildListCmd := builds.NewListCommand().SetLocal(c.Bool("local")).SetNamePattern(c.String("name")).SetProject(cliutils.GetProject(c)).SetLimit(limit)
+	if c.Bool("local") {
+		err = buildListCmd.Run()
+	} else {
+		var rtDetails *coreConfig.ServerDetails
+		if rtDetails, err = cliutils.CreateArtifactoryDetailsByFlags(c); err != nil {
+			return err
+		}
+		err = commands.Exec(buildListCmd.SetServerDetails(rtDetails))
+	}
+	if err != nil {
+		return err
+	}
+	return builds.PrintRuns(buildListCmd.Runs(), outputFormat)
+}
+
+func buildSbomCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	sbomFormat := buildsbom.CycloneDxJson
+	if c.IsSet("format") {
+		var err error
+		if sbomFormat, err = buildsbom.GetFormat(c.String("format")); err != nil {
+			return err
+		}
+	}
+	uploadTarget := c.String("upload")
+	buildSbomCmd := buildsbom.NewSbomCommand().SetBuild(c.Args().Get(0), c.Args().Get(1), cliutils.GetProject(c)).
+		SetLocal(c.Bool("local")).SetFormat(sbomFormat).SetUploadTarget(uploadTarget)
+	if c.Bool("local") && uploadTarget == "" {
+		// The local build-info is converted without a server.
+		if err := buildSbomCmd.Run(); err != nil {
+			return err
+		}
+	} else {
+		rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+		if err != nil {
+			return err
+		}
+		if err = commands.Exec(buildSbomCmd.SetServerDetails(rtDetails)); err != nil {
+			return err
+		}
+	}
+	if uploadTarget == "" {
+		log.Output(string(buildSbomCmd.Content()))
+	}
+	return nil
+}
+
+func buildProvenanceCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildProvenanceCmd := buildprovenance.NewProvenanceCommand().SetServerDetails(rtDetails).SetBuild(c.Args().Get(0), c.Args().Get(1), cliutils.GetProject(c)).
+		SetLocal(c.Bool("local")).SetSigningKeyPath(cliutils.GetProvenanceSigningKey(c.String("signing-key"))).SetTarget(c.String("target"))
+	if c.Bool("local") {
+		configuration := createBuildInfoConfiguration(c)
+		buildProvenanceCmd.SetBuildUrl(configuration.BuildUrl).SetEnvInclude(configuration.EnvInclude).SetEnvExclude(configuration.EnvExclude)
+	}
+	return commands.Exec(buildProvenanceCmd)
+}
+
+func buildProvenanceVerifyCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	return commands.Exec(buildprovenance.NewVerifyCommand().SetServerDetails(rtDetails).SetBuild(c.Args().Get(0), c.Args().Get(1), cliutils.GetProject(c)).
+		SetPublicKeyPath(c.String("public-key")))
+}
+
+func buildExportCmd(c *cli.Context) error {
+	if c.NArg() > 2 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := cliutils.CreateBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	buildName, err := buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	return buildexport.NewExportCommand().SetBuild(buildName, buildNumber, buildConfiguration.GetProject()).SetOutputPath(c.String("output")).Run()
+}
+
+func buildImportCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	return buildexport.NewImportCommand().SetArchivePath(c.Args().Get(0)).SetMergeDuplicates(c.Bool("merge-duplicates")).Run()
+}
+
+func buildQueueListCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	listCmd := buildqueue.NewListCommand()
+	if err := listCmd.Run(); err != nil {
+		return err
+	}
+	return buildqueue.PrintQueue(listCmd.Entries())
+}
+
+func buildQueueFlushCmd(c *cli.Context) error {
+	if c.NArg() != 0 && c.NArg() != 2 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	retries, err := getRetries(c)
+	if err != nil {
+		return err
+	}
+	retryWaitTime := 10 * time.Second
+	if c.String("retry-wait-time") != "" {
+		waitMilliSecs, err := getRetryWaitTime(c)
+		if err != nil {
+			return err
+		}
+		retryWaitTime = time.Duration(waitMilliSecs) * time.Millisecond
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	flushCmd := buildqueue.NewFlushCommand().SetServerDetails(rtDetails).SetRetries(retries).SetRetryWaitTime(retryWaitTime)
+	if c.NArg() == 2 {
+		flushCmd.SetBuild(c.Args().Get(0), c.Args().Get(1), cliutils.GetProject(c))
+	}
+	err = commands.Exec(flushCmd)
+	if err != nil && len(flushCmd.Results()) == 0 {
+		return err
+	}
+	if printErr := buildqueue.PrintResults(flushCmd.Results()); err == nil {
+		err = printErr
+	}
+	return err
+}
+
+func buildQueueDropCmd(c *cli.Context) error {
+	all := c.Bool("all")
+	if (all && c.NArg() != 0) || (!all && c.NArg() != 2) {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	dropCmd := buildqueue.NewDropCommand()
+	if !all {
+		dropCmd.SetBuild(c.Args().Get(0), c.Args().Get(1), cliutils.GetProject(c))
+	}
+	return dropCmd.Run()
+}
+
+func gitLfsCleanCmd(c *cli.Context) error {
+	if c.NArg() > 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	configuration := createGitLfsCleanConfiguration(c)
+	retries, err := getRetries(c)
+	if err != nil {
+		return err
+	}
+	retryWaitTime, err := getRetryWaitTime(c)
+	if err != nil {
+		return err
+	}
+	gitLfsCmd := generic.NewGitLfsCommand()
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	gitLfsCmd.SetConfiguration(configuration).SetServerDetails(rtDetails).SetDryRun(c.Bool("dry-run")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+
+	return commands.Exec(gitLfsCmd)
+}
+
+func curlCmd(c *cli.Context) error {
+	if show, err := cliutils.ShowCmdHelpIfNeeded(c, c.Args()); show || err != nil {
+		return err
+	}
+	if c.NArg() < 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	rtCurlCommand, err := newRtCurlCommand(c)
+	if err != nil {
+		return err
+	}
+	return commands.Exec(rtCurlCommand)
+}
+
+func newRtCurlCommand(c *cli.Context) (*curl.RtCurlCommand, error) {
 	curlCommand := commands.NewCurlCommand().SetArguments(cliutils.ExtractCommand(c))
 	rtCurlCommand := curl.NewRtCurlCommand(*curlCommand)
 	rtDetails, err := rtCurlCommand.GetServerDetails()
@@ -1957,6 +2790,37 @@ func repoDeleteCmd(c *cli.Context) error {
 	return commands.Exec(repoDeleteCmd)
 }
 
+func repoExportCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoExportCmd := repoconfig.NewRepoExportCommand()
+	repoExportCmd.SetExportDir(c.Args().Get(0)).SetIncludePattern(c.String("include")).SetServerDetails(rtDetails)
+	return commands.Exec(repoExportCmd)
+}
+
+func repoApplyCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoApplyCmd := repoconfig.NewRepoApplyCommand()
+	repoApplyCmd.SetTemplatesDir(c.Args().Get(0)).SetVars(c.String("vars")).SetIncludePattern(c.String("include")).
+		SetPrune(c.Bool("prune")).SetDryRun(c.Bool("dry-run")).SetQuiet(cliutils.GetQuietValue(c)).SetServerDetails(rtDetails)
+	return commands.Exec(repoApplyCmd)
+}
+
 func replicationTemplateCmd(c *cli.Context) error {
 	if c.NArg() != 1 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
@@ -1992,6 +2856,82 @@ func replicationDeleteCmd(c *cli.Context) error {
 	return commands.Exec(replicationDeleteCmd)
 }
 
+func replicationListCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	replicationListCmd := replications.NewListCommand()
+	replicationListCmd.SetServerDetails(rtDetails)
+	if err = commands.Exec(replicationListCmd); err != nil {
+		return err
+	}
+	if err = replications.PrintReplications(replicationListCmd.Replications(), outputFormat); err != nil {
+		return err
+	}
+	return replications.CheckReplications(replicationListCmd.Replications())
+}
+
+func replicationStatusCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	replicationStatusCmd := replications.NewStatusCommand()
+	replicationStatusCmd.SetRepoKey(c.Args().Get(0)).SetServerDetails(rtDetails)
+	if err = commands.Exec(replicationStatusCmd); err != nil {
+		return err
+	}
+	if err = replications.PrintRepoStatus(replicationStatusCmd.Status(), outputFormat); err != nil {
+		return err
+	}
+	return replications.CheckRepoStatus(replicationStatusCmd.Status())
+}
+
+func replicationRunCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
+	if err != nil {
+		return err
+	}
+	var timeout time.Duration
+	if c.String("timeout") != "" {
+		if timeout, err = time.ParseDuration(c.String("timeout")); err != nil {
+			return errorutils.CheckErrorf("the --timeout option should be a duration, such as 30m or 2h: %s", err.Error())
+		}
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	replicationRunCmd := replications.NewRunCommand()
+	replicationRunCmd.SetRepoKey(c.Args().Get(0)).SetWait(c.Bool("wait")).SetTimeout(timeout).SetServerDetails(rtDetails)
+	err = commands.Exec(replicationRunCmd)
+	// The final status is printed even if the replication failed.
+	if replicationRunCmd.Status() != nil {
+		if printErr := replications.PrintRepoStatus(replicationRunCmd.Status(), outputFormat); printErr != nil && err == nil {
+			err = printErr
+		}
+	}
+	return err
+}
+
 func permissionTargetTemplateCmd(c *cli.Context) error {
 	if c.NArg() != 1 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
@@ -2153,6 +3093,43 @@ func usersDeleteCmd(c *cli.Context) error {
 	return commands.Exec(usersDeleteCmd)
 }
 
+func usersListCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := usersgroups.GetOutputFormat(c.String("format"))
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	usersListCmd := usersgroups.NewUsersListCommand()
+	usersListCmd.SetServerDetails(rtDetails).SetGroup(c.String("group"))
+	if err = commands.Exec(usersListCmd); err != nil {
+		return err
+	}
+	return usersgroups.PrintUsers(usersListCmd.Users(), outputFormat)
+}
+
+func usersExportCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	csvFilePath := c.String("csv")
+	if csvFilePath == "" {
+		return cliutils.PrintHelpAndReturnError("missing --csv <File Path>", c)
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	usersExportCmd := usersgroups.NewUsersExportCommand()
+	usersExportCmd.SetServerDetails(rtDetails).SetGroup(c.String("group")).SetCsvPath(csvFilePath)
+	return commands.Exec(usersExportCmd)
+}
+
 func parseCSVToUsersList(csvFilePath string) ([]services.User, error) {
 	var usersList []services.User
 	csvInput, err := os.ReadFile(csvFilePath)
@@ -2206,6 +3183,42 @@ func groupAddUsersCmd(c *cli.Context) error {
 	return commands.Exec(groupAddUsersCmd)
 }
 
+func groupRemoveUsersCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	// Run command.
+	groupRemoveUsersCmd := usersgroups.NewGroupRemoveUsersCommand()
+	groupRemoveUsersCmd.SetName(c.Args().Get(0)).SetUsers(strings.Split(c.Args().Get(1), ",")).SetServerDetails(rtDetails)
+	return commands.Exec(groupRemoveUsersCmd)
+}
+
+func groupsListCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := usersgroups.GetOutputFormat(c.String("format"))
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	groupsListCmd := usersgroups.NewGroupsListCommand()
+	groupsListCmd.SetServerDetails(rtDetails)
+	if err = commands.Exec(groupsListCmd); err != nil {
+		return err
+	}
+	return usersgroups.PrintGroups(groupsListCmd.Groups(), outputFormat)
+}
+
 func groupDeleteCmd(c *cli.Context) error {
 	if c.NArg() != 1 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
@@ -2227,6 +3240,37 @@ func groupDeleteCmd(c *cli.Context) error {
 	return commands.Exec(groupDeleteCmd)
 }
 
+func accessExportCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	accessExportCmd := accessconfig.NewAccessExportCommand()
+	accessExportCmd.SetFilePath(c.Args().Get(0)).SetServerDetails(rtDetails)
+	return commands.Exec(accessExportCmd)
+}
+
+func accessApplyCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := cliutils.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	accessApplyCmd := accessconfig.NewAccessApplyCommand()
+	accessApplyCmd.SetFilePath(c.Args().Get(0)).SetPrune(c.Bool("prune")).SetPlanOnly(c.Bool("plan")).
+		SetQuiet(cliutils.GetQuietValue(c)).SetServerDetails(rtDetails)
+	return commands.Exec(accessApplyCmd)
+}
+
 func artifactoryAccessTokenCreateCmd(c *cli.Context) error {
 	if c.NArg() > 1 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
@@ -0,0 +1,129 @@
+package accessconfig
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Users which are managed by Artifactory itself, and are therefore never exported or deleted.
+var builtInUsers = []string{"anonymous", "_internal", "access-admin", "xray"}
+
+// Users which are exported, but aren't deleted by 'jf rt access-apply --prune' unless they're in the access configuration file.
+var protectedUsers = []string{"admin"}
+
+// GetCurrentAccessConfig returns the current users, groups and permission targets in Artifactory.
+// Only internal users are included, since users of external realms, such as LDAP and SAML, can't be created or deleted.
+func GetCurrentAccessConfig(servicesManager artifactory.ArtifactoryServicesManager) (*AccessConfig, error) {
+	accessConfig := new(AccessConfig)
+	users, err := servicesManager.GetAllUsers()
+	if err != nil {
+		return nil, err
+	}
+	for _, listedUser := range users {
+		if isBuiltInUser(listedUser.Name) || (listedUser.Realm != "" && listedUser.Realm != "internal") {
+			continue
+		}
+		user, err := servicesManager.GetUser(services.UserParams{UserDetails: services.User{Name: listedUser.Name}})
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			accessConfig.Users = append(accessConfig.Users, newUserConfig(user))
+		}
+	}
+
+	groupNames, err := servicesManager.GetAllGroups()
+	if err != nil {
+		return nil, err
+	}
+	if groupNames != nil {
+		for _, groupName := range *groupNames {
+			group, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: groupName}, IncludeUsers: true})
+			if err != nil {
+				return nil, err
+			}
+			if group != nil {
+				accessConfig.Groups = append(accessConfig.Groups, newGroupConfig(group))
+			}
+		}
+	}
+
+	permissionNames, err := getAllPermissionTargets(servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	for _, permissionName := range permissionNames {
+		permissionTarget, err := servicesManager.GetPermissionTarget(permissionName)
+		if err != nil {
+			return nil, err
+		}
+		if permissionTarget != nil {
+			accessConfig.Permissions = append(accessConfig.Permissions, newPermissionConfig(permissionTarget))
+		}
+	}
+	return accessConfig, nil
+}
+
+func isBuiltInUser(name string) bool {
+	for _, builtInUser := range builtInUsers {
+		if name == builtInUser {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the names of all the permission targets.
+func getAllPermissionTargets(servicesManager artifactory.ArtifactoryServicesManager) ([]string, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+"api/v2/security/permissions", true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var permissionTargets []struct {
+		Name string `json:"name"`
+	}
+	if err = json.Unmarshal(body, &permissionTargets); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	names := make([]string, 0, len(permissionTargets))
+	for _, permissionTarget := range permissionTargets {
+		names = append(names, permissionTarget.Name)
+	}
+	return names, nil
+}
+
+// RemoveGroupMembers removes the users from the group.
+// Artifactory only adds members when a group is updated, so the group is removed from the groups of each of the users instead.
+func RemoveGroupMembers(servicesManager artifactory.ArtifactoryServicesManager, groupName string, userNames []string) error {
+	for _, userName := range userNames {
+		user, err := servicesManager.GetUser(services.UserParams{UserDetails: services.User{Name: userName}})
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return errorutils.CheckErrorf("the user '%s' does not exist", userName)
+		}
+		groups := []string{}
+		if user.Groups != nil {
+			for _, group := range *user.Groups {
+				if group != groupName {
+					groups = append(groups, group)
+				}
+			}
+		}
+		params := services.UserParams{UserDetails: services.User{Name: userName, Groups: &groups}, ClearGroups: len(groups) == 0}
+		if err = servicesManager.UpdateUser(params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
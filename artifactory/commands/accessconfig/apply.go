@@ -0,0 +1,215 @@
+package accessconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// AccessApplyCommand brings the users, groups and permission targets in Artifactory to the state described by an access configuration file.
+// It computes a plan, prints it and applies it after confirmation.
+type AccessApplyCommand struct {
+	serverDetails *config.ServerDetails
+	filePath      string
+	// If true, users, groups and permission targets which are not in the file are deleted.
+	prune bool
+	// If true, the plan is printed but not applied.
+	planOnly bool
+	quiet    bool
+	plan     *Plan
+}
+
+func NewAccessApplyCommand() *AccessApplyCommand {
+	return &AccessApplyCommand{}
+}
+
+func (aac *AccessApplyCommand) SetServerDetails(serverDetails *config.ServerDetails) *AccessApplyCommand {
+	aac.serverDetails = serverDetails
+	return aac
+}
+
+func (aac *AccessApplyCommand) SetFilePath(filePath string) *AccessApplyCommand {
+	aac.filePath = filePath
+	return aac
+}
+
+func (aac *AccessApplyCommand) SetPrune(prune bool) *AccessApplyCommand {
+	aac.prune = prune
+	return aac
+}
+
+func (aac *AccessApplyCommand) SetPlanOnly(planOnly bool) *AccessApplyCommand {
+	aac.planOnly = planOnly
+	return aac
+}
+
+func (aac *AccessApplyCommand) SetQuiet(quiet bool) *AccessApplyCommand {
+	aac.quiet = quiet
+	return aac
+}
+
+func (aac *AccessApplyCommand) Plan() *Plan {
+	return aac.plan
+}
+
+func (aac *AccessApplyCommand) ServerDetails() (*config.ServerDetails, error) {
+	return aac.serverDetails, nil
+}
+
+func (aac *AccessApplyCommand) CommandName() string {
+	return "rt_access_apply"
+}
+
+func (aac *AccessApplyCommand) Run() error {
+	desired, err := ReadAccessConfig(aac.filePath)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(aac.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	current, err := GetCurrentAccessConfig(servicesManager)
+	if err != nil {
+		return err
+	}
+	aac.protectUsers(current, desired)
+	aac.plan = CreatePlan(desired, current, aac.prune)
+	log.Output(aac.plan.String())
+	if aac.plan.IsEmpty() || aac.planOnly {
+		return nil
+	}
+	// The passwords are resolved before applying any change, to avoid applying the plan partially.
+	passwords, err := getNewUsersPasswords(aac.plan)
+	if err != nil {
+		return err
+	}
+	if !aac.quiet && !coreutils.AskYesNo("Apply the plan?", false) {
+		return nil
+	}
+	return applyPlan(servicesManager, aac.plan, passwords)
+}
+
+// Makes sure the user running the command and the default admin user are not deleted, to avoid locking them out.
+func (aac *AccessApplyCommand) protectUsers(current, desired *AccessConfig) {
+	protected := make(map[string]bool)
+	for _, name := range protectedUsers {
+		protected[name] = true
+	}
+	if currentUser := aac.getCurrentUser(); currentUser != "" {
+		protected[currentUser] = true
+	} else if aac.prune {
+		log.Warn("The user running the command couldn't be identified, so it isn't protected from being deleted.")
+	}
+	desiredUsers := make(map[string]bool)
+	for _, user := range desired.Users {
+		desiredUsers[user.Name] = true
+	}
+	var users []UserConfig
+	for _, user := range current.Users {
+		if desiredUsers[user.Name] || !protected[user.Name] {
+			users = append(users, user)
+		}
+	}
+	current.Users = users
+}
+
+// Returns the name of the user running the command. With an access token, the user is taken from the subject of the token.
+func (aac *AccessApplyCommand) getCurrentUser() string {
+	if aac.serverDetails == nil {
+		return ""
+	}
+	if aac.serverDetails.User != "" || aac.serverDetails.AccessToken == "" {
+		return aac.serverDetails.User
+	}
+	// The subject of a user token is such as 'jfac@01h0.../users/admin'.
+	subject, err := auth.ExtractSubjectFromAccessToken(aac.serverDetails.AccessToken)
+	if err != nil {
+		log.Debug("Failed to read the subject of the access token:", err.Error())
+		return ""
+	}
+	if i := strings.LastIndex(subject, "/users/"); i >= 0 {
+		return subject[i+len("/users/"):]
+	}
+	return ""
+}
+
+// Returns the passwords of the users to create, mapped by their names.
+func getNewUsersPasswords(plan *Plan) (map[string]string, error) {
+	passwords := map[string]string{}
+	for _, change := range plan.Changes {
+		if change.Kind != userKind || change.Action != Create {
+			continue
+		}
+		password, err := change.user.getPassword()
+		if err != nil {
+			return nil, err
+		}
+		passwordDisabled := change.user.InternalPasswordDisabled != nil && *change.user.InternalPasswordDisabled
+		if password == "" && !passwordDisabled {
+			return nil, errorutils.CheckErrorf("the user '%s' should be created, but it has no password. Set its passwordEnv or passwordFile", change.Name)
+		}
+		passwords[change.Name] = password
+	}
+	return passwords, nil
+}
+
+func applyPlan(servicesManager artifactory.ArtifactoryServicesManager, plan *Plan, passwords map[string]string) error {
+	for _, change := range plan.Changes {
+		log.Info(fmt.Sprintf("Applying: %s %s %s...", change.Action, change.Kind, change.Name))
+		if err := applyChange(servicesManager, change, passwords); err != nil {
+			return err
+		}
+	}
+	log.Info(fmt.Sprintf("Applied %d changes successfully.", len(plan.Changes)))
+	return nil
+}
+
+func applyChange(servicesManager artifactory.ArtifactoryServicesManager, change Change, passwords map[string]string) error {
+	switch change.Kind {
+	case userKind:
+		switch change.Action {
+		case Create:
+			user := change.user.toUser()
+			user.Password = passwords[change.Name]
+			return servicesManager.CreateUser(services.UserParams{UserDetails: user})
+		case Update:
+			return servicesManager.UpdateUser(services.UserParams{UserDetails: change.user.toUser()})
+		case Delete:
+			return servicesManager.DeleteUser(change.Name)
+		}
+	case groupKind:
+		switch change.Action {
+		case Create:
+			return servicesManager.CreateGroup(services.GroupParams{GroupDetails: change.group.toGroup()})
+		case Update:
+			group := change.group.toGroup()
+			// Updating a group adds the listed users to it, so only the new members are listed.
+			group.UsersNames = change.AddedMembers
+			if err := servicesManager.UpdateGroup(services.GroupParams{GroupDetails: group}); err != nil {
+				return err
+			}
+			return RemoveGroupMembers(servicesManager, change.Name, change.RemovedMembers)
+		case Delete:
+			return servicesManager.DeleteGroup(change.Name)
+		}
+	case permissionKind:
+		switch change.Action {
+		case Create:
+			return servicesManager.CreatePermissionTarget(change.permission.toPermissionTarget())
+		case Update:
+			return servicesManager.UpdatePermissionTarget(change.permission.toPermissionTarget())
+		case Delete:
+			return servicesManager.DeletePermissionTarget(change.Name)
+		}
+	}
+	return errorutils.CheckErrorf("unsupported change: %s %s", change.Action, change.Kind)
+}
@@ -0,0 +1,265 @@
+package accessconfig
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"gopkg.in/yaml.v2"
+)
+
+// AccessConfig is the declarative description of the users, groups and permission targets in Artifactory.
+type AccessConfig struct {
+	Users       []UserConfig       `yaml:"users,omitempty"`
+	Groups      []GroupConfig      `yaml:"groups,omitempty"`
+	Permissions []PermissionConfig `yaml:"permissions,omitempty"`
+}
+
+// UserConfig describes a single user. Fields that are not set keep their current value.
+// Passwords are never part of the file. They are read from the referenced environment variable or file, and used only when the user is created.
+type UserConfig struct {
+	Name                     string `yaml:"name"`
+	Email                    string `yaml:"email,omitempty"`
+	Admin                    *bool  `yaml:"admin,omitempty"`
+	ProfileUpdatable         *bool  `yaml:"profileUpdatable,omitempty"`
+	DisableUIAccess          *bool  `yaml:"disableUIAccess,omitempty"`
+	InternalPasswordDisabled *bool  `yaml:"internalPasswordDisabled,omitempty"`
+	// The name of an environment variable holding the password.
+	PasswordEnv string `yaml:"passwordEnv,omitempty"`
+	// The path to a file holding the password.
+	PasswordFile string `yaml:"passwordFile,omitempty"`
+}
+
+// GroupConfig describes a single group. If Members is nil, the members of the group are not managed.
+type GroupConfig struct {
+	Name            string    `yaml:"name"`
+	Description     string    `yaml:"description,omitempty"`
+	AutoJoin        *bool     `yaml:"autoJoin,omitempty"`
+	AdminPrivileges *bool     `yaml:"adminPrivileges,omitempty"`
+	Members         *[]string `yaml:"members,omitempty"`
+}
+
+// PermissionConfig describes a single permission target. Permission targets are always replaced as a whole.
+type PermissionConfig struct {
+	Name          string             `yaml:"name"`
+	Repo          *PermissionSection `yaml:"repo,omitempty"`
+	Build         *PermissionSection `yaml:"build,omitempty"`
+	ReleaseBundle *PermissionSection `yaml:"releaseBundle,omitempty"`
+}
+
+type PermissionSection struct {
+	Repositories    []string `yaml:"repositories"`
+	IncludePatterns []string `yaml:"includePatterns,omitempty"`
+	ExcludePatterns []string `yaml:"excludePatterns,omitempty"`
+	// Maps user names to their permissions, such as read, write, annotate, delete and manage.
+	Users map[string][]string `yaml:"users,omitempty"`
+	// Maps group names to their permissions.
+	Groups map[string][]string `yaml:"groups,omitempty"`
+}
+
+// ReadAccessConfig reads and validates the access configuration file.
+func ReadAccessConfig(filePath string) (*AccessConfig, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	accessConfig := new(AccessConfig)
+	if err = yaml.UnmarshalStrict(content, accessConfig); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing %s: %s", filePath, err.Error())
+	}
+	if err = accessConfig.validate(); err != nil {
+		return nil, errorutils.CheckErrorf("invalid access configuration %s: %s", filePath, err.Error())
+	}
+	return accessConfig, nil
+}
+
+// WriteAccessConfig writes the access configuration file as YAML.
+func WriteAccessConfig(filePath string, accessConfig *AccessConfig) error {
+	content, err := yaml.Marshal(accessConfig)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(filePath, content, 0644))
+}
+
+func (ac *AccessConfig) validate() error {
+	names := map[string]bool{}
+	checkName := func(kind, name string) error {
+		if name == "" {
+			return fmt.Errorf("a %s without a name was found", kind)
+		}
+		if names[kind+"/"+name] {
+			return fmt.Errorf("the %s '%s' is defined more than once", kind, name)
+		}
+		names[kind+"/"+name] = true
+		return nil
+	}
+	for _, user := range ac.Users {
+		if err := checkName(userKind, user.Name); err != nil {
+			return err
+		}
+		if user.PasswordEnv != "" && user.PasswordFile != "" {
+			return fmt.Errorf("the user '%s' has both passwordEnv and passwordFile. Use only one of them", user.Name)
+		}
+	}
+	for _, group := range ac.Groups {
+		if err := checkName(groupKind, group.Name); err != nil {
+			return err
+		}
+	}
+	for _, permission := range ac.Permissions {
+		if err := checkName(permissionKind, permission.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the password of the user from the referenced environment variable or file.
+func (uc *UserConfig) getPassword() (string, error) {
+	switch {
+	case uc.PasswordEnv != "":
+		password, exists := os.LookupEnv(uc.PasswordEnv)
+		if !exists || password == "" {
+			return "", errorutils.CheckErrorf("the environment variable %s, holding the password of the user '%s', is not set", uc.PasswordEnv, uc.Name)
+		}
+		return password, nil
+	case uc.PasswordFile != "":
+		content, err := os.ReadFile(uc.PasswordFile)
+		if err != nil {
+			return "", errorutils.CheckErrorf("failed reading the password of the user '%s': %s", uc.Name, err.Error())
+		}
+		return strings.TrimRight(string(content), "\r\n"), nil
+	}
+	return "", nil
+}
+
+func (uc *UserConfig) toUser() services.User {
+	return services.User{
+		Name:                     uc.Name,
+		Email:                    uc.Email,
+		Admin:                    uc.Admin,
+		ProfileUpdatable:         uc.ProfileUpdatable,
+		DisableUIAccess:          uc.DisableUIAccess,
+		InternalPasswordDisabled: uc.InternalPasswordDisabled,
+	}
+}
+
+func newUserConfig(user *services.User) UserConfig {
+	return UserConfig{
+		Name:                     user.Name,
+		Email:                    user.Email,
+		Admin:                    user.Admin,
+		ProfileUpdatable:         user.ProfileUpdatable,
+		DisableUIAccess:          user.DisableUIAccess,
+		InternalPasswordDisabled: user.InternalPasswordDisabled,
+	}
+}
+
+func (gc *GroupConfig) toGroup() services.Group {
+	group := services.Group{Name: gc.Name, Description: gc.Description, AutoJoin: gc.AutoJoin, AdminPrivileges: gc.AdminPrivileges}
+	if gc.Members != nil {
+		group.UsersNames = *gc.Members
+	}
+	return group
+}
+
+func newGroupConfig(group *services.Group) GroupConfig {
+	members := append([]string{}, group.UsersNames...)
+	sort.Strings(members)
+	return GroupConfig{Name: group.Name, Description: group.Description, AutoJoin: group.AutoJoin, AdminPrivileges: group.AdminPrivileges, Members: &members}
+}
+
+func (pc *PermissionConfig) toPermissionTarget() services.PermissionTargetParams {
+	return services.PermissionTargetParams{
+		Name:          pc.Name,
+		Repo:          pc.Repo.toPermissionTargetSection(),
+		Build:         pc.Build.toPermissionTargetSection(),
+		ReleaseBundle: pc.ReleaseBundle.toPermissionTargetSection(),
+	}
+}
+
+func newPermissionConfig(permissionTarget *services.PermissionTargetParams) PermissionConfig {
+	return PermissionConfig{
+		Name:          permissionTarget.Name,
+		Repo:          newPermissionSection(permissionTarget.Repo),
+		Build:         newPermissionSection(permissionTarget.Build),
+		ReleaseBundle: newPermissionSection(permissionTarget.ReleaseBundle),
+	}
+}
+
+func (ps *PermissionSection) toPermissionTargetSection() *services.PermissionTargetSection {
+	if ps == nil {
+		return nil
+	}
+	section := &services.PermissionTargetSection{
+		Repositories:    ps.Repositories,
+		IncludePatterns: ps.IncludePatterns,
+		ExcludePatterns: ps.ExcludePatterns,
+	}
+	if section.Repositories == nil {
+		section.Repositories = []string{}
+	}
+	if len(ps.Users) > 0 || len(ps.Groups) > 0 {
+		section.Actions = &services.Actions{Users: ps.Users, Groups: ps.Groups}
+	}
+	return section
+}
+
+func newPermissionSection(section *services.PermissionTargetSection) *PermissionSection {
+	if section == nil {
+		return nil
+	}
+	permissionSection := &PermissionSection{
+		Repositories:    section.Repositories,
+		IncludePatterns: section.IncludePatterns,
+		ExcludePatterns: section.ExcludePatterns,
+	}
+	if section.Actions != nil {
+		permissionSection.Users = section.Actions.Users
+		permissionSection.Groups = section.Actions.Groups
+	}
+	return permissionSection.normalize()
+}
+
+// Returns a copy of the section with sorted lists, to allow comparing sections.
+// Artifactory includes all the paths if no include patterns are set.
+func (ps *PermissionSection) normalize() *PermissionSection {
+	if ps == nil {
+		return nil
+	}
+	normalized := &PermissionSection{
+		Repositories:    sortedCopy(ps.Repositories),
+		IncludePatterns: sortedCopy(ps.IncludePatterns),
+		ExcludePatterns: sortedCopy(ps.ExcludePatterns),
+		Users:           normalizeActions(ps.Users),
+		Groups:          normalizeActions(ps.Groups),
+	}
+	if len(normalized.IncludePatterns) == 0 {
+		normalized.IncludePatterns = []string{"**"}
+	}
+	return normalized
+}
+
+func normalizeActions(actions map[string][]string) map[string][]string {
+	if len(actions) == 0 {
+		return nil
+	}
+	normalized := make(map[string][]string, len(actions))
+	for name, permissions := range actions {
+		normalized[name] = sortedCopy(permissions)
+	}
+	return normalized
+}
+
+func sortedCopy(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
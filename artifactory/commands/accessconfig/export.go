@@ -0,0 +1,54 @@
+package accessconfig
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// AccessExportCommand writes the current users, groups and permission targets to an access configuration file.
+type AccessExportCommand struct {
+	serverDetails *config.ServerDetails
+	filePath      string
+}
+
+func NewAccessExportCommand() *AccessExportCommand {
+	return &AccessExportCommand{}
+}
+
+func (aec *AccessExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *AccessExportCommand {
+	aec.serverDetails = serverDetails
+	return aec
+}
+
+func (aec *AccessExportCommand) SetFilePath(filePath string) *AccessExportCommand {
+	aec.filePath = filePath
+	return aec
+}
+
+func (aec *AccessExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return aec.serverDetails, nil
+}
+
+func (aec *AccessExportCommand) CommandName() string {
+	return "rt_access_export"
+}
+
+func (aec *AccessExportCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(aec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	accessConfig, err := GetCurrentAccessConfig(servicesManager)
+	if err != nil {
+		return err
+	}
+	if err = WriteAccessConfig(aec.filePath, accessConfig); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Exported %d users, %d groups and %d permission targets to %s",
+		len(accessConfig.Users), len(accessConfig.Groups), len(accessConfig.Permissions), aec.filePath))
+	return nil
+}
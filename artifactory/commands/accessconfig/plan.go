@@ -0,0 +1,298 @@
+package accessconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	userKind       = "user"
+	groupKind      = "group"
+	permissionKind = "permission"
+)
+
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// FieldChange is a change of a single field of a user, group or permission target.
+type FieldChange struct {
+	Field   string      `json:"field"`
+	Current interface{} `json:"current,omitempty"`
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// Change is a single step of the plan.
+type Change struct {
+	Action Action `json:"action"`
+	// user, group or permission.
+	Kind    string        `json:"kind"`
+	Name    string        `json:"name"`
+	Changes []FieldChange `json:"changes,omitempty"`
+	// The members added to or removed from a group.
+	AddedMembers   []string `json:"addedMembers,omitempty"`
+	RemovedMembers []string `json:"removedMembers,omitempty"`
+
+	// The desired configuration, according to the kind of the change.
+	user       *UserConfig
+	group      *GroupConfig
+	permission *PermissionConfig
+}
+
+// Plan is the ordered list of changes required to bring the users, groups and permission targets to the desired state.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+func (p *Plan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+func (p *Plan) count(action Action) (count int) {
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns a human-readable description of the plan.
+func (p *Plan) String() string {
+	if p.IsEmpty() {
+		return "No changes. The users, groups and permission targets are up-to-date."
+	}
+	lines := []string{fmt.Sprintf("Plan: %d to create, %d to update, %d to delete.", p.count(Create), p.count(Update), p.count(Delete)), ""}
+	symbols := map[Action]string{Create: "+", Update: "~", Delete: "-"}
+	for _, change := range p.Changes {
+		lines = append(lines, fmt.Sprintf("%s %-6s %s %s", symbols[change.Action], change.Action, change.Kind, change.Name))
+		for _, fieldChange := range change.Changes {
+			lines = append(lines, fmt.Sprintf("      %s: %s -> %s", fieldChange.Field, formatValue(fieldChange.Current), formatValue(fieldChange.Desired)))
+		}
+		var members []string
+		for _, member := range change.AddedMembers {
+			members = append(members, "+"+member)
+		}
+		for _, member := range change.RemovedMembers {
+			members = append(members, "-"+member)
+		}
+		if len(members) > 0 {
+			lines = append(lines, "      members: "+strings.Join(members, ", "))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "(none)"
+	}
+	switch reflectValue := reflect.ValueOf(value); reflectValue.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if reflectValue.IsNil() {
+			return "(none)"
+		}
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
+
+// CreatePlan compares the desired access configuration with the current one and returns the changes in the order they should be applied.
+// Users are created before the groups which include them, and groups before the permission targets which refer to them. Deletions
+// happen last, in the opposite order. Users, groups and permission targets which are not in the desired configuration are deleted only if prune is true.
+func CreatePlan(desired, current *AccessConfig, prune bool) *Plan {
+	plan := &Plan{}
+	currentUsers := make(map[string]*UserConfig, len(current.Users))
+	for i := range current.Users {
+		currentUsers[current.Users[i].Name] = &current.Users[i]
+	}
+	currentGroups := make(map[string]*GroupConfig, len(current.Groups))
+	for i := range current.Groups {
+		currentGroups[current.Groups[i].Name] = &current.Groups[i]
+	}
+	currentPermissions := make(map[string]*PermissionConfig, len(current.Permissions))
+	for i := range current.Permissions {
+		currentPermissions[current.Permissions[i].Name] = &current.Permissions[i]
+	}
+
+	for i := range desired.Users {
+		user := &desired.Users[i]
+		change := Change{Action: Update, Kind: userKind, Name: user.Name, user: user}
+		if currentUser, exists := currentUsers[user.Name]; !exists {
+			change.Action = Create
+		} else if change.Changes = diffUser(currentUser, user); len(change.Changes) == 0 {
+			continue
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+	for i := range desired.Groups {
+		group := &desired.Groups[i]
+		change := Change{Action: Update, Kind: groupKind, Name: group.Name, group: group}
+		currentGroup, exists := currentGroups[group.Name]
+		if !exists {
+			change.Action = Create
+			if group.Members != nil {
+				change.AddedMembers = sortedCopy(*group.Members)
+			}
+		} else {
+			change.Changes = diffGroup(currentGroup, group)
+			change.AddedMembers, change.RemovedMembers = diffMembers(currentGroup, group)
+			if len(change.Changes) == 0 && len(change.AddedMembers) == 0 && len(change.RemovedMembers) == 0 {
+				continue
+			}
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+	for i := range desired.Permissions {
+		permission := &desired.Permissions[i]
+		change := Change{Action: Update, Kind: permissionKind, Name: permission.Name, permission: permission}
+		if currentPermission, exists := currentPermissions[permission.Name]; !exists {
+			change.Action = Create
+		} else if change.Changes = diffPermission(currentPermission, permission); len(change.Changes) == 0 {
+			continue
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if prune {
+		plan.Changes = append(plan.Changes, getDeletions(permissionKind, getPermissionNames(current.Permissions), getPermissionNames(desired.Permissions))...)
+		plan.Changes = append(plan.Changes, getDeletions(groupKind, getGroupNames(current.Groups), getGroupNames(desired.Groups))...)
+		plan.Changes = append(plan.Changes, getDeletions(userKind, getUserNames(current.Users), getUserNames(desired.Users))...)
+	}
+	return plan
+}
+
+// Returns the changes of the fields set in the desired configuration. Fields that are not set keep their current value.
+func diffUser(current, desired *UserConfig) (changes []FieldChange) {
+	if desired.Email != "" && desired.Email != current.Email {
+		changes = append(changes, FieldChange{Field: "email", Current: current.Email, Desired: desired.Email})
+	}
+	changes = appendBoolChange(changes, "admin", current.Admin, desired.Admin)
+	changes = appendBoolChange(changes, "profileUpdatable", current.ProfileUpdatable, desired.ProfileUpdatable)
+	changes = appendBoolChange(changes, "disableUIAccess", current.DisableUIAccess, desired.DisableUIAccess)
+	return appendBoolChange(changes, "internalPasswordDisabled", current.InternalPasswordDisabled, desired.InternalPasswordDisabled)
+}
+
+func diffGroup(current, desired *GroupConfig) (changes []FieldChange) {
+	if desired.Description != "" && desired.Description != current.Description {
+		changes = append(changes, FieldChange{Field: "description", Current: current.Description, Desired: desired.Description})
+	}
+	changes = appendBoolChange(changes, "autoJoin", current.AutoJoin, desired.AutoJoin)
+	return appendBoolChange(changes, "adminPrivileges", current.AdminPrivileges, desired.AdminPrivileges)
+}
+
+func appendBoolChange(changes []FieldChange, field string, current, desired *bool) []FieldChange {
+	if desired == nil {
+		return changes
+	}
+	currentValue := current != nil && *current
+	if currentValue == *desired {
+		return changes
+	}
+	return append(changes, FieldChange{Field: field, Current: currentValue, Desired: *desired})
+}
+
+// Returns the members to add to the group and the members to remove from it. If the members of the group are not managed, nothing is returned.
+func diffMembers(current, desired *GroupConfig) (added, removed []string) {
+	if desired.Members == nil {
+		return
+	}
+	currentMembers := map[string]bool{}
+	if current.Members != nil {
+		for _, member := range *current.Members {
+			currentMembers[member] = true
+		}
+	}
+	desiredMembers := map[string]bool{}
+	for _, member := range *desired.Members {
+		desiredMembers[member] = true
+		if !currentMembers[member] {
+			added = append(added, member)
+		}
+	}
+	for member := range currentMembers {
+		if !desiredMembers[member] {
+			removed = append(removed, member)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return
+}
+
+// Permission targets are replaced as a whole, so all their fields are compared.
+func diffPermission(current, desired *PermissionConfig) (changes []FieldChange) {
+	changes = append(changes, diffPermissionSection("repo", current.Repo, desired.Repo)...)
+	changes = append(changes, diffPermissionSection("build", current.Build, desired.Build)...)
+	return append(changes, diffPermissionSection("releaseBundle", current.ReleaseBundle, desired.ReleaseBundle)...)
+}
+
+func diffPermissionSection(sectionName string, current, desired *PermissionSection) (changes []FieldChange) {
+	current, desired = current.normalize(), desired.normalize()
+	if current == nil || desired == nil {
+		if current != desired {
+			changes = append(changes, FieldChange{Field: sectionName, Current: current, Desired: desired})
+		}
+		return
+	}
+	fields := []struct {
+		name             string
+		current, desired interface{}
+	}{
+		{"repositories", current.Repositories, desired.Repositories},
+		{"includePatterns", current.IncludePatterns, desired.IncludePatterns},
+		{"excludePatterns", current.ExcludePatterns, desired.ExcludePatterns},
+		{"users", current.Users, desired.Users},
+		{"groups", current.Groups, desired.Groups},
+	}
+	for _, field := range fields {
+		if !reflect.DeepEqual(field.current, field.desired) {
+			changes = append(changes, FieldChange{Field: sectionName + "." + field.name, Current: field.current, Desired: field.desired})
+		}
+	}
+	return
+}
+
+func getDeletions(kind string, currentNames, desiredNames []string) (deletions []Change) {
+	desired := make(map[string]bool, len(desiredNames))
+	for _, name := range desiredNames {
+		desired[name] = true
+	}
+	for _, name := range sortedCopy(currentNames) {
+		if !desired[name] {
+			deletions = append(deletions, Change{Action: Delete, Kind: kind, Name: name})
+		}
+	}
+	return
+}
+
+func getUserNames(users []UserConfig) (names []string) {
+	for _, user := range users {
+		names = append(names, user.Name)
+	}
+	return
+}
+
+func getGroupNames(groups []GroupConfig) (names []string) {
+	for _, group := range groups {
+		names = append(names, group.Name)
+	}
+	return
+}
+
+func getPermissionNames(permissions []PermissionConfig) (names []string) {
+	for _, permission := range permissions {
+		names = append(names, permission.Name)
+	}
+	return
+}
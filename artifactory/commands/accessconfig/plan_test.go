@@ -0,0 +1,146 @@
+package accessconfig
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePlan(t *testing.T) {
+	trueValue, falseValue := true, false
+	desired := &AccessConfig{
+		Users: []UserConfig{
+			{Name: "alice", Email: "alice@acme.io", Admin: &trueValue},
+			{Name: "bob", Email: "bob@acme.io"},
+			{Name: "carol", Email: "carol@acme.io", PasswordEnv: "CAROL_PASSWORD"},
+		},
+		Groups: []GroupConfig{
+			{Name: "developers", Members: &[]string{"alice", "carol"}},
+			{Name: "qa", Description: "QA team", AutoJoin: &falseValue},
+		},
+		Permissions: []PermissionConfig{{Name: "dev", Repo: &PermissionSection{
+			Repositories: []string{"libs-local", "libs-remote"},
+			Groups:       map[string][]string{"developers": {"write", "read"}},
+		}}},
+	}
+	current := &AccessConfig{
+		Users: []UserConfig{
+			{Name: "alice", Email: "alice@acme.io", Admin: &falseValue},
+			{Name: "bob", Email: "bob@acme.io", Admin: &falseValue},
+			{Name: "dave", Email: "dave@acme.io"},
+		},
+		Groups: []GroupConfig{
+			{Name: "developers", Members: &[]string{"alice", "bob"}},
+			{Name: "qa", Description: "QA team", Members: &[]string{"dave"}},
+			{Name: "old", Members: &[]string{}},
+		},
+		Permissions: []PermissionConfig{{Name: "dev", Repo: &PermissionSection{
+			Repositories:    []string{"libs-remote", "libs-local"},
+			IncludePatterns: []string{"**"},
+			Groups:          map[string][]string{"developers": {"read", "write"}},
+		}}},
+	}
+
+	plan := CreatePlan(desired, current, false)
+	assert.Equal(t, []string{"update user alice", "create user carol", "update group developers"}, getSteps(plan))
+	assert.Equal(t, []FieldChange{{Field: "admin", Current: false, Desired: true}}, plan.Changes[0].Changes)
+	assert.Equal(t, []string{"carol"}, plan.Changes[2].AddedMembers)
+	assert.Equal(t, []string{"bob"}, plan.Changes[2].RemovedMembers)
+	assert.Contains(t, plan.String(), "members: +carol, -bob")
+
+	plan = CreatePlan(desired, current, true)
+	assert.Equal(t, []string{"update user alice", "create user carol", "update group developers", "delete group old", "delete user dave"}, getSteps(plan))
+	assert.Contains(t, plan.String(), "Plan: 1 to create, 2 to update, 2 to delete.")
+
+	desired.Permissions[0].Repo.ExcludePatterns = []string{"*.tmp"}
+	plan = CreatePlan(desired, current, false)
+	assert.Equal(t, FieldChange{Field: "repo.excludePatterns", Current: []string(nil), Desired: []string{"*.tmp"}}, plan.Changes[len(plan.Changes)-1].Changes[0])
+}
+
+func TestProtectUsers(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"jfac@01h0000000000000000000000/users/ci-bot"}`))
+	applyCmd := NewAccessApplyCommand().SetServerDetails(&config.ServerDetails{AccessToken: "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"})
+	assert.Equal(t, "ci-bot", applyCmd.getCurrentUser())
+
+	current := &AccessConfig{Users: []UserConfig{{Name: "admin"}, {Name: "ci-bot"}, {Name: "dave"}}}
+	applyCmd.protectUsers(current, &AccessConfig{})
+	assert.Equal(t, []UserConfig{{Name: "dave"}}, current.Users)
+
+	// Protected users in the file are compared as usual.
+	current = &AccessConfig{Users: []UserConfig{{Name: "admin"}, {Name: "ci-bot"}}}
+	applyCmd.protectUsers(current, &AccessConfig{Users: []UserConfig{{Name: "admin", Email: "admin@acme.io"}}})
+	assert.Equal(t, []UserConfig{{Name: "admin"}}, current.Users)
+
+	applyCmd.SetServerDetails(&config.ServerDetails{User: "alice", AccessToken: "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"})
+	assert.Equal(t, "alice", applyCmd.getCurrentUser())
+}
+
+func TestCreatePlanNoChanges(t *testing.T) {
+	accessConfig := &AccessConfig{
+		Users:  []UserConfig{{Name: "alice", Email: "alice@acme.io"}},
+		Groups: []GroupConfig{{Name: "developers", Members: &[]string{"alice"}}},
+	}
+	plan := CreatePlan(accessConfig, accessConfig, true)
+	assert.True(t, plan.IsEmpty())
+	assert.Equal(t, "No changes. The users, groups and permission targets are up-to-date.", plan.String())
+}
+
+func TestReadAccessConfig(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "access.yaml")
+	assert.NoError(t, os.WriteFile(configPath, []byte(`
+users:
+  - name: alice
+    email: alice@acme.io
+    passwordEnv: ALICE_PASSWORD
+groups:
+  - name: developers
+    members: [alice]
+permissions:
+  - name: dev
+    repo:
+      repositories: [libs-local]
+      groups:
+        developers: [read, write]
+`), 0644))
+	accessConfig, err := ReadAccessConfig(configPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "ALICE_PASSWORD", accessConfig.Users[0].PasswordEnv)
+	assert.Equal(t, []string{"alice"}, *accessConfig.Groups[0].Members)
+	assert.Equal(t, []string{"read", "write"}, accessConfig.Permissions[0].Repo.Groups["developers"])
+
+	// Passwords are not allowed in the file itself.
+	assert.NoError(t, os.WriteFile(configPath, []byte("users:\n  - name: alice\n    password: secret\n"), 0644))
+	_, err = ReadAccessConfig(configPath)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.WriteFile(configPath, []byte("groups:\n  - name: developers\n  - name: developers\n"), 0644))
+	_, err = ReadAccessConfig(configPath)
+	assert.ErrorContains(t, err, "defined more than once")
+}
+
+func TestGetPassword(t *testing.T) {
+	t.Setenv("ALICE_PASSWORD", "env-password")
+	password, err := (&UserConfig{Name: "alice", PasswordEnv: "ALICE_PASSWORD"}).getPassword()
+	assert.NoError(t, err)
+	assert.Equal(t, "env-password", password)
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	assert.NoError(t, os.WriteFile(passwordFile, []byte("file-password\n"), 0600))
+	password, err = (&UserConfig{Name: "alice", PasswordFile: passwordFile}).getPassword()
+	assert.NoError(t, err)
+	assert.Equal(t, "file-password", password)
+
+	_, err = (&UserConfig{Name: "alice", PasswordEnv: "MISSING_PASSWORD_ENV"}).getPassword()
+	assert.Error(t, err)
+}
+
+func getSteps(plan *Plan) (steps []string) {
+	for _, change := range plan.Changes {
+		steps = append(steps, string(change.Action)+" "+change.Kind+" "+change.Name)
+	}
+	return
+}
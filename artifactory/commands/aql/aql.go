@@ -0,0 +1,138 @@
+package aql
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const DefaultPageSize = 1000
+
+// AqlCommand runs a raw AQL query of any domain and prints its results.
+// The results are fetched page by page, using the '.offset()' and '.limit()' modifiers.
+type AqlCommand struct {
+	serverDetails *config.ServerDetails
+	query         string
+	pageSize      int
+	outputFormat  format.OutputFormat
+	columns       []string
+	retries       int
+	resultsCount  int
+}
+
+func NewAqlCommand() *AqlCommand {
+	return &AqlCommand{pageSize: DefaultPageSize, outputFormat: Ndjson}
+}
+
+func (ac *AqlCommand) SetServerDetails(serverDetails *config.ServerDetails) *AqlCommand {
+	ac.serverDetails = serverDetails
+	return ac
+}
+
+func (ac *AqlCommand) SetQuery(query string) *AqlCommand {
+	ac.query = query
+	return ac
+}
+
+// A page size of 0 disables paging, so that the query is sent as is.
+func (ac *AqlCommand) SetPageSize(pageSize int) *AqlCommand {
+	ac.pageSize = pageSize
+	return ac
+}
+
+func (ac *AqlCommand) SetOutputFormat(outputFormat format.OutputFormat) *AqlCommand {
+	ac.outputFormat = outputFormat
+	return ac
+}
+
+func (ac *AqlCommand) SetColumns(columns []string) *AqlCommand {
+	ac.columns = columns
+	return ac
+}
+
+func (ac *AqlCommand) SetRetries(retries int) *AqlCommand {
+	ac.retries = retries
+	return ac
+}
+
+// Returns the number of results printed by the command.
+func (ac *AqlCommand) ResultsCount() int {
+	return ac.resultsCount
+}
+
+func (ac *AqlCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ac.serverDetails, nil
+}
+
+func (ac *AqlCommand) CommandName() string {
+	return "rt_aql"
+}
+
+func (ac *AqlCommand) Run() error {
+	query, err := ParseQuery(ac.query)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(ac.serverDetails, ac.retries, 0, false)
+	if err != nil {
+		return err
+	}
+	if ac.pageSize > 0 && !query.WithDefaultSort() {
+		log.Warn("The results of the query can't be sorted by default, so its pages may overlap. Add a .sort() modifier to the query, or set --page-size=0.")
+	}
+	printer := newResultsPrinter(ac.outputFormat, ac.columns)
+	offset, remaining := query.Offset, query.Limit
+	for {
+		pageLimit := ac.pageSize
+		if query.Limit > 0 && (pageLimit <= 0 || remaining < pageLimit) {
+			pageLimit = remaining
+		}
+		results, err := runQuery(servicesManager, query.WithRange(offset, pageLimit))
+		if err != nil {
+			return err
+		}
+		if err = printer.add(results); err != nil {
+			return err
+		}
+		ac.resultsCount += len(results)
+		offset += len(results)
+		remaining -= len(results)
+		// Stop when paging is disabled, when the last page was read, or when the limit requested by the query was reached.
+		if pageLimit <= 0 || len(results) < pageLimit || (query.Limit > 0 && remaining <= 0) {
+			break
+		}
+	}
+	return printer.flush()
+}
+
+type aqlResponse struct {
+	Results []json.RawMessage `json:"results,omitempty"`
+}
+
+func runQuery(servicesManager artifactory.ArtifactoryServicesManager, query string) ([]json.RawMessage, error) {
+	log.Debug("Running AQL query:", query)
+	stream, err := servicesManager.Aql(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := stream.Close(); closeErr != nil {
+			log.Debug(closeErr.Error())
+		}
+	}()
+	body, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	response := new(aqlResponse)
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return response.Results, nil
+}
@@ -0,0 +1,113 @@
+package aql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	testRuns := []struct {
+		name           string
+		query          string
+		expectedBody   string
+		expectedOffset int
+		expectedLimit  int
+	}{
+		{"itemsNoRange", `items.find({"repo":"my-repo"})`, `items.find({"repo":"my-repo"})`, 0, 0},
+		{"buildsWithModifiers", "builds.find({\"name\":\"my-build\"}).include(\"name\",\"number\").sort({\"$desc\":[\"created\"]}).limit(10);\n",
+			`builds.find({"name":"my-build"}).include("name","number").sort({"$desc":["created"]})`, 0, 10},
+		{"offsetAndLimit", `entries.find().offset(20) .limit( 5 )`, `entries.find()`, 20, 5},
+	}
+	for _, test := range testRuns {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := ParseQuery(test.query)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedBody, query.Body)
+			assert.Equal(t, test.expectedOffset, query.Offset)
+			assert.Equal(t, test.expectedLimit, query.Limit)
+		})
+	}
+
+	query, err := ParseQuery(`items.find({"name":"a.limit(1)","path":"x\".offset(2)"}).limit(3)`)
+	assert.NoError(t, err)
+	assert.Equal(t, `items.find({"name":"a.limit(1)","path":"x\".offset(2)"})`, query.Body)
+	assert.Equal(t, 0, query.Offset)
+	assert.Equal(t, 3, query.Limit)
+
+	_, err = ParseQuery(`{"repo":"my-repo"}`)
+	assert.Error(t, err)
+}
+
+func TestWithRange(t *testing.T) {
+	query := &Query{Body: `items.find()`}
+	assert.Equal(t, `items.find()`, query.WithRange(0, 0))
+	assert.Equal(t, `items.find().limit(100)`, query.WithRange(0, 100))
+	assert.Equal(t, `items.find().offset(200).limit(100)`, query.WithRange(200, 100))
+}
+
+func TestWithDefaultSort(t *testing.T) {
+	testRuns := []struct {
+		name         string
+		query        string
+		expectedBody string
+		expectedOk   bool
+	}{
+		{"items", `items.find({"repo":"my-repo"})`, `items.find({"repo":"my-repo"}).sort({"$asc":["repo","path","name"]})`, true},
+		{"itemsInclude", `items.find().include("name","repo")`, `items.find().include("name","repo").sort({"$asc":["repo","name"]})`, true},
+		{"sorted", `builds.find().sort({"$desc":["created"]}).limit(5)`, `builds.find().sort({"$desc":["created"]})`, true},
+		{"sortInLiteral", `items.find({"name":".sort("})`, `items.find({"name":".sort("}).sort({"$asc":["repo","path","name"]})`, true},
+		{"unknownDomain", `releases.find()`, `releases.find()`, false},
+		{"notIncluded", `items.find().include("size")`, `items.find().include("size")`, false},
+	}
+	for _, test := range testRuns {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := ParseQuery(test.query)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedOk, query.WithDefaultSort())
+			assert.Equal(t, test.expectedBody, query.Body)
+		})
+	}
+}
+
+func TestGetOutputFormat(t *testing.T) {
+	outputFormat, err := GetOutputFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, Ndjson, outputFormat)
+	outputFormat, err = GetOutputFormat("TABLE")
+	assert.NoError(t, err)
+	assert.Equal(t, format.Table, outputFormat)
+	_, err = GetOutputFormat("sarif")
+	assert.Error(t, err)
+}
+
+func TestAddRows(t *testing.T) {
+	results := []json.RawMessage{
+		json.RawMessage(`{"repo":"my-repo","path":"a/b","name":"file.zip","size":10485760,"stats":[{"downloads":3}]}`),
+		json.RawMessage(`{"repo":"my-repo","path":".","name":"other.zip","size":1}`),
+	}
+
+	printer := newResultsPrinter(format.Table, nil)
+	assert.NoError(t, printer.add(results))
+	assert.Equal(t, []string{"repo", "path", "name", "size", "stats"}, printer.columns)
+	assert.Equal(t, [][]string{
+		{"my-repo", "a/b", "file.zip", "10485760", `[{"downloads":3}]`},
+		{"my-repo", ".", "other.zip", "1", ""},
+	}, printer.rows)
+
+	printer = newResultsPrinter(format.Table, []string{"name", "missing.field"})
+	assert.NoError(t, printer.add(results))
+	assert.Equal(t, [][]string{{"file.zip", ""}, {"other.zip", ""}}, printer.rows)
+}
+
+func TestGetField(t *testing.T) {
+	fields, err := decodeResult(json.RawMessage(`{"build.name":"flat","module":{"id":"my-module","stats":{"count":2}}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "my-module", getField(fields, "module.id"))
+	assert.Equal(t, json.Number("2"), getField(fields, "module.stats.count"))
+	assert.Nil(t, getField(fields, "module.id.other"))
+	assert.Nil(t, getField(fields, "missing"))
+	assert.Equal(t, "flat", getField(fields, "build.name"))
+}
@@ -0,0 +1,201 @@
+package aql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Ndjson prints every result as a JSON object in a separate line.
+const Ndjson format.OutputFormat = "ndjson"
+
+// GetOutputFormat returns the output format matching the value of the --format option. The default is NDJSON.
+func GetOutputFormat(value string) (format.OutputFormat, error) {
+	switch strings.ToLower(value) {
+	case "", string(Ndjson):
+		return Ndjson, nil
+	case string(format.Table):
+		return format.Table, nil
+	default:
+		return "", errorutils.CheckErrorf("only the following output formats are supported: %s, %s", Ndjson, format.Table)
+	}
+}
+
+// resultsPrinter prints the query results page by page.
+// NDJSON results are printed as soon as they arrive, while the table is printed once all pages were read.
+type resultsPrinter struct {
+	outputFormat format.OutputFormat
+	// The fields to print. Nested fields are separated by dots, for example 'stats.downloads'.
+	columns []string
+	rows    [][]string
+}
+
+func newResultsPrinter(outputFormat format.OutputFormat, columns []string) *resultsPrinter {
+	return &resultsPrinter{outputFormat: outputFormat, columns: columns}
+}
+
+func (rp *resultsPrinter) add(results []json.RawMessage) error {
+	for _, result := range results {
+		if rp.outputFormat == Ndjson {
+			if err := rp.printLine(result); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := rp.addRow(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rp *resultsPrinter) printLine(result json.RawMessage) error {
+	if len(rp.columns) == 0 {
+		line := new(bytes.Buffer)
+		if err := json.Compact(line, result); err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(line.String())
+		return nil
+	}
+	fields, err := decodeResult(result)
+	if err != nil {
+		return err
+	}
+	// Build the object manually to keep the columns order.
+	line := new(bytes.Buffer)
+	line.WriteString("{")
+	for i, column := range rp.columns {
+		content, err := json.Marshal(getField(fields, column))
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		if i > 0 {
+			line.WriteString(",")
+		}
+		line.WriteString(fmt.Sprintf("%q:%s", column, content))
+	}
+	line.WriteString("}")
+	log.Output(line.String())
+	return nil
+}
+
+func (rp *resultsPrinter) addRow(result json.RawMessage) (err error) {
+	if len(rp.columns) == 0 {
+		// Without chosen columns, the fields of the first result are printed, in the order returned by Artifactory.
+		if rp.columns, err = getKeys(result); err != nil {
+			return
+		}
+	}
+	fields, err := decodeResult(result)
+	if err != nil {
+		return
+	}
+	row := make([]string, 0, len(rp.columns))
+	for _, column := range rp.columns {
+		var value string
+		if value, err = toCellValue(getField(fields, column)); err != nil {
+			return
+		}
+		row = append(row, value)
+	}
+	rp.rows = append(rp.rows, row)
+	return
+}
+
+func (rp *resultsPrinter) flush() error {
+	if rp.outputFormat != format.Table {
+		return nil
+	}
+	if len(rp.rows) == 0 {
+		log.Info("No results were found.")
+		return nil
+	}
+	table := new(strings.Builder)
+	writer := tabwriter.NewWriter(table, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, strings.ToUpper(strings.Join(rp.columns, "\t")))
+	for _, row := range rp.rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+	if err := writer.Flush(); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(strings.TrimSuffix(table.String(), "\n"))
+	return nil
+}
+
+func decodeResult(result json.RawMessage) (fields map[string]interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(result))
+	// Keep numbers as they were returned, to avoid printing sizes in scientific notation.
+	decoder.UseNumber()
+	err = errorutils.CheckError(decoder.Decode(&fields))
+	return
+}
+
+// Returns the value of a field, or nil if it does not exist. Nested fields are separated by dots.
+// Since the fields of some domains contain dots themselves, such as 'build.name', an exact match is looked for first.
+func getField(fields map[string]interface{}, column string) interface{} {
+	if value, ok := fields[column]; ok {
+		return value
+	}
+	for i := strings.Index(column, "."); i >= 0; i = nextDot(column, i) {
+		if nested, ok := fields[column[:i]].(map[string]interface{}); ok {
+			if value := getField(nested, column[i+1:]); value != nil {
+				return value
+			}
+		}
+	}
+	return nil
+}
+
+func nextDot(column string, previous int) int {
+	next := strings.Index(column[previous+1:], ".")
+	if next < 0 {
+		return -1
+	}
+	return previous + 1 + next
+}
+
+func toCellValue(value interface{}) (string, error) {
+	switch typedValue := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return typedValue, nil
+	case json.Number:
+		return typedValue.String(), nil
+	case bool:
+		return fmt.Sprint(typedValue), nil
+	default:
+		// Nested objects and arrays, such as the properties of an item, are printed as JSON.
+		content, err := json.Marshal(typedValue)
+		return string(content), errorutils.CheckError(err)
+	}
+}
+
+// Returns the top level keys of a JSON object, in their original order.
+func getKeys(result json.RawMessage) (keys []string, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(result))
+	if _, err = decoder.Token(); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for decoder.More() {
+		var token json.Token
+		if token, err = decoder.Token(); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		keys = append(keys, fmt.Sprint(token))
+		// Skip the value.
+		var value json.RawMessage
+		if err = decoder.Decode(&value); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	}
+	return
+}
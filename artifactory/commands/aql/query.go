@@ -0,0 +1,136 @@
+package aql
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+var (
+	// Matches the beginning of an AQL query, such as 'items.find(' or 'builds.find('.
+	domainRegexp = regexp.MustCompile(`^\s*[a-z]+\s*\.\s*find\s*\(`)
+	// Matches the '.offset(n)' and '.limit(n)' modifiers of an AQL query.
+	rangeRegexp = regexp.MustCompile(`\.\s*(offset|limit)\s*\(\s*(\d+)\s*\)`)
+	// Matches the '.sort(' and '.include(' modifiers of an AQL query.
+	sortRegexp    = regexp.MustCompile(`\.\s*sort\s*\(`)
+	includeRegexp = regexp.MustCompile(`\.\s*include\s*\(([^)]*)\)`)
+)
+
+// The fields the results of a domain are sorted by if the query isn't sorted, so that the pages don't overlap.
+// AQL doesn't keep the order of the results across requests, unless they're sorted.
+var defaultSortFields = map[string][]string{
+	"items":   {"repo", "path", "name"},
+	"builds":  {"name", "number", "created"},
+	"entries": {"path", "name"},
+}
+
+// Query is an AQL query, split into its body and the range of results it requests.
+// Splitting the range from the body allows running the query page by page.
+type Query struct {
+	// The query without its '.offset()' and '.limit()' modifiers.
+	Body string
+	// The offset requested by the query, or 0 if not set.
+	Offset int
+	// The limit requested by the query, or 0 if not set.
+	Limit int
+	// The domain of the query, such as 'items' or 'builds'.
+	Domain string
+	// True if the query has a '.sort()' modifier.
+	Sorted bool
+}
+
+// ParseQuery parses an AQL query of any domain, with or without the '.include()', '.sort()', '.offset()' and '.limit()' modifiers.
+func ParseQuery(query string) (*Query, error) {
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	if !domainRegexp.MatchString(query) {
+		return nil, errorutils.CheckErrorf("invalid AQL query. The query should start with <domain>.find(, for example: items.find({\"repo\":\"my-repo\"})")
+	}
+	parsed := &Query{Domain: strings.TrimSpace(query[:strings.Index(query, ".")])}
+	// The modifiers are looked for outside the string literals, so that a value such as "a.limit(1)" isn't mistaken for one.
+	masked := maskLiterals(query)
+	parsed.Sorted = sortRegexp.MatchString(masked)
+	var body strings.Builder
+	end := 0
+	for _, match := range rangeRegexp.FindAllStringSubmatchIndex(masked, -1) {
+		name, number := query[match[2]:match[3]], query[match[4]:match[5]]
+		value, err := strconv.Atoi(number)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("invalid AQL query. The value of .%s() is not a valid number: %s", name, number)
+		}
+		if name == "offset" {
+			parsed.Offset = value
+		} else {
+			parsed.Limit = value
+		}
+		body.WriteString(query[end:match[0]])
+		end = match[1]
+	}
+	body.WriteString(query[end:])
+	parsed.Body = strings.TrimSpace(body.String())
+	return parsed, nil
+}
+
+// Returns the query with the content of its string literals replaced by spaces, so that the positions in the query are kept.
+func maskLiterals(query string) string {
+	masked := []byte(query)
+	inLiteral, escaped := false, false
+	for i, char := range masked {
+		switch {
+		case !inLiteral:
+			inLiteral = char == '"'
+		case escaped:
+			escaped = false
+			masked[i] = ' '
+		case char == '\\':
+			escaped = true
+			masked[i] = ' '
+		case char == '"':
+			inLiteral = false
+		default:
+			masked[i] = ' '
+		}
+	}
+	return string(masked)
+}
+
+// WithDefaultSort sorts the results of an unsorted query by the default fields of its domain, so that it can be run page by page.
+// Fields which aren't returned by the '.include()' modifier of the query are skipped, since AQL can't sort by them.
+// Returns false if the results of the query can't be sorted.
+func (q *Query) WithDefaultSort() bool {
+	if q.Sorted {
+		return true
+	}
+	var include string
+	masked := maskLiterals(q.Body)
+	if match := includeRegexp.FindStringSubmatchIndex(masked); match != nil {
+		include = q.Body[match[2]:match[3]]
+	}
+	var fields []string
+	for _, field := range defaultSortFields[q.Domain] {
+		if include == "" || strings.Contains(include, `"`+field+`"`) || strings.Contains(include, `"*"`) {
+			fields = append(fields, `"`+field+`"`)
+		}
+	}
+	if len(fields) == 0 {
+		return false
+	}
+	q.Body += fmt.Sprintf(`.sort({"$asc":[%s]})`, strings.Join(fields, ","))
+	q.Sorted = true
+	return true
+}
+
+// WithRange returns the query with the provided offset and limit.
+// A zero offset or limit is omitted from the returned query.
+func (q *Query) WithRange(offset, limit int) string {
+	query := q.Body
+	if offset > 0 {
+		query += fmt.Sprintf(".offset(%d)", offset)
+	}
+	if limit > 0 {
+		query += fmt.Sprintf(".limit(%d)", limit)
+	}
+	return query
+}
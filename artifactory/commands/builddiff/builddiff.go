@@ -0,0 +1,114 @@
+package builddiff
+
+import (
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// BuildDiffCommand compares two published builds.
+type BuildDiffCommand struct {
+	serverDetails *config.ServerDetails
+	from          services.BuildInfoParams
+	to            services.BuildInfoParams
+	envInclude    string
+	envExclude    string
+	diff          *Diff
+}
+
+func NewBuildDiffCommand() *BuildDiffCommand {
+	return &BuildDiffCommand{}
+}
+
+func (bdc *BuildDiffCommand) SetServerDetails(serverDetails *config.ServerDetails) *BuildDiffCommand {
+	bdc.serverDetails = serverDetails
+	return bdc
+}
+
+// SetFrom sets the build which the comparison starts from.
+func (bdc *BuildDiffCommand) SetFrom(buildName, buildNumber string) *BuildDiffCommand {
+	bdc.from.BuildName, bdc.from.BuildNumber = buildName, buildNumber
+	return bdc
+}
+
+// SetTo sets the build which is compared with the first one.
+func (bdc *BuildDiffCommand) SetTo(buildName, buildNumber string) *BuildDiffCommand {
+	bdc.to.BuildName, bdc.to.BuildNumber = buildName, buildNumber
+	return bdc
+}
+
+func (bdc *BuildDiffCommand) SetProject(project string) *BuildDiffCommand {
+	bdc.from.ProjectKey, bdc.to.ProjectKey = project, project
+	return bdc
+}
+
+// SetEnvInclude sets the patterns, separated by semicolons, of the environment variables to compare.
+func (bdc *BuildDiffCommand) SetEnvInclude(envInclude string) *BuildDiffCommand {
+	bdc.envInclude = envInclude
+	return bdc
+}
+
+// SetEnvExclude sets the patterns, separated by semicolons, of the environment variables to leave out of the comparison.
+func (bdc *BuildDiffCommand) SetEnvExclude(envExclude string) *BuildDiffCommand {
+	bdc.envExclude = envExclude
+	return bdc
+}
+
+func (bdc *BuildDiffCommand) Diff() *Diff {
+	return bdc.diff
+}
+
+func (bdc *BuildDiffCommand) ServerDetails() (*config.ServerDetails, error) {
+	return bdc.serverDetails, nil
+}
+
+func (bdc *BuildDiffCommand) CommandName() string {
+	return "rt_build_diff"
+}
+
+func (bdc *BuildDiffCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(bdc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	from, err := bdc.getBuildInfo(servicesManager, bdc.from)
+	if err != nil {
+		return err
+	}
+	to, err := bdc.getBuildInfo(servicesManager, bdc.to)
+	if err != nil {
+		return err
+	}
+	bdc.diff = Compare(from, to)
+	return nil
+}
+
+// Gets the build info, and filters its environment variables.
+func (bdc *BuildDiffCommand) getBuildInfo(servicesManager artifactory.ArtifactoryServicesManager, params services.BuildInfoParams) (*buildinfo.BuildInfo, error) {
+	publishedBuildInfo, found, err := servicesManager.GetBuildInfo(params)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorutils.CheckErrorf("build %s was not found", builds.FormatBuild(buildindex.Build{Name: params.BuildName, Number: params.BuildNumber, Project: params.ProjectKey}))
+	}
+	buildInfo := &publishedBuildInfo.BuildInfo
+	if bdc.envInclude != "" {
+		if err = buildInfo.IncludeEnv(strings.Split(bdc.envInclude, ";")...); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	}
+	if bdc.envExclude != "" {
+		if err = buildInfo.ExcludeEnv(strings.Split(bdc.envExclude, ";")...); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	}
+	return buildInfo, nil
+}
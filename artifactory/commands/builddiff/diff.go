@@ -0,0 +1,360 @@
+package builddiff
+
+import (
+	"regexp"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+)
+
+type Change string
+
+const (
+	Added     Change = "added"
+	Removed   Change = "removed"
+	Changed   Change = "changed"
+	Unchanged Change = "unchanged"
+)
+
+// Diff holds the differences between two builds.
+type Diff struct {
+	From    BuildRef     `json:"from"`
+	To      BuildRef     `json:"to"`
+	Modules []ModuleDiff `json:"modules"`
+	Vcs     []VcsDiff    `json:"vcs"`
+	Env     []EnvDiff    `json:"env"`
+	Issues  []IssueDiff  `json:"issues"`
+}
+
+type BuildRef struct {
+	Name    string `json:"name"`
+	Number  string `json:"number"`
+	Started string `json:"started,omitempty"`
+}
+
+func (br BuildRef) String() string {
+	return builds.FormatBuild(buildindex.Build{Name: br.Name, Number: br.Number})
+}
+
+// ModuleDiff holds the differences of a module, which is matched between the builds by its ID without the version.
+type ModuleDiff struct {
+	Name         string     `json:"name"`
+	Change       Change     `json:"change"`
+	FromId       string     `json:"fromId,omitempty"`
+	ToId         string     `json:"toId,omitempty"`
+	Artifacts    []ItemDiff `json:"artifacts,omitempty"`
+	Dependencies []ItemDiff `json:"dependencies,omitempty"`
+}
+
+// ItemDiff is an artifact or a dependency which was added, removed or changed.
+// An item is changed if its version or its checksum is different.
+type ItemDiff struct {
+	Name         string `json:"name"`
+	Change       Change `json:"change"`
+	FromVersion  string `json:"fromVersion,omitempty"`
+	ToVersion    string `json:"toVersion,omitempty"`
+	FromChecksum string `json:"fromChecksum,omitempty"`
+	ToChecksum   string `json:"toChecksum,omitempty"`
+}
+
+// VcsDiff holds the revisions range of a repository.
+type VcsDiff struct {
+	Url          string `json:"url"`
+	Change       Change `json:"change"`
+	FromRevision string `json:"fromRevision,omitempty"`
+	ToRevision   string `json:"toRevision,omitempty"`
+	Branch       string `json:"branch,omitempty"`
+}
+
+type EnvDiff struct {
+	Name   string `json:"name"`
+	Change Change `json:"change"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+}
+
+type IssueDiff struct {
+	Key     string `json:"key"`
+	Change  Change `json:"change"`
+	Summary string `json:"summary,omitempty"`
+	Url     string `json:"url,omitempty"`
+}
+
+// IsEmpty returns true if the builds have the same modules, environment variables and issues, and were built from the same revisions.
+func (d *Diff) IsEmpty() bool {
+	for _, vcs := range d.Vcs {
+		if vcs.Change != Unchanged {
+			return false
+		}
+	}
+	return len(d.Modules) == 0 && len(d.Env) == 0 && len(d.Issues) == 0
+}
+
+// Compare returns the differences between two builds.
+func Compare(from, to *buildinfo.BuildInfo) *Diff {
+	return &Diff{
+		From:    BuildRef{Name: from.Name, Number: from.Number, Started: from.Started},
+		To:      BuildRef{Name: to.Name, Number: to.Number, Started: to.Started},
+		Modules: compareModules(from.Modules, to.Modules),
+		Vcs:     compareVcs(from.VcsList, to.VcsList),
+		Env:     compareEnv(from.Properties, to.Properties),
+		Issues:  compareIssues(from.Issues, to.Issues),
+	}
+}
+
+func compareModules(from, to []buildinfo.Module) (diffs []ModuleDiff) {
+	fromModules := indexModules(from)
+	toModules := indexModules(to)
+	for _, name := range builds.SortedKeys(fromModules, toModules) {
+		fromModule, inFrom := fromModules[name]
+		toModule, inTo := toModules[name]
+		diff := ModuleDiff{Name: name, Change: Changed, FromId: fromModule.Id, ToId: toModule.Id}
+		switch {
+		case !inFrom:
+			diff.Change = Added
+		case !inTo:
+			diff.Change = Removed
+		}
+		diff.Artifacts = compareItems(indexArtifacts(fromModule.Artifacts, fromModule.Id), indexArtifacts(toModule.Artifacts, toModule.Id))
+		diff.Dependencies = compareItems(indexDependencies(fromModule.Dependencies), indexDependencies(toModule.Dependencies))
+		if diff.Change == Changed && diff.FromId == diff.ToId && len(diff.Artifacts) == 0 && len(diff.Dependencies) == 0 {
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+	return
+}
+
+// An artifact or a dependency, as it is compared between the builds.
+type item struct {
+	version  string
+	checksum buildinfo.Checksum
+}
+
+func compareItems(from, to map[string]item) (diffs []ItemDiff) {
+	for _, name := range builds.SortedKeys(from, to) {
+		fromItem, inFrom := from[name]
+		toItem, inTo := to[name]
+		diff := ItemDiff{Name: name, FromVersion: fromItem.version, ToVersion: toItem.version,
+			FromChecksum: getChecksum(fromItem.checksum), ToChecksum: getChecksum(toItem.checksum)}
+		switch {
+		case !inFrom:
+			diff.Change = Added
+		case !inTo:
+			diff.Change = Removed
+		case fromItem.version != toItem.version || !isSameChecksum(fromItem.checksum, toItem.checksum):
+			diff.Change = Changed
+		default:
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+	return
+}
+
+func compareVcs(from, to []buildinfo.Vcs) (diffs []VcsDiff) {
+	fromVcs := make(map[string]buildinfo.Vcs)
+	for _, vcs := range from {
+		fromVcs[vcs.Url] = vcs
+	}
+	toVcs := make(map[string]buildinfo.Vcs)
+	for _, vcs := range to {
+		toVcs[vcs.Url] = vcs
+	}
+	for _, url := range builds.SortedKeys(fromVcs, toVcs) {
+		fromRevision, inFrom := fromVcs[url]
+		toRevision, inTo := toVcs[url]
+		diff := VcsDiff{Url: url, Change: Unchanged, FromRevision: fromRevision.Revision, ToRevision: toRevision.Revision, Branch: toRevision.Branch}
+		switch {
+		case !inFrom:
+			diff.Change = Added
+		case !inTo:
+			diff.Change, diff.Branch = Removed, fromRevision.Branch
+		case fromRevision.Revision != toRevision.Revision:
+			diff.Change = Changed
+		}
+		diffs = append(diffs, diff)
+	}
+	return
+}
+
+func compareEnv(from, to buildinfo.Env) (diffs []EnvDiff) {
+	fromEnv := getEnvVars(from)
+	toEnv := getEnvVars(to)
+	for _, name := range builds.SortedKeys(fromEnv, toEnv) {
+		fromValue, inFrom := fromEnv[name]
+		toValue, inTo := toEnv[name]
+		diff := EnvDiff{Name: name, From: fromValue, To: toValue}
+		switch {
+		case !inFrom:
+			diff.Change = Added
+		case !inTo:
+			diff.Change = Removed
+		case fromValue != toValue:
+			diff.Change = Changed
+		default:
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+	return
+}
+
+func compareIssues(from, to *buildinfo.Issues) (diffs []IssueDiff) {
+	fromIssues := indexIssues(from)
+	toIssues := indexIssues(to)
+	for _, key := range builds.SortedKeys(fromIssues, toIssues) {
+		fromIssue, inFrom := fromIssues[key]
+		toIssue, inTo := toIssues[key]
+		switch {
+		case !inFrom:
+			diffs = append(diffs, IssueDiff{Key: key, Change: Added, Summary: toIssue.Summary, Url: toIssue.Url})
+		case !inTo:
+			diffs = append(diffs, IssueDiff{Key: key, Change: Removed, Summary: fromIssue.Summary, Url: fromIssue.Url})
+		}
+	}
+	return
+}
+
+// Indexes the modules by their IDs without the versions.
+func indexModules(modules []buildinfo.Module) map[string]buildinfo.Module {
+	ids := make([]string, len(modules))
+	for i, module := range modules {
+		ids[i] = module.Id
+	}
+	keys := getKeys(ids)
+	index := make(map[string]buildinfo.Module)
+	for i, module := range modules {
+		index[keys[i]] = module
+	}
+	return index
+}
+
+// Indexes the artifacts by their paths without the version of the module, or by their names if the paths are unknown.
+// The paths of Maven, npm and NuGet artifacts include the version, such as 'org/acme/app/1.0/app-1.0.jar', which is replaced by '*',
+// so that the artifacts are matched between the builds the same way as the dependencies.
+func indexArtifacts(artifacts []buildinfo.Artifact, moduleId string) map[string]item {
+	_, moduleVersion := splitId(moduleId)
+	ids := make([]string, len(artifacts))
+	versions := make([]string, len(artifacts))
+	for i, artifact := range artifacts {
+		ids[i] = artifact.Path
+		if ids[i] == "" {
+			ids[i] = artifact.Name
+		}
+		if versionless := removeVersion(ids[i], moduleVersion); versionless != ids[i] {
+			ids[i], versions[i] = versionless+":"+moduleVersion, moduleVersion
+		}
+	}
+	keys := getKeys(ids)
+	index := make(map[string]item)
+	for i, artifact := range artifacts {
+		version := ""
+		if keys[i] != ids[i] {
+			version = versions[i]
+		}
+		index[keys[i]] = item{version: version, checksum: artifact.Checksum}
+	}
+	return index
+}
+
+// Replaces the version in the path of an artifact by '*', where it's delimited by the start or the end of the path, or by '/', '-', '_' or '.'.
+func removeVersion(path, version string) string {
+	if version == "" {
+		return path
+	}
+	versionRegexp := regexp.MustCompile(`(^|[/._-])` + regexp.QuoteMeta(version) + `([/._-]|$)`)
+	return versionRegexp.ReplaceAllString(path, "${1}*${2}")
+}
+
+// Indexes the dependencies by their IDs without the versions.
+func indexDependencies(dependencies []buildinfo.Dependency) map[string]item {
+	ids := make([]string, len(dependencies))
+	for i, dependency := range dependencies {
+		ids[i] = dependency.Id
+	}
+	keys := getKeys(ids)
+	index := make(map[string]item)
+	for i, dependency := range dependencies {
+		version := ""
+		if keys[i] != dependency.Id {
+			_, version = splitId(dependency.Id)
+		}
+		index[keys[i]] = item{version: version, checksum: dependency.Checksum}
+	}
+	return index
+}
+
+func indexIssues(issues *buildinfo.Issues) map[string]buildinfo.AffectedIssue {
+	index := make(map[string]buildinfo.AffectedIssue)
+	if issues == nil {
+		return index
+	}
+	for _, issue := range issues.AffectedIssues {
+		index[issue.Key] = issue
+	}
+	return index
+}
+
+// Returns the environment variables which were collected into the build, without the build info prefix.
+func getEnvVars(properties buildinfo.Env) map[string]string {
+	envVars := make(map[string]string)
+	for key, value := range properties {
+		if name, found := strings.CutPrefix(key, buildinfo.BuildInfoEnvPrefix); found {
+			envVars[name] = value
+		}
+	}
+	return envVars
+}
+
+// Returns the keys which match the IDs between the builds. The key of an ID is the ID without the version,
+// unless several IDs share the same key. Such IDs, like the layers of a Docker image, are matched by the full ID.
+func getKeys(ids []string) []string {
+	count := make(map[string]int)
+	for _, id := range ids {
+		name, _ := splitId(id)
+		count[name]++
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = id
+		if name, _ := splitId(id); count[name] == 1 {
+			keys[i] = name
+		}
+	}
+	return keys
+}
+
+// Splits an ID, such as 'org.jfrog:artifact:1.0.0' or 'lodash:4.17.21', to the name and the version.
+func splitId(id string) (name, version string) {
+	i := strings.LastIndex(id, ":")
+	if i <= 0 {
+		return id, ""
+	}
+	return id[:i], id[i+1:]
+}
+
+// Compares the strongest checksum which is known in both builds. Items which have no checksums are considered the same.
+func isSameChecksum(from, to buildinfo.Checksum) bool {
+	switch {
+	case from.Sha256 != "" && to.Sha256 != "":
+		return from.Sha256 == to.Sha256
+	case from.Sha1 != "" && to.Sha1 != "":
+		return from.Sha1 == to.Sha1
+	case from.Md5 != "" && to.Md5 != "":
+		return from.Md5 == to.Md5
+	}
+	return true
+}
+
+func getChecksum(checksum buildinfo.Checksum) string {
+	switch {
+	case checksum.Sha1 != "":
+		return checksum.Sha1
+	case checksum.Sha256 != "":
+		return checksum.Sha256
+	}
+	return checksum.Md5
+}
@@ -0,0 +1,139 @@
+package builddiff
+
+import (
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestBuilds() (from, to *buildinfo.BuildInfo) {
+	from = &buildinfo.BuildInfo{
+		Name:   "app",
+		Number: "1",
+		Modules: []buildinfo.Module{
+			{
+				Id: "org.acme:app:1.0",
+				Artifacts: []buildinfo.Artifact{
+					{Name: "app-1.0.jar", Path: "org/acme/app/1.0/app-1.0.jar", Checksum: buildinfo.Checksum{Sha1: "aaa"}},
+					{Name: "app-1.0.pom", Path: "org/acme/app/1.0/app-1.0.pom", Checksum: buildinfo.Checksum{Sha1: "bbb"}},
+				},
+				Dependencies: []buildinfo.Dependency{
+					{Id: "org.slf4j:slf4j-api:1.7.36", Checksum: buildinfo.Checksum{Sha1: "s1"}},
+					{Id: "junit:junit:4.13.2", Checksum: buildinfo.Checksum{Sha1: "j1"}},
+					{Id: "commons-io:commons-io:2.11.0", Checksum: buildinfo.Checksum{Sha1: "c1"}},
+				},
+			},
+			{Id: "docs", Artifacts: []buildinfo.Artifact{{Name: "docs.zip", Checksum: buildinfo.Checksum{Sha1: "d1"}}}},
+		},
+		VcsList: []buildinfo.Vcs{{Url: "https://github.com/acme/app.git", Revision: "r1", Branch: "main"}},
+		Properties: buildinfo.Env{
+			"buildInfo.env.JAVA_VERSION": "17",
+			"buildInfo.env.CI":           "true",
+			"buildInfo.env.OLD":          "value",
+			"build.property":             "ignored",
+		},
+		Issues: &buildinfo.Issues{AffectedIssues: []buildinfo.AffectedIssue{{Key: "APP-1", Summary: "First"}}},
+	}
+	to = &buildinfo.BuildInfo{
+		Name:   "app",
+		Number: "2",
+		Modules: []buildinfo.Module{
+			{
+				Id: "org.acme:app:1.1",
+				Artifacts: []buildinfo.Artifact{
+					{Name: "app-1.1.jar", Path: "org/acme/app/1.1/app-1.1.jar", Checksum: buildinfo.Checksum{Sha1: "ccc"}},
+					{Name: "app-1.1.pom", Path: "org/acme/app/1.1/app-1.1.pom", Checksum: buildinfo.Checksum{Sha1: "bbb"}},
+				},
+				Dependencies: []buildinfo.Dependency{
+					{Id: "org.slf4j:slf4j-api:2.0.9", Checksum: buildinfo.Checksum{Sha1: "s2"}},
+					{Id: "junit:junit:4.13.2", Checksum: buildinfo.Checksum{Sha1: "j1"}},
+					{Id: "com.google.guava:guava:32.1.2", Checksum: buildinfo.Checksum{Sha1: "g1"}},
+				},
+			},
+		},
+		VcsList: []buildinfo.Vcs{{Url: "https://github.com/acme/app.git", Revision: "r2", Branch: "main"}},
+		Properties: buildinfo.Env{
+			"buildInfo.env.JAVA_VERSION": "21",
+			"buildInfo.env.CI":           "true",
+			"buildInfo.env.NEW":          "value",
+		},
+		Issues: &buildinfo.Issues{AffectedIssues: []buildinfo.AffectedIssue{{Key: "APP-2", Summary: "Second"}}},
+	}
+	return
+}
+
+func TestCompare(t *testing.T) {
+	from, to := getTestBuilds()
+	diff := Compare(from, to)
+	assert.Equal(t, BuildRef{Name: "app", Number: "1"}, diff.From)
+	assert.False(t, diff.IsEmpty())
+
+	if assert.Len(t, diff.Modules, 2) {
+		assert.Equal(t, ModuleDiff{Name: "docs", Change: Removed, FromId: "docs",
+			Artifacts: []ItemDiff{{Name: "docs.zip", Change: Removed, FromChecksum: "d1"}}}, diff.Modules[0])
+
+		module := diff.Modules[1]
+		assert.Equal(t, "org.acme:app", module.Name)
+		assert.Equal(t, Changed, module.Change)
+		assert.Equal(t, "org.acme:app:1.0", module.FromId)
+		assert.Equal(t, "org.acme:app:1.1", module.ToId)
+		// The artifacts are matched without the versions in their paths.
+		assert.Equal(t, []ItemDiff{
+			{Name: "org/acme/app/*/app-*.jar", Change: Changed, FromVersion: "1.0", ToVersion: "1.1", FromChecksum: "aaa", ToChecksum: "ccc"},
+			{Name: "org/acme/app/*/app-*.pom", Change: Changed, FromVersion: "1.0", ToVersion: "1.1", FromChecksum: "bbb", ToChecksum: "bbb"},
+		}, module.Artifacts)
+		assert.Equal(t, []ItemDiff{
+			{Name: "com.google.guava:guava", Change: Added, ToVersion: "32.1.2", ToChecksum: "g1"},
+			{Name: "commons-io:commons-io", Change: Removed, FromVersion: "2.11.0", FromChecksum: "c1"},
+			{Name: "org.slf4j:slf4j-api", Change: Changed, FromVersion: "1.7.36", ToVersion: "2.0.9", FromChecksum: "s1", ToChecksum: "s2"},
+		}, module.Dependencies)
+	}
+	assert.Equal(t, []VcsDiff{{Url: "https://github.com/acme/app.git", Change: Changed, FromRevision: "r1", ToRevision: "r2", Branch: "main"}}, diff.Vcs)
+	assert.Equal(t, []EnvDiff{
+		{Name: "JAVA_VERSION", Change: Changed, From: "17", To: "21"},
+		{Name: "NEW", Change: Added, To: "value"},
+		{Name: "OLD", Change: Removed, From: "value"},
+	}, diff.Env)
+	assert.Equal(t, []IssueDiff{{Key: "APP-1", Change: Removed, Summary: "First"}, {Key: "APP-2", Change: Added, Summary: "Second"}}, diff.Issues)
+
+	assert.True(t, Compare(from, from).IsEmpty())
+}
+
+func TestRemoveVersion(t *testing.T) {
+	assert.Equal(t, "org/acme/app/*/app-*.jar", removeVersion("org/acme/app/1.0.0/app-1.0.0.jar", "1.0.0"))
+	assert.Equal(t, "app/-/app-*.tgz", removeVersion("app/-/app-2.1.0.tgz", "2.1.0"))
+	assert.Equal(t, "Acme.App.*.nupkg", removeVersion("Acme.App.3.0.1.nupkg", "3.0.1"))
+	// A version which is part of another word isn't replaced.
+	assert.Equal(t, "lib1/app-11.jar", removeVersion("lib1/app-11.jar", "1"))
+	assert.Equal(t, "docs.zip", removeVersion("docs.zip", ""))
+}
+
+func TestCompareSharedNames(t *testing.T) {
+	// Docker layers share the 'sha256' prefix, so they are matched by their full IDs.
+	from := &buildinfo.BuildInfo{Modules: []buildinfo.Module{{Id: "app:1", Dependencies: []buildinfo.Dependency{{Id: "sha256:aaa"}, {Id: "sha256:bbb"}}}}}
+	to := &buildinfo.BuildInfo{Modules: []buildinfo.Module{{Id: "app:1", Dependencies: []buildinfo.Dependency{{Id: "sha256:aaa"}, {Id: "sha256:ccc"}}}}}
+	diff := Compare(from, to)
+	if assert.Len(t, diff.Modules, 1) {
+		assert.Equal(t, []ItemDiff{{Name: "sha256:bbb", Change: Removed}, {Name: "sha256:ccc", Change: Added}}, diff.Modules[0].Dependencies)
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	from, to := getTestBuilds()
+	markdown := toMarkdown("Differences", getSections(Compare(from, to)))
+	assert.Contains(t, markdown, "| Module | Name | Change | From | To |\n| --- | --- | --- | --- | --- |\n")
+	assert.Contains(t, markdown, "| org.acme:app | org.slf4j:slf4j-api | changed | 1.7.36 (s1) | 2.0.9 (s2) |")
+	assert.Contains(t, markdown, "### Issues\n\n| Key | Change | Summary | URL |")
+
+	markdown = toMarkdown("Differences", getSections(Compare(from, from)))
+	assert.Contains(t, markdown, "### Artifacts\n\nNo artifacts were changed.\n")
+}
+
+func TestGetOutputFormat(t *testing.T) {
+	outputFormat, err := GetOutputFormat("Markdown")
+	assert.NoError(t, err)
+	assert.Equal(t, Markdown, outputFormat)
+	_, err = GetOutputFormat("csv")
+	assert.ErrorContains(t, err, "table, json, markdown")
+}
@@ -0,0 +1,190 @@
+package builddiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Markdown prints the differences as Markdown tables, which can be added to a pull request or to release notes.
+const Markdown format.OutputFormat = "markdown"
+
+// The length of the checksums which are printed in tables.
+const shortChecksumLength = 12
+
+// GetOutputFormat returns the output format matching the value of the --format option. The default is a table.
+func GetOutputFormat(value string) (format.OutputFormat, error) {
+	switch strings.ToLower(value) {
+	case "", string(format.Table):
+		return format.Table, nil
+	case string(format.Json):
+		return format.Json, nil
+	case string(Markdown):
+		return Markdown, nil
+	default:
+		return "", errorutils.CheckErrorf("only the following output formats are supported: %s, %s, %s", format.Table, format.Json, Markdown)
+	}
+}
+
+type moduleRow struct {
+	Module string `col-name:"Module"`
+	Change string `col-name:"Change"`
+	From   string `col-name:"From"`
+	To     string `col-name:"To"`
+}
+
+type itemRow struct {
+	Module string `col-name:"Module"`
+	Name   string `col-name:"Name"`
+	Change string `col-name:"Change"`
+	From   string `col-name:"From"`
+	To     string `col-name:"To"`
+}
+
+type vcsRow struct {
+	Url    string `col-name:"URL"`
+	Branch string `col-name:"Branch"`
+	Change string `col-name:"Change"`
+	From   string `col-name:"From Revision"`
+	To     string `col-name:"To Revision"`
+}
+
+type envRow struct {
+	Name   string `col-name:"Name"`
+	Change string `col-name:"Change"`
+	From   string `col-name:"From"`
+	To     string `col-name:"To"`
+}
+
+type issueRow struct {
+	Key     string `col-name:"Key"`
+	Change  string `col-name:"Change"`
+	Summary string `col-name:"Summary"`
+	Url     string `col-name:"URL"`
+}
+
+// A section of the differences, which is printed as a table.
+type section struct {
+	title        string
+	emptyMessage string
+	rows         interface{}
+}
+
+// PrintDiff prints the differences between the builds as tables, as JSON or as Markdown.
+func PrintDiff(diff *Diff, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		content, err := json.Marshal(diff)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(clientutils.IndentJson(content))
+		return nil
+	}
+	title := fmt.Sprintf("Differences between %s and %s", diff.From, diff.To)
+	sections := getSections(diff)
+	if outputFormat == Markdown {
+		log.Output(toMarkdown(title, sections))
+		return nil
+	}
+	log.Output(title)
+	for _, section := range sections {
+		if err := coreutils.PrintTable(section.rows, section.title, section.emptyMessage, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getSections(diff *Diff) []section {
+	var modules []moduleRow
+	var artifacts, dependencies []itemRow
+	for _, module := range diff.Modules {
+		if module.FromId != module.ToId {
+			modules = append(modules, moduleRow{Module: module.Name, Change: string(module.Change), From: module.FromId, To: module.ToId})
+		}
+		artifacts = append(artifacts, toItemRows(module.Name, module.Artifacts)...)
+		dependencies = append(dependencies, toItemRows(module.Name, module.Dependencies)...)
+	}
+	var vcs []vcsRow
+	for _, vcsDiff := range diff.Vcs {
+		vcs = append(vcs, vcsRow{Url: vcsDiff.Url, Branch: vcsDiff.Branch, Change: string(vcsDiff.Change), From: vcsDiff.FromRevision, To: vcsDiff.ToRevision})
+	}
+	var env []envRow
+	for _, envDiff := range diff.Env {
+		env = append(env, envRow{Name: envDiff.Name, Change: string(envDiff.Change), From: envDiff.From, To: envDiff.To})
+	}
+	var issues []issueRow
+	for _, issueDiff := range diff.Issues {
+		issues = append(issues, issueRow{Key: issueDiff.Key, Change: string(issueDiff.Change), Summary: issueDiff.Summary, Url: issueDiff.Url})
+	}
+	return []section{
+		{title: "Modules", emptyMessage: "No modules were added, removed or renamed", rows: modules},
+		{title: "Artifacts", emptyMessage: "No artifacts were changed", rows: artifacts},
+		{title: "Dependencies", emptyMessage: "No dependencies were changed", rows: dependencies},
+		{title: "VCS", emptyMessage: "No VCS information was collected", rows: vcs},
+		{title: "Environment Variables", emptyMessage: "No environment variables were changed", rows: env},
+		{title: "Issues", emptyMessage: "No issues were added or removed", rows: issues},
+	}
+}
+
+func toItemRows(module string, items []ItemDiff) (rows []itemRow) {
+	for _, item := range items {
+		rows = append(rows, itemRow{Module: module, Name: item.Name, Change: string(item.Change),
+			From: describeItem(item.FromVersion, item.FromChecksum), To: describeItem(item.ToVersion, item.ToChecksum)})
+	}
+	return
+}
+
+// Describes an item by its version and its shortened checksum.
+func describeItem(version, checksum string) string {
+	if len(checksum) > shortChecksumLength {
+		checksum = checksum[:shortChecksumLength]
+	}
+	if version == "" || checksum == "" {
+		return version + checksum
+	}
+	return fmt.Sprintf("%s (%s)", version, checksum)
+}
+
+func toMarkdown(title string, sections []section) string {
+	var builder strings.Builder
+	builder.WriteString("## " + title + "\n")
+	for _, section := range sections {
+		builder.WriteString("\n### " + section.title + "\n\n")
+		rows := reflect.ValueOf(section.rows)
+		if rows.Len() == 0 {
+			builder.WriteString(section.emptyMessage + ".\n")
+			continue
+		}
+		rowType := rows.Type().Elem()
+		var columns, separators []string
+		for i := 0; i < rowType.NumField(); i++ {
+			columns = append(columns, rowType.Field(i).Tag.Get("col-name"))
+			separators = append(separators, "---")
+		}
+		writeMarkdownRow(&builder, columns)
+		writeMarkdownRow(&builder, separators)
+		for i := 0; i < rows.Len(); i++ {
+			var cells []string
+			for j := 0; j < rowType.NumField(); j++ {
+				cells = append(cells, rows.Index(i).Field(j).String())
+			}
+			writeMarkdownRow(&builder, cells)
+		}
+	}
+	return builder.String()
+}
+
+func writeMarkdownRow(builder *strings.Builder, cells []string) {
+	for i, cell := range cells {
+		cells[i] = strings.ReplaceAll(strings.ReplaceAll(cell, "|", "\\|"), "\n", " ")
+	}
+	builder.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+}
@@ -0,0 +1,241 @@
+package builddiscard
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// DiscardedBuild is a build number which was discarded, or which would be discarded if it isn't a dry run.
+type DiscardedBuild struct {
+	Name    string `json:"name"`
+	Number  string `json:"number"`
+	Started string `json:"started"`
+	// The number and the total size of the artifacts of the build, which are deleted with it.
+	// Only counted by a dry run, when the artifacts are deleted.
+	Artifacts int   `json:"artifacts,omitempty"`
+	Size      int64 `json:"size,omitempty"`
+}
+
+// Summary sums up the discarded build numbers of a build name.
+type Summary struct {
+	Name      string `json:"name"`
+	Builds    int    `json:"builds"`
+	Discarded int    `json:"discarded"`
+	Artifacts int    `json:"artifacts,omitempty"`
+	Size      int64  `json:"size,omitempty"`
+}
+
+// The retention policy of the build-discard command, as applied by Artifactory.
+type policy struct {
+	// Builds which started before this time are discarded. Zero if there's no limit.
+	minimumBuildDate time.Time
+	// Only the most recent builds are kept. Negative if there's no limit.
+	maxBuilds int
+	// Build numbers which are never discarded.
+	excludeBuilds map[string]bool
+}
+
+// DiscardCommand discards the builds of the build names which match a pattern,
+// or only lists the builds which would be discarded by the retention parameters, in a dry run.
+type DiscardCommand struct {
+	serverDetails *config.ServerDetails
+	services.DiscardBuildsParams
+	buildNamePattern string
+	dryRun           bool
+	discarded        []DiscardedBuild
+	summaries        []Summary
+}
+
+func NewDiscardCommand() *DiscardCommand {
+	return &DiscardCommand{}
+}
+
+func (dc *DiscardCommand) SetServerDetails(serverDetails *config.ServerDetails) *DiscardCommand {
+	dc.serverDetails = serverDetails
+	return dc
+}
+
+func (dc *DiscardCommand) SetDiscardBuildsParams(params services.DiscardBuildsParams) *DiscardCommand {
+	dc.DiscardBuildsParams = params
+	return dc
+}
+
+// SetBuildNamePattern sets a wildcard pattern of the build names to discard builds of, instead of the build name of the params.
+func (dc *DiscardCommand) SetBuildNamePattern(buildNamePattern string) *DiscardCommand {
+	dc.buildNamePattern = buildNamePattern
+	return dc
+}
+
+func (dc *DiscardCommand) SetDryRun(dryRun bool) *DiscardCommand {
+	dc.dryRun = dryRun
+	return dc
+}
+
+func (dc *DiscardCommand) Discarded() []DiscardedBuild {
+	return dc.discarded
+}
+
+func (dc *DiscardCommand) Summaries() []Summary {
+	return dc.summaries
+}
+
+func (dc *DiscardCommand) IsDryRun() bool {
+	return dc.dryRun
+}
+
+// IsCountingArtifacts returns true if the artifacts of the discarded builds were counted.
+func (dc *DiscardCommand) IsCountingArtifacts() bool {
+	return dc.dryRun && dc.DeleteArtifacts
+}
+
+func (dc *DiscardCommand) ServerDetails() (*config.ServerDetails, error) {
+	return dc.serverDetails, nil
+}
+
+func (dc *DiscardCommand) CommandName() string {
+	return "rt_build_discard"
+}
+
+func (dc *DiscardCommand) Run() error {
+	discardPolicy, err := dc.getPolicy(time.Now())
+	if err != nil {
+		return err
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(dc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	names := []string{dc.BuildName}
+	if dc.buildNamePattern != "" {
+		if names, err = builds.ListBuildNames(servicesManager, dc.buildNamePattern, dc.ProjectKey); err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Found %d build names matching '%s'.", len(names), dc.buildNamePattern))
+	}
+	dc.discarded, dc.summaries = nil, nil
+	// A failure of one build name doesn't stop the others, so that the report covers all the build names which succeeded.
+	var errs []error
+	for _, name := range names {
+		if err = dc.discardBuilds(servicesManager, name, discardPolicy); err != nil {
+			log.Error(fmt.Sprintf("Failed to discard the builds of '%s': %s", name, err.Error()))
+			errs = append(errs, fmt.Errorf("build name '%s': %w", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errorutils.CheckErrorf("failed to discard the builds of %d of %d build names:\n%s", len(errs), len(names), errors.Join(errs...).Error())
+	}
+	return nil
+}
+
+func (dc *DiscardCommand) discardBuilds(servicesManager artifactory.ArtifactoryServicesManager, name string, discardPolicy *policy) error {
+	runs, err := builds.ListBuildRuns(servicesManager, name, dc.ProjectKey)
+	if err != nil {
+		return err
+	}
+	selected := selectDiscarded(runs, discardPolicy)
+	summary := Summary{Name: name, Builds: len(runs), Discarded: len(selected)}
+	// Artifacts may belong to more than one build, so they're counted once in the summary.
+	artifacts := map[string]int64{}
+	var discarded []DiscardedBuild
+	for _, run := range selected {
+		discardedBuild := DiscardedBuild{Name: name, Number: run.Number, Started: run.Started}
+		if dc.IsCountingArtifacts() {
+			items, err := builds.SearchBuildItems(servicesManager, buildindex.Build{Name: name, Number: run.Number, Project: dc.ProjectKey}, "artifact")
+			if err != nil {
+				return err
+			}
+			discardedBuild.Artifacts, discardedBuild.Size = countItems(items, artifacts)
+		}
+		discarded = append(discarded, discardedBuild)
+	}
+	for _, size := range artifacts {
+		summary.Artifacts++
+		summary.Size += size
+	}
+	if !dc.dryRun {
+		// The builds are discarded by Artifactory, which applies the retention parameters itself.
+		params := dc.DiscardBuildsParams
+		params.BuildName = name
+		if err = servicesManager.DiscardBuilds(params); err != nil {
+			return err
+		}
+	}
+	// The build name is reported only once its builds are discarded.
+	dc.discarded = append(dc.discarded, discarded...)
+	dc.summaries = append(dc.summaries, summary)
+	return nil
+}
+
+func (dc *DiscardCommand) getPolicy(now time.Time) (*policy, error) {
+	discardPolicy := &policy{maxBuilds: -1, excludeBuilds: map[string]bool{}}
+	if dc.MaxDays != "" {
+		maxDays, err := strconv.Atoi(dc.MaxDays)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("the value of --max-days should be a number, but is '%s'", dc.MaxDays)
+		}
+		discardPolicy.minimumBuildDate = now.Add(-24 * time.Hour * time.Duration(maxDays))
+	}
+	if dc.MaxBuilds != "" {
+		maxBuilds, err := strconv.Atoi(dc.MaxBuilds)
+		if err != nil || maxBuilds < 0 {
+			return nil, errorutils.CheckErrorf("the value of --max-builds should be a non-negative number, but is '%s'", dc.MaxBuilds)
+		}
+		discardPolicy.maxBuilds = maxBuilds
+	}
+	for _, number := range strings.Split(dc.ExcludeBuilds, ",") {
+		if number = strings.TrimSpace(number); number != "" {
+			discardPolicy.excludeBuilds[number] = true
+		}
+	}
+	return discardPolicy, nil
+}
+
+// Returns the runs the policy discards. The runs are sorted from the most recent.
+// Builds which started before the minimum date are discarded, and then only the most recent builds are kept.
+// Excluded builds are never discarded.
+func selectDiscarded(runs []builds.BuildRun, discardPolicy *policy) []builds.BuildRun {
+	var discarded []builds.BuildRun
+	kept := 0
+	for _, run := range runs {
+		if discardPolicy.excludeBuilds[run.Number] {
+			continue
+		}
+		started, err := time.Parse(buildinfo.TimeFormat, run.Started)
+		old := !discardPolicy.minimumBuildDate.IsZero() && err == nil && started.Before(discardPolicy.minimumBuildDate)
+		if old || (discardPolicy.maxBuilds >= 0 && kept >= discardPolicy.maxBuilds) {
+			discarded = append(discarded, run)
+			continue
+		}
+		kept++
+	}
+	return discarded
+}
+
+// Returns the number and the total size of the items, and adds them to the counted items.
+func countItems(items []builds.AqlItem, counted map[string]int64) (count int, size int64) {
+	paths := map[string]bool{}
+	for _, item := range items {
+		itemPath := item.Repo + "/" + item.Path + "/" + item.Name
+		if paths[itemPath] {
+			continue
+		}
+		paths[itemPath] = true
+		count++
+		size += item.Size
+		counted[itemPath] = item.Size
+	}
+	return
+}
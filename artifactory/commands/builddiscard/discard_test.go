@@ -0,0 +1,86 @@
+package builddiscard
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPolicy(t *testing.T) {
+	now := time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC)
+	dc := NewDiscardCommand().SetDiscardBuildsParams(services.DiscardBuildsParams{MaxDays: "3", MaxBuilds: "5", ExcludeBuilds: "1, 2,"})
+	discardPolicy, err := dc.getPolicy(now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 10, 7, 0, 0, 0, 0, time.UTC), discardPolicy.minimumBuildDate)
+	assert.Equal(t, 5, discardPolicy.maxBuilds)
+	assert.Equal(t, map[string]bool{"1": true, "2": true}, discardPolicy.excludeBuilds)
+
+	discardPolicy, err = NewDiscardCommand().getPolicy(now)
+	assert.NoError(t, err)
+	assert.True(t, discardPolicy.minimumBuildDate.IsZero())
+	assert.Equal(t, -1, discardPolicy.maxBuilds)
+
+	_, err = NewDiscardCommand().SetDiscardBuildsParams(services.DiscardBuildsParams{MaxDays: "week"}).getPolicy(now)
+	assert.Error(t, err)
+	_, err = NewDiscardCommand().SetDiscardBuildsParams(services.DiscardBuildsParams{MaxBuilds: "-1"}).getPolicy(now)
+	assert.Error(t, err)
+}
+
+func TestSelectDiscarded(t *testing.T) {
+	now := time.Now()
+	var runs []builds.BuildRun
+	// Builds 6 to 1, from the most recent, one per day.
+	for i := 6; i >= 1; i-- {
+		runs = append(runs, newRun(i, now.Add(-time.Duration(6-i)*24*time.Hour-time.Hour)))
+	}
+	testCases := []struct {
+		name     string
+		policy   *policy
+		expected []string
+	}{
+		{"no limits", &policy{maxBuilds: -1}, nil},
+		{"max builds", &policy{maxBuilds: 4}, []string{"2", "1"}},
+		{"max builds with excluded", &policy{maxBuilds: 2, excludeBuilds: map[string]bool{"5": true, "1": true}}, []string{"3", "2"}},
+		{"max days", &policy{maxBuilds: -1, minimumBuildDate: now.Add(-3 * 24 * time.Hour)}, []string{"3", "2", "1"}},
+		{"max days and builds", &policy{maxBuilds: 1, minimumBuildDate: now.Add(-3 * 24 * time.Hour)}, []string{"5", "4", "3", "2", "1"}},
+		{"zero builds", &policy{maxBuilds: 0, excludeBuilds: map[string]bool{"6": true}}, []string{"5", "4", "3", "2", "1"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var numbers []string
+			for _, run := range selectDiscarded(runs, testCase.policy) {
+				numbers = append(numbers, run.Number)
+			}
+			assert.Equal(t, testCase.expected, numbers)
+		})
+	}
+}
+
+func TestCountItems(t *testing.T) {
+	counted := map[string]int64{}
+	count, size := countItems([]builds.AqlItem{
+		{Repo: "libs", Path: "a", Name: "a.jar", Size: 100},
+		{Repo: "libs", Path: "a", Name: "a.jar", Size: 100},
+		{Repo: "libs", Path: "a", Name: "a.pom", Size: 20},
+	}, counted)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, int64(120), size)
+	countItems([]builds.AqlItem{{Repo: "libs", Path: "a", Name: "a.jar", Size: 100}}, counted)
+	assert.Len(t, counted, 2)
+}
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "0 B", formatSize(0))
+	assert.Equal(t, "1023 B", formatSize(1023))
+	assert.Equal(t, "1.5 KiB", formatSize(1536))
+	assert.Equal(t, "3.0 GiB", formatSize(3*1024*1024*1024))
+}
+
+func newRun(number int, started time.Time) builds.BuildRun {
+	return builds.BuildRun{Name: "app", Number: strconv.Itoa(number), Started: started.Format(buildinfo.TimeFormat)}
+}
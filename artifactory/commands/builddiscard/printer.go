@@ -0,0 +1,83 @@
+package builddiscard
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type discardedRow struct {
+	Name      string `col-name:"Build Name"`
+	Number    string `col-name:"Build Number"`
+	Started   string `col-name:"Started"`
+	Artifacts string `col-name:"Artifacts" omitempty:"true"`
+	Size      string `col-name:"Size" omitempty:"true"`
+}
+
+type summaryRow struct {
+	Name      string `col-name:"Build Name"`
+	Builds    string `col-name:"Builds"`
+	Discarded string `col-name:"Discarded"`
+	Artifacts string `col-name:"Artifacts" omitempty:"true"`
+	Size      string `col-name:"Size" omitempty:"true"`
+}
+
+// PrintReport prints the discarded builds, and a summary of the build names if more than one was matched.
+func PrintReport(dc *DiscardCommand) error {
+	title, verb := "Discarded Builds", "were discarded"
+	if dc.IsDryRun() {
+		// The builds are selected by the CLI the same way as by Artifactory, which applies the retention parameters when the builds are discarded.
+		title, verb = "Builds to Discard (Estimate)", "would be discarded, by estimate"
+	}
+	var discarded []discardedRow
+	for _, b := range dc.Discarded() {
+		row := discardedRow{Name: b.Name, Number: b.Number, Started: b.Started}
+		if dc.IsCountingArtifacts() {
+			row.Artifacts, row.Size = strconv.Itoa(b.Artifacts), formatSize(b.Size)
+		}
+		discarded = append(discarded, row)
+	}
+	if err := coreutils.PrintTable(discarded, title, "No builds "+verb, false); err != nil {
+		return err
+	}
+	total := Summary{}
+	var summaries []summaryRow
+	for _, summary := range dc.Summaries() {
+		row := summaryRow{Name: summary.Name, Builds: strconv.Itoa(summary.Builds), Discarded: strconv.Itoa(summary.Discarded)}
+		if dc.IsCountingArtifacts() {
+			row.Artifacts, row.Size = strconv.Itoa(summary.Artifacts), formatSize(summary.Size)
+		}
+		summaries = append(summaries, row)
+		total.Builds += summary.Builds
+		total.Discarded += summary.Discarded
+		total.Artifacts += summary.Artifacts
+		total.Size += summary.Size
+	}
+	if len(summaries) > 1 {
+		if err := coreutils.PrintTable(summaries, "Summary", "", false); err != nil {
+			return err
+		}
+	}
+	message := fmt.Sprintf("%d of %d builds of %d build names %s", total.Discarded, total.Builds, len(summaries), verb)
+	if dc.IsCountingArtifacts() {
+		message += fmt.Sprintf(", along with about %d artifacts (%s)", total.Artifacts, formatSize(total.Size))
+	}
+	log.Info(message + ".")
+	return nil
+}
+
+// Formats the size in bytes with a binary unit, such as '1.5 MiB'.
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
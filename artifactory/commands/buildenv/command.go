@@ -0,0 +1,97 @@
+package buildenv
+
+import (
+	"fmt"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// CollectFactsCommand adds the versions of the tools found on the PATH and the details of the host to the build-info properties.
+type CollectFactsCommand struct {
+	buildConfiguration *build.BuildConfiguration
+	tools              bool
+	host               bool
+	// The patterns, separated by semicolons, of the facts to exclude.
+	excludePatterns string
+	containerImage  string
+}
+
+func NewCollectFactsCommand() *CollectFactsCommand {
+	return &CollectFactsCommand{}
+}
+
+func (cfc *CollectFactsCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *CollectFactsCommand {
+	cfc.buildConfiguration = buildConfiguration
+	return cfc
+}
+
+func (cfc *CollectFactsCommand) SetTools(tools bool) *CollectFactsCommand {
+	cfc.tools = tools
+	return cfc
+}
+
+func (cfc *CollectFactsCommand) SetHost(host bool) *CollectFactsCommand {
+	cfc.host = host
+	return cfc
+}
+
+func (cfc *CollectFactsCommand) SetExcludePatterns(excludePatterns string) *CollectFactsCommand {
+	cfc.excludePatterns = excludePatterns
+	return cfc
+}
+
+// SetContainerImage sets the image of the container the CLI runs in, which can't be detected from inside the container.
+func (cfc *CollectFactsCommand) SetContainerImage(containerImage string) *CollectFactsCommand {
+	cfc.containerImage = containerImage
+	return cfc
+}
+
+func (cfc *CollectFactsCommand) Run() error {
+	buildName, err := cfc.buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := cfc.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	facts := buildinfo.Env{}
+	if cfc.tools {
+		log.Info("Collecting the versions of the tools...")
+		for key, value := range collectTools() {
+			facts[key] = value
+		}
+	}
+	if cfc.host {
+		log.Info("Collecting the details of the host...")
+		for key, value := range collectHost(cfc.containerImage) {
+			facts[key] = value
+		}
+	}
+	if err = excludeFacts(facts, strings.Split(cfc.excludePatterns, ";")); err != nil {
+		return err
+	}
+	if len(facts) == 0 {
+		return nil
+	}
+	if err = build.SavePartialBuildInfo(buildName, buildNumber, cfc.buildConfiguration.GetProject(), func(partial *buildinfo.Partial) {
+		partial.Env = facts
+	}); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Collected %d tool and host facts for %s/%s.", len(facts), buildName, buildNumber))
+	return nil
+}
+
+// Returns the default configured Artifactory server, like 'jf rt bce'.
+func (cfc *CollectFactsCommand) ServerDetails() (*config.ServerDetails, error) {
+	return config.GetDefaultServerConf()
+}
+
+func (cfc *CollectFactsCommand) CommandName() string {
+	return "rt_build_collect_facts"
+}
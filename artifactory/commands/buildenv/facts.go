@@ -0,0 +1,237 @@
+package buildenv
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The prefixes of the build-info properties of the tools found on the PATH, and of the host, like 'buildInfo.env.' for environment variables.
+	ToolsPrefix = "buildInfo.tools."
+	HostPrefix  = "buildInfo.host."
+
+	toolTimeout = 30 * time.Second
+)
+
+// A tool whose version is collected. The first executable found on the PATH is run with the arguments, and the version is parsed from its output.
+type tool struct {
+	name        string
+	executables []string
+	args        []string
+}
+
+var tools = []tool{
+	{name: "go", executables: []string{"go"}, args: []string{"version"}},
+	{name: "java", executables: []string{"java"}, args: []string{"-version"}},
+	{name: "mvn", executables: []string{"mvn"}, args: []string{"--version"}},
+	{name: "gradle", executables: []string{"gradle"}, args: []string{"--version"}},
+	{name: "node", executables: []string{"node"}, args: []string{"--version"}},
+	{name: "npm", executables: []string{"npm"}, args: []string{"--version"}},
+	{name: "python", executables: []string{"python3", "python"}, args: []string{"--version"}},
+	{name: "dotnet", executables: []string{"dotnet"}, args: []string{"--version"}},
+}
+
+var (
+	versionRegexp = regexp.MustCompile(`\d+(\.\d+)+([-+][0-9A-Za-z.-]+)?`)
+	// The ID of a container in a line of /proc/self/cgroup, such as '0::/system.slice/docker-<id>.scope' or '12:cpu:/kubepods/pod1/<id>'.
+	cgroupContainerIdRegexp = regexp.MustCompile(`[/-]([0-9a-f]{64})(\.scope)?$`)
+	// With cgroup v2, the ID of a Docker container appears in the mounts of its files, such as '/var/lib/docker/containers/<id>/hostname'.
+	mountContainerIdRegexp = regexp.MustCompile(`/containers/([0-9a-f]{64})/`)
+)
+
+// Returns the versions of the tools found on the PATH.
+func collectTools() map[string]string {
+	facts := map[string]string{}
+	for _, t := range tools {
+		if version := getToolVersion(t); version != "" {
+			facts[ToolsPrefix+t.name+".version"] = version
+		}
+	}
+	return facts
+}
+
+func getToolVersion(t tool) string {
+	for _, executable := range t.executables {
+		executablePath, err := exec.LookPath(executable)
+		if err != nil {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), toolTimeout)
+		// Some tools, such as java, print their versions to the standard error.
+		output, err := exec.CommandContext(ctx, executablePath, t.args...).CombinedOutput()
+		cancel()
+		if err != nil {
+			log.Debug("Failed to get the version of " + t.name + ": " + err.Error())
+			continue
+		}
+		if version := parseVersion(string(output)); version != "" {
+			return version
+		}
+	}
+	return ""
+}
+
+// Returns the first version in the output of a tool, such as '1.21.5' in 'go version go1.21.5 linux/amd64'.
+func parseVersion(output string) string {
+	return versionRegexp.FindString(output)
+}
+
+// Returns the operating system, its release and kernel, the CPU architecture, and the container the CLI runs in, if any.
+// The container image is taken from containerImage, since it isn't visible from inside the container.
+func collectHost(containerImage string) map[string]string {
+	facts := map[string]string{
+		HostPrefix + "os":   runtime.GOOS,
+		HostPrefix + "arch": runtime.GOARCH,
+	}
+	if release := getOsRelease(); release != "" {
+		facts[HostPrefix+"os.release"] = release
+	}
+	if kernel := getKernel(); kernel != "" {
+		facts[HostPrefix+"kernel"] = kernel
+	}
+	containerId, inContainer := getContainerId()
+	if !inContainer && containerImage == "" {
+		return facts
+	}
+	facts[HostPrefix+"container"] = "true"
+	if containerId != "" {
+		facts[HostPrefix+"container.id"] = containerId
+	}
+	if containerImage != "" {
+		facts[HostPrefix+"container.image"] = containerImage
+		if digest := parseImageDigest(containerImage); digest != "" {
+			facts[HostPrefix+"container.image.digest"] = digest
+		}
+	}
+	return facts
+}
+
+func getOsRelease() string {
+	switch runtime.GOOS {
+	case "linux":
+		content, err := os.ReadFile("/etc/os-release")
+		if err != nil {
+			return ""
+		}
+		return parseOsRelease(string(content))
+	case "darwin":
+		if version := runCommand("sw_vers", "-productVersion"); version != "" {
+			return "macOS " + version
+		}
+	case "windows":
+		return runCommand("cmd", "/c", "ver")
+	}
+	return ""
+}
+
+// Parses the content of /etc/os-release, such as 'PRETTY_NAME="Ubuntu 22.04.3 LTS"'.
+func parseOsRelease(content string) string {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		if key, value, found := strings.Cut(scanner.Text(), "="); found {
+			values[key] = strings.Trim(value, `"'`)
+		}
+	}
+	if values["PRETTY_NAME"] != "" {
+		return values["PRETTY_NAME"]
+	}
+	return strings.TrimSpace(values["NAME"] + " " + values["VERSION_ID"])
+}
+
+func getKernel() string {
+	if runtime.GOOS == "linux" {
+		if content, err := os.ReadFile("/proc/sys/kernel/osrelease"); err == nil {
+			return strings.TrimSpace(string(content))
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return ""
+	}
+	return runCommand("uname", "-r")
+}
+
+// Returns the ID of the container the CLI runs in, and whether it runs in a container.
+func getContainerId() (containerId string, inContainer bool) {
+	if runtime.GOOS != "linux" {
+		return "", false
+	}
+	for _, markerFile := range []string{"/.dockerenv", "/run/.containerenv"} {
+		if _, err := os.Stat(markerFile); err == nil {
+			inContainer = true
+		}
+	}
+	if cgroup, err := os.ReadFile("/proc/self/cgroup"); err == nil {
+		if containerId = parseCgroupContainerId(string(cgroup)); containerId != "" {
+			return containerId, true
+		}
+	}
+	if mountInfo, err := os.ReadFile("/proc/self/mountinfo"); err == nil {
+		if match := mountContainerIdRegexp.FindStringSubmatch(string(mountInfo)); match != nil {
+			return match[1], true
+		}
+	}
+	return "", inContainer
+}
+
+func parseCgroupContainerId(cgroup string) string {
+	for _, line := range strings.Split(cgroup, "\n") {
+		if match := cgroupContainerIdRegexp.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			return match[1]
+		}
+	}
+	return ""
+}
+
+// Returns the digest of an image reference, such as 'sha256:<digest>' in 'acme/app@sha256:<digest>'.
+func parseImageDigest(image string) string {
+	if _, digest, found := strings.Cut(image, "@"); found {
+		return digest
+	}
+	if strings.HasPrefix(image, "sha256:") {
+		return image
+	}
+	return ""
+}
+
+func runCommand(name string, args ...string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), toolTimeout)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, name, args...).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// Removes the facts whose names match at least one of the patterns, the same way 'jf rt bp --env-exclude' excludes environment variables.
+// The names are matched without the 'buildInfo.' prefix, such as 'tools.go.version'.
+func excludeFacts(facts map[string]string, patterns []string) error {
+	for key := range facts {
+		name := strings.ToLower(strings.TrimPrefix(key, "buildInfo."))
+		for _, pattern := range patterns {
+			if pattern == "" {
+				continue
+			}
+			match, err := stringutils.MatchWildcardPattern(strings.ToLower(pattern), name)
+			if err != nil {
+				return errorutils.CheckError(err)
+			}
+			if match {
+				delete(facts, key)
+				break
+			}
+		}
+	}
+	return nil
+}
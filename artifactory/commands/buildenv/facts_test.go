@@ -0,0 +1,68 @@
+package buildenv
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	testCases := []struct {
+		output   string
+		expected string
+	}{
+		{"go version go1.21.5 linux/amd64", "1.21.5"},
+		{"openjdk version \"17.0.9\" 2023-10-17\nOpenJDK Runtime Environment (build 17.0.9+9)", "17.0.9"},
+		{"Apache Maven 3.9.6 (bc0240f3c744dd6b6ec2920b3cd08dcc295161ae)\nMaven home: /opt/maven", "3.9.6"},
+		{"\n------------------------------------------------------------\nGradle 8.5\n------------------------------------------------------------", "8.5"},
+		{"v20.10.0", "20.10.0"},
+		{"10.2.3", "10.2.3"},
+		{"Python 3.11.2", "3.11.2"},
+		{"8.0.100-rc.2.23502.2", "8.0.100-rc.2.23502.2"},
+		{"The operation couldn't be completed. Unable to locate a Java Runtime.", ""},
+	}
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, parseVersion(testCase.output), testCase.output)
+	}
+}
+
+func TestParseOsRelease(t *testing.T) {
+	assert.Equal(t, "Ubuntu 22.04.3 LTS", parseOsRelease("NAME=\"Ubuntu\"\nVERSION_ID=\"22.04\"\nPRETTY_NAME=\"Ubuntu 22.04.3 LTS\"\n"))
+	assert.Equal(t, "Alpine Linux 3.19.0", parseOsRelease("NAME='Alpine Linux'\nVERSION_ID=3.19.0\n"))
+	assert.Empty(t, parseOsRelease(""))
+}
+
+func TestParseCgroupContainerId(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+	assert.Equal(t, id, parseCgroupContainerId("12:cpu,cpuacct:/docker/"+id+"\n11:memory:/docker/"+id))
+	assert.Equal(t, id, parseCgroupContainerId("0::/system.slice/docker-"+id+".scope"))
+	assert.Equal(t, id, parseCgroupContainerId("1:name=systemd:/kubepods/burstable/pod7f1c/"+id))
+	assert.Empty(t, parseCgroupContainerId("0::/\n4:memory:/user.slice"))
+}
+
+func TestParseImageDigest(t *testing.T) {
+	assert.Equal(t, "sha256:abc", parseImageDigest("acme.io/app:1.0@sha256:abc"))
+	assert.Equal(t, "sha256:abc", parseImageDigest("sha256:abc"))
+	assert.Empty(t, parseImageDigest("acme.io/app:1.0"))
+}
+
+func TestCollectHost(t *testing.T) {
+	facts := collectHost("acme.io/builder@sha256:abc")
+	assert.Equal(t, runtime.GOOS, facts[HostPrefix+"os"])
+	assert.Equal(t, runtime.GOARCH, facts[HostPrefix+"arch"])
+	assert.Equal(t, "true", facts[HostPrefix+"container"])
+	assert.Equal(t, "sha256:abc", facts[HostPrefix+"container.image.digest"])
+}
+
+func TestExcludeFacts(t *testing.T) {
+	facts := map[string]string{
+		ToolsPrefix + "go.version":   "1.21.5",
+		ToolsPrefix + "java.version": "17.0.9",
+		HostPrefix + "kernel":        "6.5.0",
+		HostPrefix + "container.id":  "abc",
+	}
+	assert.NoError(t, excludeFacts(facts, []string{"*password*", "tools.java*", "*CONTAINER*", ""}))
+	assert.Equal(t, map[string]string{ToolsPrefix + "go.version": "1.21.5", HostPrefix + "kernel": "6.5.0"}, facts)
+}
@@ -0,0 +1,263 @@
+package buildexport
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The archive holds a manifest which identifies the build, the general details of the build, its partials
+// and the build-info files which were generated by the build tools, such as Maven and Gradle, as is.
+const (
+	manifestEntry       = "manifest.json"
+	partialsDirName     = "partials"
+	buildInfosDirName   = "build-info"
+	archiveVersion      = 1
+	buildFilePermission = 0600
+)
+
+// Manifest identifies the build, which the partial build-info in the archive belongs to.
+type Manifest struct {
+	Version    int       `json:"version"`
+	Name       string    `json:"name"`
+	Number     string    `json:"number"`
+	Project    string    `json:"project,omitempty"`
+	Host       string    `json:"host,omitempty"`
+	ExportedAt time.Time `json:"exportedAt"`
+	Modules    []string  `json:"modules,omitempty"`
+}
+
+// The files of a build-info, which were collected locally and weren't published yet.
+type buildFiles struct {
+	details    []byte
+	partials   [][]byte
+	buildInfos [][]byte
+}
+
+// Reads the local build-info files of a build. Returns empty buildFiles if no build-info was collected for the build.
+func readBuildFiles(buildName, buildNumber, project string) (*buildFiles, error) {
+	buildDir, err := build.GetBuildDir(buildName, buildNumber, project)
+	if err != nil {
+		return nil, err
+	}
+	files := new(buildFiles)
+	if files.buildInfos, err = readDirFiles(buildDir, ""); err != nil {
+		return nil, err
+	}
+	// The details file is kept in the partials directory, along with the partials.
+	if files.partials, err = readDirFiles(filepath.Join(buildDir, partialsDirName), build.BuildInfoDetails); err != nil {
+		return nil, err
+	}
+	files.details, err = os.ReadFile(filepath.Join(buildDir, partialsDirName, build.BuildInfoDetails))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errorutils.CheckError(err)
+	}
+	return files, nil
+}
+
+// Reads the non-empty files of the directory, except for the excluded file, sorted by their names. A missing directory has no files.
+func readDirFiles(dirPath, excludedName string) ([][]byte, error) {
+	dirEntries, err := os.ReadDir(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	var contents [][]byte
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || dirEntry.Name() == excludedName {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(dirPath, dirEntry.Name()))
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if len(content) > 0 {
+			contents = append(contents, content)
+		}
+	}
+	return contents, nil
+}
+
+// Returns the sorted IDs of the modules of the build-info files.
+// Partials which don't belong to a module, such as the environment variables and the VCS details, are ignored.
+func (files *buildFiles) getModules() ([]string, error) {
+	modules := make(map[string]bool)
+	for _, content := range files.partials {
+		partial := new(buildinfo.Partial)
+		if err := json.Unmarshal(content, partial); err != nil {
+			return nil, errorutils.CheckErrorf("failed to read a partial build-info: %s", err.Error())
+		}
+		if partial.ModuleType != "" {
+			modules[partial.ModuleId] = true
+		}
+	}
+	for _, content := range files.buildInfos {
+		buildInfo := new(buildinfo.BuildInfo)
+		if err := json.Unmarshal(content, buildInfo); err != nil {
+			return nil, errorutils.CheckErrorf("failed to read a generated build-info: %s", err.Error())
+		}
+		for _, module := range buildInfo.Modules {
+			modules[module.Id] = true
+		}
+	}
+	var ids []string
+	for id := range modules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Returns the timestamp of the general details of the build, which is the time the build started.
+func getDetailsTimestamp(details []byte) (time.Time, error) {
+	general := new(buildinfo.General)
+	if err := json.Unmarshal(details, general); err != nil {
+		return time.Time{}, errorutils.CheckErrorf("failed to read the build details: %s", err.Error())
+	}
+	return general.Timestamp, nil
+}
+
+func writeArchive(archivePath string, manifest *Manifest, files *buildFiles) (err error) {
+	file, err := os.Create(archivePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	gzipWriter := gzip.NewWriter(file)
+	defer func() {
+		if closeErr := gzipWriter.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	tarWriter := tar.NewWriter(gzipWriter)
+	defer func() {
+		if closeErr := tarWriter.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+
+	manifestContent, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = addEntry(tarWriter, manifestEntry, manifestContent, manifest.ExportedAt); err != nil {
+		return err
+	}
+	if err = addEntry(tarWriter, build.BuildInfoDetails, files.details, manifest.ExportedAt); err != nil {
+		return err
+	}
+	for i, content := range files.partials {
+		if err = addEntry(tarWriter, path.Join(partialsDirName, fmt.Sprintf("%04d.json", i+1)), content, manifest.ExportedAt); err != nil {
+			return err
+		}
+	}
+	for i, content := range files.buildInfos {
+		if err = addEntry(tarWriter, path.Join(buildInfosDirName, fmt.Sprintf("%04d.json", i+1)), content, manifest.ExportedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addEntry(tarWriter *tar.Writer, name string, content []byte, modTime time.Time) error {
+	if err := tarWriter.WriteHeader(&tar.Header{Name: name, Mode: buildFilePermission, Size: int64(len(content)), ModTime: modTime}); err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err := tarWriter.Write(content)
+	return errorutils.CheckError(err)
+}
+
+// Reads an archive which was created by 'jf rt build-export'.
+func readArchive(archivePath string) (manifest *Manifest, files *buildFiles, err error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, nil, errorutils.CheckErrorf("%s isn't a build-info archive: %s", archivePath, err.Error())
+	}
+	tarReader := tar.NewReader(gzipReader)
+	files = new(buildFiles)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, errorutils.CheckErrorf("failed to read the build-info archive %s: %s", archivePath, err.Error())
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, nil, errorutils.CheckError(err)
+		}
+		// The entries are kept in memory and written under new names, so the paths in the archive are never used as file paths.
+		switch dir, _ := path.Split(header.Name); {
+		case header.Name == manifestEntry:
+			manifest = new(Manifest)
+			if err = json.Unmarshal(content, manifest); err != nil {
+				return nil, nil, errorutils.CheckErrorf("failed to read the manifest of the build-info archive %s: %s", archivePath, err.Error())
+			}
+		case header.Name == build.BuildInfoDetails:
+			files.details = content
+		case dir == partialsDirName+"/":
+			files.partials = append(files.partials, content)
+		case dir == buildInfosDirName+"/":
+			files.buildInfos = append(files.buildInfos, content)
+		default:
+			return nil, nil, errorutils.CheckErrorf("the build-info archive %s has an unexpected entry: %s", archivePath, header.Name)
+		}
+	}
+	switch {
+	case manifest == nil:
+		return nil, nil, errorutils.CheckErrorf("%s isn't a build-info archive, since it has no %s", archivePath, manifestEntry)
+	case manifest.Version > archiveVersion:
+		return nil, nil, errorutils.CheckErrorf("the build-info archive %s was created by a newer version of JFrog CLI", archivePath)
+	case manifest.Name == "" || manifest.Number == "":
+		return nil, nil, errorutils.CheckErrorf("the manifest of the build-info archive %s has no build name or number", archivePath)
+	case files.details == nil:
+		return nil, nil, errorutils.CheckErrorf("the build-info archive %s has no build details", archivePath)
+	}
+	return manifest, files, nil
+}
+
+// Returns the modules which are in both lists, which are sorted.
+func getDuplicates(modules, otherModules []string) (duplicates []string) {
+	for _, module := range modules {
+		if i := sort.SearchStrings(otherModules, module); i < len(otherModules) && otherModules[i] == module {
+			duplicates = append(duplicates, module)
+		}
+	}
+	return
+}
+
+// Returns the module IDs quoted and separated by commas, for messages.
+func formatModules(modules []string) string {
+	return "'" + strings.Join(modules, "', '") + "'"
+}
@@ -0,0 +1,119 @@
+package buildexport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/stretchr/testify/assert"
+)
+
+// The build-info is collected under the CLI temp dir, which is set once per process,
+// so each test uses a build name of its own and removes the build-info when it's done.
+func removeBuild(t *testing.T, buildName, buildNumber string) {
+	assert.NoError(t, build.RemoveBuildDir(buildName, buildNumber, ""))
+}
+
+// Collects partial build-info of a module of the build, the same way the build tools do.
+func collectModule(t *testing.T, buildName, buildNumber, moduleId string) {
+	assert.NoError(t, build.SaveBuildGeneralDetails(buildName, buildNumber, ""))
+	assert.NoError(t, build.SavePartialBuildInfo(buildName, buildNumber, "", func(partial *buildinfo.Partial) {
+		partial.ModuleId = moduleId
+		partial.ModuleType = buildinfo.Generic
+		partial.Artifacts = []buildinfo.Artifact{{Name: moduleId + ".zip", Checksum: buildinfo.Checksum{Sha1: moduleId}}}
+	}))
+}
+
+func TestExportImport(t *testing.T) {
+	buildName := "build-export-test"
+	defer removeBuild(t, buildName, "1")
+	collectModule(t, buildName, "1", "frontend")
+	assert.NoError(t, build.SavePartialBuildInfo(buildName, "1", "", func(partial *buildinfo.Partial) {
+		partial.Env = buildinfo.Env{"buildInfo.env.HOST": "agent-1"}
+	}))
+	assert.NoError(t, build.SaveBuildInfo(buildName, "1", "", &buildinfo.BuildInfo{Modules: []buildinfo.Module{{Id: "backend"}}}))
+
+	archivePath := filepath.Join(t.TempDir(), "part.tgz")
+	exportCmd := NewExportCommand().SetBuild(buildName, "1", "").SetOutputPath(archivePath)
+	assert.NoError(t, exportCmd.Run())
+	assert.Equal(t, []string{"backend", "frontend"}, exportCmd.Manifest().Modules)
+
+	// Import the archive on another machine, which collected another module of the build.
+	removeBuild(t, buildName, "1")
+	collectModule(t, buildName, "1", "docs")
+	importCmd := NewImportCommand().SetArchivePath(archivePath)
+	assert.NoError(t, importCmd.Run())
+	assert.Equal(t, buildName, importCmd.Manifest().Name)
+	// The imported build is indexed, so that it is listed.
+	pending, err := buildindex.List()
+	assert.NoError(t, err)
+	assert.Contains(t, getBuildNames(pending), buildName)
+
+	partials, err := build.ReadPartialBuildInfoFiles(buildName, "1", "")
+	assert.NoError(t, err)
+	assert.Len(t, partials, 3)
+	generated, err := build.GetGeneratedBuildsInfo(buildName, "1", "")
+	assert.NoError(t, err)
+	assert.Len(t, generated, 1)
+
+	// Importing the same archive again is detected.
+	assert.ErrorContains(t, NewImportCommand().SetArchivePath(archivePath).Run(), "'backend', 'frontend'")
+	assert.NoError(t, NewImportCommand().SetArchivePath(archivePath).SetMergeDuplicates(true).Run())
+	partials, err = build.ReadPartialBuildInfoFiles(buildName, "1", "")
+	assert.NoError(t, err)
+	assert.Len(t, partials, 5)
+}
+
+func getBuildNames(pending []buildindex.PendingBuild) (names []string) {
+	for _, pendingBuild := range pending {
+		names = append(names, pendingBuild.Name)
+	}
+	return
+}
+
+func TestExportOutputPath(t *testing.T) {
+	assert.Equal(t, "team-app-7.tgz", NewExportCommand().SetBuild("team/app", "7", "").OutputPath())
+	assert.Equal(t, "out.tgz", NewExportCommand().SetBuild("team/app", "7", "").SetOutputPath("out.tgz").OutputPath())
+}
+
+func TestExportWithoutBuildInfo(t *testing.T) {
+	buildName := "build-export-missing-test"
+	defer removeBuild(t, buildName, "1")
+	assert.Error(t, NewExportCommand().SetBuild(buildName, "1", "").SetOutputPath(filepath.Join(t.TempDir(), "part.tgz")).Run())
+}
+
+func TestImportEarlierDetails(t *testing.T) {
+	buildName := "build-export-details-test"
+	defer removeBuild(t, buildName, "1")
+	collectModule(t, buildName, "1", "frontend")
+	archivePath := filepath.Join(t.TempDir(), "part.tgz")
+	assert.NoError(t, NewExportCommand().SetBuild(buildName, "1", "").SetOutputPath(archivePath).Run())
+	exported, err := build.ReadBuildInfoGeneralDetails(buildName, "1", "")
+	assert.NoError(t, err)
+
+	removeBuild(t, buildName, "1")
+	time.Sleep(10 * time.Millisecond)
+	collectModule(t, buildName, "1", "backend")
+	assert.NoError(t, NewImportCommand().SetArchivePath(archivePath).Run())
+	details, err := build.ReadBuildInfoGeneralDetails(buildName, "1", "")
+	assert.NoError(t, err)
+	assert.True(t, details.Timestamp.Equal(exported.Timestamp))
+}
+
+func TestReadInvalidArchive(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "part.tgz")
+	assert.NoError(t, os.WriteFile(archivePath, []byte("not an archive"), 0600))
+	assert.ErrorContains(t, NewImportCommand().SetArchivePath(archivePath).Run(), "isn't a build-info archive")
+
+	assert.NoError(t, writeArchive(archivePath, &Manifest{Version: archiveVersion, Name: "app"}, &buildFiles{details: []byte("{}")}))
+	assert.ErrorContains(t, NewImportCommand().SetArchivePath(archivePath).Run(), "no build name or number")
+}
+
+func TestGetDuplicates(t *testing.T) {
+	assert.Equal(t, []string{"b", "c"}, getDuplicates([]string{"a", "b", "c"}, []string{"b", "c", "d"}))
+	assert.Empty(t, getDuplicates([]string{"a"}, nil))
+}
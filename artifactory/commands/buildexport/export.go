@@ -0,0 +1,82 @@
+package buildexport
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ExportCommand exports the local partial build-info of a build to a tar.gz archive, so that it can be imported
+// into the build on another machine by 'jf rt build-import'. The local build-info is kept.
+type ExportCommand struct {
+	buildName   string
+	buildNumber string
+	project     string
+	outputPath  string
+	manifest    *Manifest
+}
+
+func NewExportCommand() *ExportCommand {
+	return &ExportCommand{}
+}
+
+func (ec *ExportCommand) SetBuild(buildName, buildNumber, project string) *ExportCommand {
+	ec.buildName, ec.buildNumber, ec.project = buildName, buildNumber, project
+	return ec
+}
+
+func (ec *ExportCommand) SetOutputPath(outputPath string) *ExportCommand {
+	ec.outputPath = outputPath
+	return ec
+}
+
+// OutputPath returns the path of the archive. If it wasn't set, it's <build name>-<build number>.tgz.
+func (ec *ExportCommand) OutputPath() string {
+	if ec.outputPath == "" {
+		return fmt.Sprintf("%s-%s.tgz", strings.ReplaceAll(ec.buildName, "/", "-"), ec.buildNumber)
+	}
+	return ec.outputPath
+}
+
+// Manifest returns the manifest of the created archive.
+func (ec *ExportCommand) Manifest() *Manifest {
+	return ec.manifest
+}
+
+func (ec *ExportCommand) Run() error {
+	files, err := readBuildFiles(ec.buildName, ec.buildNumber, ec.project)
+	if err != nil {
+		return err
+	}
+	if files.details == nil {
+		return errorutils.CheckErrorf("no build-info was collected locally for build %s/%s", ec.buildName, ec.buildNumber)
+	}
+	modules, err := files.getModules()
+	if err != nil {
+		return err
+	}
+	// The host identifies the machine the partials were collected on, when the archive is imported.
+	host, err := os.Hostname()
+	if err != nil {
+		log.Debug("Couldn't get the host name: " + err.Error())
+	}
+	ec.manifest = &Manifest{
+		Version:    archiveVersion,
+		Name:       ec.buildName,
+		Number:     ec.buildNumber,
+		Project:    ec.project,
+		Host:       host,
+		ExportedAt: time.Now().UTC(),
+		Modules:    modules,
+	}
+	if err = writeArchive(ec.OutputPath(), ec.manifest, files); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Exported %d partials and %d generated build-info files of build %s/%s, with the modules %s, to %s.",
+		len(files.partials), len(files.buildInfos), ec.buildName, ec.buildNumber, formatModules(modules), ec.OutputPath()))
+	return nil
+}
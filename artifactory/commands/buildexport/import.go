@@ -0,0 +1,134 @@
+package buildexport
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ImportCommand imports an archive, which was created by 'jf rt build-export', into the local build-info of the build.
+// The imported partials are merged with the local ones when the build is published, the same way the partials of
+// several commands on the same machine are. Modules which were already collected locally are detected, so that the
+// same partials aren't merged twice, unless merging the duplicate modules is allowed.
+type ImportCommand struct {
+	archivePath     string
+	mergeDuplicates bool
+	manifest        *Manifest
+}
+
+func NewImportCommand() *ImportCommand {
+	return &ImportCommand{}
+}
+
+func (ic *ImportCommand) SetArchivePath(archivePath string) *ImportCommand {
+	ic.archivePath = archivePath
+	return ic
+}
+
+// SetMergeDuplicates allows importing modules which were already collected locally.
+func (ic *ImportCommand) SetMergeDuplicates(mergeDuplicates bool) *ImportCommand {
+	ic.mergeDuplicates = mergeDuplicates
+	return ic
+}
+
+// Manifest returns the manifest of the imported archive.
+func (ic *ImportCommand) Manifest() *Manifest {
+	return ic.manifest
+}
+
+func (ic *ImportCommand) Run() error {
+	manifest, imported, err := readArchive(ic.archivePath)
+	if err != nil {
+		return err
+	}
+	ic.manifest = manifest
+	importedModules, err := imported.getModules()
+	if err != nil {
+		return err
+	}
+	local, err := readBuildFiles(manifest.Name, manifest.Number, manifest.Project)
+	if err != nil {
+		return err
+	}
+	localModules, err := local.getModules()
+	if err != nil {
+		return err
+	}
+	if duplicates := getDuplicates(importedModules, localModules); len(duplicates) > 0 {
+		if !ic.mergeDuplicates {
+			return errorutils.CheckErrorf("the modules %s of build %s/%s were already collected locally, or imported from another archive. "+
+				"Set --merge-duplicates to merge them with the local modules", formatModules(duplicates), manifest.Name, manifest.Number)
+		}
+		log.Warn(fmt.Sprintf("Merging the modules %s, which were already collected locally.", formatModules(duplicates)))
+	}
+	if err = ic.writeBuildFiles(local, imported); err != nil {
+		return err
+	}
+	// The build is indexed, so that it is listed by 'jf rt build-list --local'.
+	if err = buildindex.Record(buildindex.Build{Name: manifest.Name, Number: manifest.Number, Project: manifest.Project}); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Imported %d partials and %d generated build-info files of build %s/%s, exported from %s, with the modules %s.",
+		len(imported.partials), len(imported.buildInfos), manifest.Name, manifest.Number, manifest.Host, formatModules(importedModules)))
+	return nil
+}
+
+// Adds the imported files to the local build-info. The build details are replaced if the imported build started earlier.
+func (ic *ImportCommand) writeBuildFiles(local, imported *buildFiles) error {
+	buildDir, err := build.GetBuildDir(ic.manifest.Name, ic.manifest.Number, ic.manifest.Project)
+	if err != nil {
+		return err
+	}
+	partialsDir := filepath.Join(buildDir, partialsDirName)
+	if err = os.MkdirAll(partialsDir, 0777); err != nil {
+		return errorutils.CheckError(err)
+	}
+	replaceDetails := local.details == nil
+	if !replaceDetails {
+		localStart, err := getDetailsTimestamp(local.details)
+		if err != nil {
+			return err
+		}
+		importedStart, err := getDetailsTimestamp(imported.details)
+		if err != nil {
+			return err
+		}
+		replaceDetails = importedStart.Before(localStart)
+	}
+	if replaceDetails {
+		if err = os.WriteFile(filepath.Join(partialsDir, build.BuildInfoDetails), imported.details, buildFilePermission); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	for _, content := range imported.partials {
+		if err = writeTempFile(partialsDir, content); err != nil {
+			return err
+		}
+	}
+	for _, content := range imported.buildInfos {
+		if err = writeTempFile(buildDir, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes the content to a new file in the directory, named the same way the build-info files are named when they're collected.
+func writeTempFile(dirPath string, content []byte) (err error) {
+	file, err := os.CreateTemp(dirPath, "temp")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	_, err = file.Write(content)
+	return errorutils.CheckError(err)
+}
@@ -0,0 +1,285 @@
+package buildgates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Result is the result of the evaluation of a gate.
+type Result struct {
+	Gate    string `json:"gate"`
+	Passed  bool   `json:"passed"`
+	Details string `json:"details"`
+}
+
+// EvaluateCommand evaluates the gates of a published build.
+type EvaluateCommand struct {
+	serverDetails *config.ServerDetails
+	build         buildindex.Build
+	gates         *Gates
+	results       []Result
+}
+
+func NewEvaluateCommand() *EvaluateCommand {
+	return &EvaluateCommand{}
+}
+
+func (ec *EvaluateCommand) SetServerDetails(serverDetails *config.ServerDetails) *EvaluateCommand {
+	ec.serverDetails = serverDetails
+	return ec
+}
+
+func (ec *EvaluateCommand) SetBuild(buildName, buildNumber, project string) *EvaluateCommand {
+	ec.build = buildindex.Build{Name: buildName, Number: buildNumber, Project: project}
+	return ec
+}
+
+func (ec *EvaluateCommand) SetGates(gates *Gates) *EvaluateCommand {
+	ec.gates = gates
+	return ec
+}
+
+func (ec *EvaluateCommand) Results() []Result {
+	return ec.results
+}
+
+// Passed returns true if all the gates passed.
+func (ec *EvaluateCommand) Passed() bool {
+	for _, result := range ec.results {
+		if !result.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+func (ec *EvaluateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ec.serverDetails, nil
+}
+
+func (ec *EvaluateCommand) CommandName() string {
+	return "rt_build_promote_gates"
+}
+
+func (ec *EvaluateCommand) Run() (err error) {
+	servicesManager, err := credentialhelper.CreateServiceManager(ec.serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	buildInfo, statuses, found, err := builds.GetPublishedBuildInfo(servicesManager, ec.build)
+	if err != nil {
+		return
+	}
+	if !found {
+		return errorutils.CheckErrorf("build %s/%s was not found", ec.build.Name, ec.build.Number)
+	}
+	log.Info(fmt.Sprintf("Evaluating the promotion gates of build %s/%s...", ec.build.Name, ec.build.Number))
+	ec.results = nil
+	if len(ec.gates.Properties) > 0 {
+		ec.results = append(ec.results, evaluateProperties(buildInfo.Properties, ec.gates.Properties))
+	}
+	if len(ec.gates.Statuses) > 0 {
+		ec.results = append(ec.results, evaluateStatuses(statuses, ec.gates.Statuses))
+	}
+	if ec.gates.minAge > 0 {
+		ec.results = append(ec.results, evaluateMinAge(buildInfo.Started, ec.gates.minAge, time.Now()))
+	}
+	if ec.gates.UnstableDependencies != nil {
+		var items []builds.AqlItem
+		if len(ec.gates.UnstableDependencies.Repos) > 0 {
+			if items, err = builds.SearchBuildItems(servicesManager, ec.build, "dependency"); err != nil {
+				return
+			}
+		}
+		ec.results = append(ec.results, evaluateUnstableDependencies(buildInfo, items, ec.gates.UnstableDependencies))
+	}
+	if ec.gates.VerifyArtifacts {
+		var items []builds.AqlItem
+		if items, err = builds.SearchBuildItems(servicesManager, ec.build, "artifact"); err != nil {
+			return
+		}
+		ec.results = append(ec.results, evaluateArtifacts(buildInfo, items))
+	}
+	if ec.gates.ExternalCheck != nil {
+		ec.results = append(ec.results, evaluateExternalCheck(ec.gates.ExternalCheck, ec.build))
+	}
+	return
+}
+
+func evaluateProperties(properties buildinfo.Env, required map[string]string) Result {
+	result := Result{Gate: "Properties", Passed: true}
+	var failures []string
+	for _, name := range builds.SortedKeys(required) {
+		value, exists := properties[name]
+		if !exists {
+			failures = append(failures, name+" is missing")
+			continue
+		}
+		pattern := required[name]
+		if pattern == "" {
+			continue
+		}
+		if matched, err := stringutils.MatchWildcardPattern(pattern, value); err != nil || !matched {
+			failures = append(failures, fmt.Sprintf("%s is '%s', not '%s'", name, value, pattern))
+		}
+	}
+	if len(failures) > 0 {
+		result.Passed, result.Details = false, strings.Join(failures, "; ")
+	} else {
+		result.Details = fmt.Sprintf("%d required properties are set", len(required))
+	}
+	return result
+}
+
+func evaluateStatuses(statuses []builds.PromotionStatus, required []string) Result {
+	result := Result{Gate: "Statuses", Passed: true}
+	set := map[string]bool{}
+	for _, status := range statuses {
+		set[strings.ToLower(status.Status)] = true
+	}
+	var missing []string
+	for _, status := range required {
+		if !set[strings.ToLower(status)] {
+			missing = append(missing, status)
+		}
+	}
+	if len(missing) > 0 {
+		result.Passed, result.Details = false, "missing statuses: "+strings.Join(missing, ", ")
+	} else {
+		result.Details = "the build was promoted with " + strings.Join(required, ", ")
+	}
+	return result
+}
+
+func evaluateMinAge(started string, minAge time.Duration, now time.Time) Result {
+	result := Result{Gate: "Minimum age"}
+	startedTime, err := time.Parse(buildinfo.TimeFormat, started)
+	if err != nil {
+		result.Details = fmt.Sprintf("failed to parse the start time of the build '%s'", started)
+		return result
+	}
+	age := now.Sub(startedTime).Truncate(time.Second)
+	result.Passed = age >= minAge
+	result.Details = fmt.Sprintf("the build is %s old, and should be at least %s old", age, minAge)
+	return result
+}
+
+// Counts the dependencies whose IDs match the patterns of the gate, or which are found in repositories matching its patterns.
+func evaluateUnstableDependencies(buildInfo *buildinfo.BuildInfo, items []builds.AqlItem, gate *UnstableDependenciesGate) Result {
+	result := Result{Gate: "Unstable dependencies"}
+	unstableSha1s := map[string]bool{}
+	for _, item := range items {
+		if matchAny(gate.Repos, item.Repo) {
+			unstableSha1s[item.ActualSha1] = true
+		}
+	}
+	unstable := map[string]bool{}
+	for _, module := range buildInfo.Modules {
+		for _, dependency := range module.Dependencies {
+			if (dependency.Sha1 != "" && unstableSha1s[dependency.Sha1]) || matchAny(gate.Ids, dependency.Id) {
+				unstable[dependency.Id] = true
+			}
+		}
+	}
+	result.Passed = len(unstable) <= gate.Max
+	result.Details = fmt.Sprintf("%d unstable dependencies, and at most %d are allowed", len(unstable), gate.Max)
+	if len(unstable) > 0 {
+		result.Details += ": " + strings.Join(builds.SortedKeys(unstable), ", ")
+	}
+	return result
+}
+
+// Verifies that every artifact of the build exists in Artifactory, with the checksums of the build-info.
+func evaluateArtifacts(buildInfo *buildinfo.BuildInfo, items []builds.AqlItem) Result {
+	result := Result{Gate: "Artifacts"}
+	sha256s := map[string][]string{}
+	for _, item := range items {
+		sha256s[item.ActualSha1] = append(sha256s[item.ActualSha1], item.Sha256)
+	}
+	count := 0
+	var failures []string
+	for _, module := range buildInfo.Modules {
+		for _, artifact := range module.Artifacts {
+			count++
+			name := artifact.Path
+			if name == "" {
+				name = artifact.Name
+			}
+			if artifact.Sha1 == "" {
+				failures = append(failures, name+" has no checksum")
+				continue
+			}
+			found, ok := sha256s[artifact.Sha1]
+			if !ok {
+				failures = append(failures, name+" is missing")
+				continue
+			}
+			if artifact.Sha256 != "" && !contains(found, artifact.Sha256) && !contains(found, "") {
+				failures = append(failures, name+" has a different SHA-256 checksum")
+			}
+		}
+	}
+	if len(failures) > 0 {
+		result.Details = strings.Join(failures, "; ")
+		return result
+	}
+	result.Passed = true
+	result.Details = fmt.Sprintf("%d artifacts were verified", count)
+	return result
+}
+
+func evaluateExternalCheck(gate *ExternalCheckGate, b buildindex.Build) Result {
+	result := Result{Gate: "External check"}
+	ctx, cancel := context.WithTimeout(context.Background(), gate.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, gate.Command[0], gate.Command[1:]...)
+	cmd.Env = append(os.Environ(), coreutils.BuildName+"="+b.Name, coreutils.BuildNumber+"="+b.Number, coreutils.Project+"="+b.Project)
+	// The output of the check is shown, since it explains why the check failed.
+	cmd.Stdout, cmd.Stderr = os.Stderr, os.Stderr
+	err := cmd.Run()
+	exitCode := 0
+	if err != nil {
+		var exitError *exec.ExitError
+		if !errors.As(err, &exitError) || ctx.Err() != nil {
+			result.Details = fmt.Sprintf("'%s' failed to run: %s", strings.Join(gate.Command, " "), err.Error())
+			return result
+		}
+		exitCode = exitError.ExitCode()
+	}
+	result.Passed = exitCode == gate.ExitCode
+	result.Details = fmt.Sprintf("'%s' exited with %d, and should exit with %d", strings.Join(gate.Command, " "), exitCode, gate.ExitCode)
+	return result
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, err := stringutils.MatchWildcardPattern(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
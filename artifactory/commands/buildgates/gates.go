@@ -0,0 +1,107 @@
+package buildgates
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"gopkg.in/yaml.v2"
+)
+
+const defaultExternalCheckTimeout = 10 * time.Minute
+
+// Gates are the conditions a build must meet to be promoted. Gates which aren't set aren't evaluated.
+type Gates struct {
+	// Maps the names of build properties, such as 'buildInfo.env.CI', to wildcard patterns of their values.
+	Properties map[string]string `yaml:"properties,omitempty"`
+	// Statuses which earlier promotions of the build must have set.
+	Statuses []string `yaml:"statuses,omitempty"`
+	// The minimum time since the build started, such as '30m', '2h' or '1d'.
+	MinAge               string                    `yaml:"minAge,omitempty"`
+	UnstableDependencies *UnstableDependenciesGate `yaml:"unstableDependencies,omitempty"`
+	// Set to true to require the artifacts of the build to exist in Artifactory, with the checksums of the build-info.
+	VerifyArtifacts bool               `yaml:"verifyArtifacts,omitempty"`
+	ExternalCheck   *ExternalCheckGate `yaml:"externalCheck,omitempty"`
+	minAge          time.Duration
+}
+
+// UnstableDependenciesGate limits the number of dependencies from snapshot or unstable repositories.
+type UnstableDependenciesGate struct {
+	// Wildcard patterns of the repositories of unstable dependencies, such as '*-snapshot-*'.
+	Repos []string `yaml:"repos,omitempty"`
+	// Wildcard patterns of the IDs of unstable dependencies, such as '*-SNAPSHOT'.
+	Ids []string `yaml:"ids,omitempty"`
+	Max int      `yaml:"max"`
+}
+
+// ExternalCheckGate runs a command, which must exit with the expected exit code.
+// The command gets the build in the JFROG_CLI_BUILD_NAME, JFROG_CLI_BUILD_NUMBER and JFROG_CLI_BUILD_PROJECT environment variables.
+type ExternalCheckGate struct {
+	// The executable and its arguments. The command isn't run by a shell.
+	Command  []string `yaml:"command"`
+	ExitCode int      `yaml:"exitCode,omitempty"`
+	// The maximum run time of the command, such as '5m'. 10 minutes by default.
+	Timeout string `yaml:"timeout,omitempty"`
+	timeout time.Duration
+}
+
+// ReadGates reads and validates the gates file.
+func ReadGates(filePath string) (*Gates, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	gates := new(Gates)
+	if err = yaml.UnmarshalStrict(content, gates); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing %s: %s", filePath, err.Error())
+	}
+	if err = gates.validate(); err != nil {
+		return nil, errorutils.CheckErrorf("invalid gates file %s: %s", filePath, err.Error())
+	}
+	return gates, nil
+}
+
+func (g *Gates) validate() (err error) {
+	if len(g.Properties) == 0 && len(g.Statuses) == 0 && g.MinAge == "" && g.UnstableDependencies == nil && !g.VerifyArtifacts && g.ExternalCheck == nil {
+		return errorutils.CheckErrorf("no gates are defined")
+	}
+	if g.MinAge != "" {
+		if g.minAge, err = parseDuration(g.MinAge); err != nil {
+			return errorutils.CheckErrorf("invalid minAge '%s': %s", g.MinAge, err.Error())
+		}
+	}
+	if g.UnstableDependencies != nil {
+		if len(g.UnstableDependencies.Repos) == 0 && len(g.UnstableDependencies.Ids) == 0 {
+			return errorutils.CheckErrorf("unstableDependencies should have repos or ids")
+		}
+		if g.UnstableDependencies.Max < 0 {
+			return errorutils.CheckErrorf("unstableDependencies.max can't be negative")
+		}
+	}
+	if g.ExternalCheck != nil {
+		if len(g.ExternalCheck.Command) == 0 {
+			return errorutils.CheckErrorf("externalCheck should have a command")
+		}
+		g.ExternalCheck.timeout = defaultExternalCheckTimeout
+		if g.ExternalCheck.Timeout != "" {
+			if g.ExternalCheck.timeout, err = parseDuration(g.ExternalCheck.Timeout); err != nil {
+				return errorutils.CheckErrorf("invalid externalCheck.timeout '%s': %s", g.ExternalCheck.Timeout, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// Parses a duration such as '90s' or '2h', and also supports days, such as '3d'.
+func parseDuration(value string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(value, "d"); found {
+		count, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
@@ -0,0 +1,129 @@
+package buildgates
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadGates(t *testing.T) {
+	gatesFile := filepath.Join(t.TempDir(), "gates.yaml")
+	content := `properties:
+  buildInfo.env.CI: "true"
+statuses: [QA]
+minAge: 1d
+unstableDependencies:
+  repos: ["*-snapshot-*"]
+  max: 2
+verifyArtifacts: true
+externalCheck:
+  command: [scan, --strict]
+`
+	assert.NoError(t, os.WriteFile(gatesFile, []byte(content), 0644))
+	gates, err := ReadGates(gatesFile)
+	assert.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, gates.minAge)
+	assert.Equal(t, 2, gates.UnstableDependencies.Max)
+	assert.True(t, gates.VerifyArtifacts)
+	assert.Equal(t, defaultExternalCheckTimeout, gates.ExternalCheck.timeout)
+}
+
+func TestReadGatesInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"unknown: true",
+		"minAge: soon",
+		"unstableDependencies:\n  max: 1",
+		"unstableDependencies:\n  ids: [\"*-SNAPSHOT\"]\n  max: -1",
+		"externalCheck:\n  exitCode: 0",
+		"externalCheck:\n  command: [scan]\n  timeout: 5",
+	}
+	for _, testCase := range testCases {
+		gatesFile := filepath.Join(t.TempDir(), "gates.yaml")
+		assert.NoError(t, os.WriteFile(gatesFile, []byte(testCase), 0644))
+		_, err := ReadGates(gatesFile)
+		assert.Error(t, err, testCase)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	duration, err := parseDuration("3d")
+	assert.NoError(t, err)
+	assert.Equal(t, 72*time.Hour, duration)
+	duration, err = parseDuration("90m")
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Minute, duration)
+	_, err = parseDuration("xd")
+	assert.Error(t, err)
+}
+
+func TestEvaluateProperties(t *testing.T) {
+	properties := buildinfo.Env{"buildInfo.env.CI": "true", "buildInfo.env.BRANCH": "release/1.0"}
+	assert.True(t, evaluateProperties(properties, map[string]string{"buildInfo.env.CI": "", "buildInfo.env.BRANCH": "release/*"}).Passed)
+	result := evaluateProperties(properties, map[string]string{"buildInfo.env.BRANCH": "main", "buildInfo.env.TICKET": ""})
+	assert.False(t, result.Passed)
+	assert.Equal(t, "buildInfo.env.BRANCH is 'release/1.0', not 'main'; buildInfo.env.TICKET is missing", result.Details)
+}
+
+func TestEvaluateStatuses(t *testing.T) {
+	statuses := []builds.PromotionStatus{{Status: "QA"}, {Status: "Staged"}}
+	assert.True(t, evaluateStatuses(statuses, []string{"qa", "Staged"}).Passed)
+	result := evaluateStatuses(statuses, []string{"QA", "Approved"})
+	assert.False(t, result.Passed)
+	assert.Equal(t, "missing statuses: Approved", result.Details)
+}
+
+func TestEvaluateMinAge(t *testing.T) {
+	now := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	started := now.Add(-2 * time.Hour).Format(buildinfo.TimeFormat)
+	assert.True(t, evaluateMinAge(started, time.Hour, now).Passed)
+	assert.False(t, evaluateMinAge(started, 3*time.Hour, now).Passed)
+	assert.False(t, evaluateMinAge("yesterday", time.Hour, now).Passed)
+}
+
+func TestEvaluateUnstableDependencies(t *testing.T) {
+	buildInfo := &buildinfo.BuildInfo{Modules: []buildinfo.Module{{Dependencies: []buildinfo.Dependency{
+		{Id: "acme:lib:1.0-SNAPSHOT", Checksum: buildinfo.Checksum{Sha1: "1"}},
+		{Id: "acme:core:2.0", Checksum: buildinfo.Checksum{Sha1: "2"}},
+		{Id: "acme:util:3.0", Checksum: buildinfo.Checksum{Sha1: "3"}},
+	}}}}
+	items := []builds.AqlItem{{Repo: "libs-snapshot-local", ActualSha1: "2"}, {Repo: "libs-release-local", ActualSha1: "3"}}
+	gate := &UnstableDependenciesGate{Repos: []string{"*-snapshot-*"}, Ids: []string{"*-SNAPSHOT"}, Max: 1}
+	result := evaluateUnstableDependencies(buildInfo, items, gate)
+	assert.False(t, result.Passed)
+	assert.Equal(t, "2 unstable dependencies, and at most 1 are allowed: acme:core:2.0, acme:lib:1.0-SNAPSHOT", result.Details)
+	gate.Max = 2
+	assert.True(t, evaluateUnstableDependencies(buildInfo, items, gate).Passed)
+}
+
+func TestEvaluateArtifacts(t *testing.T) {
+	buildInfo := &buildinfo.BuildInfo{Modules: []buildinfo.Module{{Artifacts: []buildinfo.Artifact{
+		{Name: "a.jar", Checksum: buildinfo.Checksum{Sha1: "1", Sha256: "a"}},
+		{Name: "b.jar", Checksum: buildinfo.Checksum{Sha1: "2", Sha256: "b"}},
+	}}}}
+	items := []builds.AqlItem{{ActualSha1: "1", Sha256: "a"}, {ActualSha1: "2", Sha256: "b"}}
+	assert.True(t, evaluateArtifacts(buildInfo, items).Passed)
+	result := evaluateArtifacts(buildInfo, []builds.AqlItem{{ActualSha1: "1", Sha256: "c"}})
+	assert.False(t, result.Passed)
+	assert.Equal(t, "a.jar has a different SHA-256 checksum; b.jar is missing", result.Details)
+}
+
+func TestEvaluateExternalCheck(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test runs a shell script.")
+	}
+	b := buildindex.Build{Name: "app", Number: "1"}
+	gate := &ExternalCheckGate{Command: []string{"sh", "-c", `test "$JFROG_CLI_BUILD_NAME" = app`}, timeout: time.Minute}
+	assert.True(t, evaluateExternalCheck(gate, b).Passed)
+	gate.ExitCode = 1
+	assert.False(t, evaluateExternalCheck(gate, b).Passed)
+	gate.Command = []string{"no-such-command-for-gates"}
+	assert.False(t, evaluateExternalCheck(gate, b).Passed)
+}
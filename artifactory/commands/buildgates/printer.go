@@ -0,0 +1,24 @@
+package buildgates
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+type resultRow struct {
+	Gate    string `col-name:"Gate"`
+	Result  string `col-name:"Result"`
+	Details string `col-name:"Details"`
+}
+
+// PrintReport prints the results of the evaluation of the gates as a table.
+func PrintReport(results []Result) error {
+	var rows []resultRow
+	for _, result := range results {
+		row := resultRow{Gate: result.Gate, Result: "FAILED", Details: result.Details}
+		if result.Passed {
+			row.Result = "PASSED"
+		}
+		rows = append(rows, row)
+	}
+	return coreutils.PrintTable(rows, "Promotion Gates", "No gates were evaluated", false)
+}
@@ -0,0 +1,180 @@
+package buildpromoteset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	resultValid          = "VALID"
+	resultInvalid        = "INVALID"
+	resultPromoted       = "PROMOTED"
+	resultFailed         = "FAILED"
+	resultSkipped        = "SKIPPED"
+	resultRolledBack     = "ROLLED BACK"
+	resultRollbackFailed = "ROLLBACK FAILED"
+)
+
+// Result is the result of the promotion of a build of the set.
+type Result struct {
+	Build   *SetBuild
+	Result  string
+	Details string
+}
+
+// PromoteSetCommand promotes all the builds of a set, or none of them.
+// All the builds are validated before any of them is promoted. If the promotion of a build fails,
+// the builds promoted before it, and the items the failed promotion already promoted, are rolled back.
+type PromoteSetCommand struct {
+	serverDetails *config.ServerDetails
+	set           *PromotionSet
+	move          bool
+	dryRun        bool
+	results       []Result
+}
+
+func NewPromoteSetCommand() *PromoteSetCommand {
+	return &PromoteSetCommand{}
+}
+
+func (psc *PromoteSetCommand) SetServerDetails(serverDetails *config.ServerDetails) *PromoteSetCommand {
+	psc.serverDetails = serverDetails
+	return psc
+}
+
+func (psc *PromoteSetCommand) SetPromotionSet(set *PromotionSet) *PromoteSetCommand {
+	psc.set = set
+	return psc
+}
+
+// SetMove sets whether the artifacts are moved to the target repositories, rather than copied.
+func (psc *PromoteSetCommand) SetMove(move bool) *PromoteSetCommand {
+	psc.move = move
+	return psc
+}
+
+// SetDryRun sets whether the builds are only validated, and aren't promoted.
+func (psc *PromoteSetCommand) SetDryRun(dryRun bool) *PromoteSetCommand {
+	psc.dryRun = dryRun
+	return psc
+}
+
+func (psc *PromoteSetCommand) Results() []Result {
+	return psc.results
+}
+
+func (psc *PromoteSetCommand) ServerDetails() (*config.ServerDetails, error) {
+	return psc.serverDetails, nil
+}
+
+func (psc *PromoteSetCommand) CommandName() string {
+	return "rt_build_promote_set"
+}
+
+func (psc *PromoteSetCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(psc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	dryRunServicesManager, err := credentialhelper.CreateServiceManager(psc.serverDetails, -1, 0, true)
+	if err != nil {
+		return err
+	}
+	psc.results = make([]Result, len(psc.set.Builds))
+	for i, b := range psc.set.Builds {
+		psc.results[i].Build = b
+	}
+	log.Info(fmt.Sprintf("Validating the promotion of %d builds...", len(psc.set.Builds)))
+	if !psc.validate(servicesManager, dryRunServicesManager) {
+		return errorutils.CheckErrorf("the validation of the promotion set failed, so no builds were promoted")
+	}
+	if psc.dryRun {
+		log.Info("[Dry run] All the builds can be promoted.")
+		return nil
+	}
+	snapshots := make([][]builds.AqlItem, len(psc.set.Builds))
+	for i, b := range psc.set.Builds {
+		log.Info(fmt.Sprintf("Promoting build %s (%d/%d)...", b, i+1, len(psc.set.Builds)))
+		// The items of the build before its promotion are used to tell which items the promotion copied or moved.
+		if snapshots[i], err = searchItems(servicesManager, b); err == nil {
+			err = servicesManager.PromoteBuild(psc.getPromotionParams(b))
+		}
+		if err != nil {
+			psc.results[i].Result, psc.results[i].Details = resultFailed, getErrorDetails(err)
+			for j := i + 1; j < len(psc.set.Builds); j++ {
+				psc.results[j].Result, psc.results[j].Details = resultSkipped, fmt.Sprintf("the promotion of %s failed", b)
+			}
+			psc.rollback(servicesManager, snapshots[:i+1])
+			return errorutils.CheckErrorf("the promotion of build %s failed, so the promotion set was rolled back", b)
+		}
+		psc.results[i].Result, psc.results[i].Details = resultPromoted, "promoted to "+b.TargetRepo
+	}
+	return nil
+}
+
+// Validates that the builds exist and can be promoted to their target repositories, with a dry run promotion.
+// Returns false if any of the builds is invalid.
+func (psc *PromoteSetCommand) validate(servicesManager, dryRunServicesManager artifactory.ArtifactoryServicesManager) bool {
+	valid := true
+	repos := map[string]bool{}
+	for i, b := range psc.set.Builds {
+		details, err := psc.validateBuild(servicesManager, dryRunServicesManager, b, repos)
+		if err != nil {
+			details = getErrorDetails(err)
+		}
+		if details != "" {
+			valid = false
+			psc.results[i].Result, psc.results[i].Details = resultInvalid, details
+			continue
+		}
+		psc.results[i].Result, psc.results[i].Details = resultValid, "can be promoted to "+b.TargetRepo
+	}
+	return valid
+}
+
+// Returns the reason the build can't be promoted, or an empty string if it can.
+func (psc *PromoteSetCommand) validateBuild(servicesManager, dryRunServicesManager artifactory.ArtifactoryServicesManager, b *SetBuild, repos map[string]bool) (string, error) {
+	_, _, found, err := builds.GetPublishedBuildInfo(servicesManager, buildindex.Build{Name: b.Name, Number: b.Number, Project: b.Project})
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "the build wasn't found", nil
+	}
+	exists, checked := repos[b.TargetRepo]
+	if !checked {
+		if exists, err = servicesManager.IsRepoExists(b.TargetRepo); err != nil {
+			return "", err
+		}
+		repos[b.TargetRepo] = exists
+	}
+	if !exists {
+		return fmt.Sprintf("the target repository %s doesn't exist", b.TargetRepo), nil
+	}
+	return "", dryRunServicesManager.PromoteBuild(psc.getPromotionParams(b))
+}
+
+func (psc *PromoteSetCommand) getPromotionParams(b *SetBuild) services.PromotionParams {
+	params := services.NewPromotionParams()
+	params.BuildName, params.BuildNumber, params.ProjectKey = b.Name, b.Number, b.Project
+	params.TargetRepo, params.SourceRepo = b.TargetRepo, b.SourceRepo
+	params.Status, params.Comment, params.Properties = b.Status, b.Comment, b.Properties
+	params.IncludeDependencies = b.IncludeDependencies != nil && *b.IncludeDependencies
+	params.Copy = !psc.move
+	params.FailFast = true
+	return params
+}
+
+// Returns the error in a single line, since the errors of Artifactory include the indented response body.
+func getErrorDetails(err error) string {
+	return strings.Join(strings.Fields(err.Error()), " ")
+}
@@ -0,0 +1,24 @@
+package buildpromoteset
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+type resultRow struct {
+	Name       string `col-name:"Build Name"`
+	Number     string `col-name:"Build Number"`
+	Project    string `col-name:"Project" omitempty:"true"`
+	TargetRepo string `col-name:"Target Repository"`
+	Result     string `col-name:"Result"`
+	Details    string `col-name:"Details"`
+}
+
+// PrintReport prints the results of the promotion of the builds of the set as a table.
+func PrintReport(results []Result) error {
+	var rows []resultRow
+	for _, result := range results {
+		rows = append(rows, resultRow{Name: result.Build.Name, Number: result.Build.Number, Project: result.Build.Project,
+			TargetRepo: result.Build.TargetRepo, Result: result.Result, Details: result.Details})
+	}
+	return coreutils.PrintTable(rows, "Promotion Set", "No builds were promoted", false)
+}
@@ -0,0 +1,103 @@
+package buildpromoteset
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPromotionSet(t *testing.T) {
+	setFile := filepath.Join(t.TempDir(), "set.yaml")
+	content := `targetRepo: release-local
+status: Released
+includeDependencies: false
+builds:
+  - name: api
+    number: "42"
+  - name: web
+    number: "7"
+    project: web
+    targetRepo: npm-release-local
+    includeDependencies: true
+`
+	assert.NoError(t, os.WriteFile(setFile, []byte(content), 0644))
+	set, err := ReadPromotionSet(setFile, "default")
+	assert.NoError(t, err)
+	assert.Len(t, set.Builds, 2)
+	api, web := set.Builds[0], set.Builds[1]
+	assert.Equal(t, "default", api.Project)
+	assert.Equal(t, "release-local", api.TargetRepo)
+	assert.Equal(t, "Released", api.Status)
+	assert.False(t, *api.IncludeDependencies)
+	assert.Equal(t, "web", web.Project)
+	assert.Equal(t, "npm-release-local", web.TargetRepo)
+	assert.Equal(t, "Released", web.Status)
+	assert.True(t, *web.IncludeDependencies)
+}
+
+func TestReadPromotionSetInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"targetRepo: release-local",
+		"builds:\n  - name: api\n    number: \"1\"",
+		"targetRepo: release-local\nbuilds:\n  - name: api",
+		"targetRepo: release-local\nbuilds:\n  - name: api\n    number: \"1\"\n  - name: api\n    number: \"1\"",
+		"targetRepo: release-local\nbuilds:\n  - name: api\n    number: \"1\"\n    copy: true",
+	}
+	for _, testCase := range testCases {
+		setFile := filepath.Join(t.TempDir(), "set.yaml")
+		assert.NoError(t, os.WriteFile(setFile, []byte(testCase), 0644))
+		_, err := ReadPromotionSet(setFile, "")
+		assert.Error(t, err, testCase)
+	}
+}
+
+func TestGetPromotedCopies(t *testing.T) {
+	before := []builds.AqlItem{
+		{Repo: "libs-dev", Path: "acme/api", Name: "api.jar"},
+		{Repo: "libs-dev", Path: ".", Name: "api.pom"},
+		{Repo: "release", Path: "acme/api", Name: "api-sources.jar"},
+	}
+	after := append(before,
+		builds.AqlItem{Repo: "release", Path: "acme/api", Name: "api.jar"},
+		builds.AqlItem{Repo: "release", Path: ".", Name: "api.pom"})
+	assert.Equal(t, []string{"release/acme/api/api.jar", "release/api.pom"}, getPromotedCopies(before, after, "release"))
+	assert.Empty(t, getPromotedCopies(before, before, "release"))
+}
+
+func TestGetPromotedMoves(t *testing.T) {
+	before := []builds.AqlItem{
+		{Repo: "libs-dev", Path: "acme/api", Name: "api.jar"},
+		{Repo: "libs-staging", Path: "acme/api", Name: "api.jar"},
+		{Repo: "libs-dev", Path: "acme/api", Name: "api.pom"},
+		{Repo: "libs-dev", Path: "acme/api", Name: "api-sources.jar"},
+	}
+	after := []builds.AqlItem{
+		{Repo: "release", Path: "acme/api", Name: "api.jar"},
+		{Repo: "release", Path: "acme/api", Name: "api.pom"},
+		// The promotion failed before it moved this item.
+		{Repo: "libs-dev", Path: "acme/api", Name: "api-sources.jar"},
+	}
+	assert.Equal(t, []itemMove{
+		{from: "release/acme/api/api.jar", to: "libs-dev/acme/api/api.jar"},
+		{from: "release/acme/api/api.pom", to: "libs-dev/acme/api/api.pom"},
+	}, getPromotedMoves(before, after, "release"))
+}
+
+func TestGetRollbackParams(t *testing.T) {
+	params := getRollbackParams(&SetBuild{Name: "app", Number: "7", Project: "acme", TargetRepo: "libs-release", Status: "released"})
+	assert.Equal(t, "app", params.BuildName)
+	assert.Equal(t, "7", params.BuildNumber)
+	assert.Equal(t, "acme", params.ProjectKey)
+	assert.Equal(t, rolledBackStatus, params.Status)
+	// Only the status is recorded, so nothing is promoted.
+	assert.Empty(t, params.TargetRepo)
+	assert.Contains(t, params.Comment, "libs-release")
+}
+
+func TestEscapePath(t *testing.T) {
+	assert.Equal(t, "release/acme/my%20app/app%231.jar", escapePath("release/acme/my app/app#1.jar"))
+}
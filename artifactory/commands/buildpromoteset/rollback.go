@@ -0,0 +1,165 @@
+package buildpromoteset
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The promotion status recorded for the builds whose promotions were rolled back, since the statuses of the promotions can't be removed.
+const rolledBackStatus = "rolled-back"
+
+// A move of an item back to where it was before the promotion.
+type itemMove struct {
+	from string
+	to   string
+}
+
+// Returns the items of the build, which are its artifacts, and also its dependencies if they're promoted.
+func searchItems(servicesManager artifactory.ArtifactoryServicesManager, b *SetBuild) ([]builds.AqlItem, error) {
+	build := buildindex.Build{Name: b.Name, Number: b.Number, Project: b.Project}
+	items, err := builds.SearchBuildItems(servicesManager, build, "artifact")
+	if err != nil || b.IncludeDependencies == nil || !*b.IncludeDependencies {
+		return items, err
+	}
+	dependencies, err := builds.SearchBuildItems(servicesManager, build, "dependency")
+	return append(items, dependencies...), err
+}
+
+// Rolls back the promotions of the builds in reverse order. snapshots holds the items of each build before its promotion.
+func (psc *PromoteSetCommand) rollback(servicesManager artifactory.ArtifactoryServicesManager, snapshots [][]builds.AqlItem) {
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		result := &psc.results[i]
+		// The promotion of the build failed before it started.
+		if snapshots[i] == nil && result.Result == resultFailed {
+			continue
+		}
+		log.Info(fmt.Sprintf("Rolling back the promotion of build %s...", result.Build))
+		count, err := psc.rollbackBuild(servicesManager, result.Build, snapshots[i])
+		switch {
+		case err != nil:
+			details := fmt.Sprintf("%d items were rolled back before the rollback failed: %s", count, getErrorDetails(err))
+			if result.Result == resultFailed {
+				details = result.Details + "; " + details
+			}
+			result.Result, result.Details = resultRollbackFailed, details
+		case result.Result == resultFailed:
+			if count > 0 {
+				result.Details += fmt.Sprintf("; %d partially promoted items were rolled back", count)
+			}
+		default:
+			result.Result, result.Details = resultRolledBack, fmt.Sprintf("%d items were rolled back from %s", count, result.Build.TargetRepo)
+			if err = servicesManager.PromoteBuild(getRollbackParams(result.Build)); err != nil {
+				result.Details += fmt.Sprintf(", but the '%s' status wasn't recorded: %s", rolledBackStatus, getErrorDetails(err))
+			}
+		}
+	}
+}
+
+// Returns the parameters of a promotion which only records the rolled-back status, so that the history of the build shows
+// that its last promotion was undone. The promotion has no target repository, so no items are copied or moved.
+func getRollbackParams(b *SetBuild) services.PromotionParams {
+	params := services.NewPromotionParams()
+	params.BuildName, params.BuildNumber, params.ProjectKey = b.Name, b.Number, b.Project
+	params.Status = rolledBackStatus
+	params.Comment = "The promotion to " + b.TargetRepo + " was rolled back, since the promotion of another build of the set failed"
+	params.FailFast = true
+	return params
+}
+
+// Deletes the copies the promotion created in the target repository, or moves the moved items back.
+// Returns the number of items which were rolled back.
+func (psc *PromoteSetCommand) rollbackBuild(servicesManager artifactory.ArtifactoryServicesManager, b *SetBuild, before []builds.AqlItem) (count int, err error) {
+	after, err := searchItems(servicesManager, b)
+	if err != nil {
+		return
+	}
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	if !psc.move {
+		for _, item := range getPromotedCopies(before, after, b.TargetRepo) {
+			resp, body, e := servicesManager.Client().SendDelete(serviceDetails.GetUrl()+escapePath(item), nil, &httpDetails)
+			if e != nil {
+				return count, e
+			}
+			if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent, http.StatusOK); err != nil {
+				return
+			}
+			count++
+		}
+		return
+	}
+	for _, move := range getPromotedMoves(before, after, b.TargetRepo) {
+		restApi := "api/move/" + escapePath(move.from) + "?to=/" + escapePath(move.to)
+		resp, body, e := servicesManager.Client().SendPost(serviceDetails.GetUrl()+restApi, nil, &httpDetails)
+		if e != nil {
+			return count, e
+		}
+		if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+			return
+		}
+		count++
+	}
+	return
+}
+
+// Returns the paths of the items in the target repository, which weren't there before the promotion.
+func getPromotedCopies(before, after []builds.AqlItem, targetRepo string) []string {
+	existing := getItemPaths(before)
+	var copies []string
+	for _, item := range after {
+		itemPath := getItemPath(item)
+		if item.Repo == targetRepo && !existing[itemPath] {
+			existing[itemPath] = true
+			copies = append(copies, itemPath)
+		}
+	}
+	return copies
+}
+
+// Returns the moves of the items, which the promotion moved to the target repository, back to where they were.
+// The promotion keeps the paths of the items in the repository.
+func getPromotedMoves(before, after []builds.AqlItem, targetRepo string) []itemMove {
+	existingBefore, existingAfter := getItemPaths(before), getItemPaths(after)
+	var moves []itemMove
+	for _, item := range before {
+		original := getItemPath(item)
+		promoted := path.Join(targetRepo, item.Path, item.Name)
+		if item.Repo == targetRepo || existingAfter[original] || existingBefore[promoted] || !existingAfter[promoted] {
+			continue
+		}
+		// Marks the promoted item, so that it isn't moved back twice.
+		existingBefore[promoted] = true
+		moves = append(moves, itemMove{from: promoted, to: original})
+	}
+	return moves
+}
+
+func getItemPaths(items []builds.AqlItem) map[string]bool {
+	paths := map[string]bool{}
+	for _, item := range items {
+		paths[getItemPath(item)] = true
+	}
+	return paths
+}
+
+func getItemPath(item builds.AqlItem) string {
+	return path.Join(item.Repo, item.Path, item.Name)
+}
+
+func escapePath(itemPath string) string {
+	parts := strings.Split(itemPath, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
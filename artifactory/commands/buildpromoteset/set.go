@@ -0,0 +1,102 @@
+package buildpromoteset
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"gopkg.in/yaml.v2"
+)
+
+// PromotionSet is a set of builds which are promoted together.
+// The promotion options at the top level are the defaults of the builds, which can override them.
+type PromotionSet struct {
+	TargetRepo          string `yaml:"targetRepo,omitempty"`
+	SourceRepo          string `yaml:"sourceRepo,omitempty"`
+	Status              string `yaml:"status,omitempty"`
+	Comment             string `yaml:"comment,omitempty"`
+	IncludeDependencies *bool  `yaml:"includeDependencies,omitempty"`
+	// Properties in the form of "key1=value1;key2=value2", to attach to the promoted artifacts.
+	Properties string      `yaml:"properties,omitempty"`
+	Builds     []*SetBuild `yaml:"builds"`
+}
+
+// SetBuild is a build of a promotion set.
+type SetBuild struct {
+	Name                string `yaml:"name"`
+	Number              string `yaml:"number"`
+	Project             string `yaml:"project,omitempty"`
+	TargetRepo          string `yaml:"targetRepo,omitempty"`
+	SourceRepo          string `yaml:"sourceRepo,omitempty"`
+	Status              string `yaml:"status,omitempty"`
+	Comment             string `yaml:"comment,omitempty"`
+	IncludeDependencies *bool  `yaml:"includeDependencies,omitempty"`
+	Properties          string `yaml:"properties,omitempty"`
+}
+
+func (sb *SetBuild) String() string {
+	return sb.Name + "/" + sb.Number
+}
+
+// ReadPromotionSet reads the set file, and applies the defaults of the set to its builds.
+// Builds without a project get the default project.
+func ReadPromotionSet(filePath, defaultProject string) (*PromotionSet, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	set := new(PromotionSet)
+	if err = yaml.UnmarshalStrict(content, set); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing %s: %s", filePath, err.Error())
+	}
+	set.applyDefaults(defaultProject)
+	if err = set.validate(); err != nil {
+		return nil, errorutils.CheckErrorf("invalid set file %s: %s", filePath, err.Error())
+	}
+	return set, nil
+}
+
+func (ps *PromotionSet) applyDefaults(defaultProject string) {
+	for _, b := range ps.Builds {
+		if b == nil {
+			continue
+		}
+		b.Project = getOrDefault(b.Project, defaultProject)
+		b.TargetRepo = getOrDefault(b.TargetRepo, ps.TargetRepo)
+		b.SourceRepo = getOrDefault(b.SourceRepo, ps.SourceRepo)
+		b.Status = getOrDefault(b.Status, ps.Status)
+		b.Comment = getOrDefault(b.Comment, ps.Comment)
+		b.Properties = getOrDefault(b.Properties, ps.Properties)
+		if b.IncludeDependencies == nil {
+			b.IncludeDependencies = ps.IncludeDependencies
+		}
+	}
+}
+
+func (ps *PromotionSet) validate() error {
+	if len(ps.Builds) == 0 {
+		return errorutils.CheckErrorf("no builds are defined")
+	}
+	builds := map[string]bool{}
+	for i, b := range ps.Builds {
+		if b == nil || b.Name == "" || b.Number == "" {
+			return errorutils.CheckErrorf("build #%d should have a name and a number", i+1)
+		}
+		if b.TargetRepo == "" {
+			return errorutils.CheckErrorf("build %s has no target repository", b)
+		}
+		key := fmt.Sprintf("%s/%s/%s", b.Project, b.Name, b.Number)
+		if builds[key] {
+			return errorutils.CheckErrorf("build %s is defined more than once", b)
+		}
+		builds[key] = true
+	}
+	return nil
+}
+
+func getOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
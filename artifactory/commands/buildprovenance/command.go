@@ -0,0 +1,158 @@
+package buildprovenance
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ProvenanceCommand generates a signed SLSA provenance attestation of the artifacts of a build, uploads it to Artifactory
+// and adds it to the artifacts of the build.
+type ProvenanceCommand struct {
+	serverDetails *config.ServerDetails
+	build         buildindex.Build
+	local         bool
+	// A PEM file of an ed25519 or ECDSA private key.
+	signingKeyPath string
+	// The path in Artifactory to upload the attestation to, in the form of repo/path. If it ends with a slash, the file name is
+	// generated. If empty, the attestation is uploaded next to the artifacts of the build.
+	target     string
+	buildUrl   string
+	envInclude string
+	envExclude string
+	uploadPath string
+}
+
+func NewProvenanceCommand() *ProvenanceCommand {
+	return &ProvenanceCommand{}
+}
+
+func (pc *ProvenanceCommand) SetServerDetails(serverDetails *config.ServerDetails) *ProvenanceCommand {
+	pc.serverDetails = serverDetails
+	return pc
+}
+
+func (pc *ProvenanceCommand) SetBuild(buildName, buildNumber, project string) *ProvenanceCommand {
+	pc.build = buildindex.Build{Name: buildName, Number: buildNumber, Project: project}
+	return pc
+}
+
+// SetLocal sets whether to attest the build-info collected locally, before it's published, rather than the published build-info.
+func (pc *ProvenanceCommand) SetLocal(local bool) *ProvenanceCommand {
+	pc.local = local
+	return pc
+}
+
+func (pc *ProvenanceCommand) SetSigningKeyPath(signingKeyPath string) *ProvenanceCommand {
+	pc.signingKeyPath = signingKeyPath
+	return pc
+}
+
+func (pc *ProvenanceCommand) SetTarget(target string) *ProvenanceCommand {
+	pc.target = target
+	return pc
+}
+
+// SetBuildUrl sets the build URL of the local build-info, which is the invocation ID of the provenance.
+func (pc *ProvenanceCommand) SetBuildUrl(buildUrl string) *ProvenanceCommand {
+	pc.buildUrl = buildUrl
+	return pc
+}
+
+// SetEnvInclude sets the patterns, separated by semicolons, of the environment variables of the local build-info to include in the provenance.
+func (pc *ProvenanceCommand) SetEnvInclude(envInclude string) *ProvenanceCommand {
+	pc.envInclude = envInclude
+	return pc
+}
+
+// SetEnvExclude sets the patterns, separated by semicolons, of the environment variables of the local build-info to exclude from the provenance.
+func (pc *ProvenanceCommand) SetEnvExclude(envExclude string) *ProvenanceCommand {
+	pc.envExclude = envExclude
+	return pc
+}
+
+// UploadPath returns the path in Artifactory, which the attestation was uploaded to.
+func (pc *ProvenanceCommand) UploadPath() string {
+	return pc.uploadPath
+}
+
+func (pc *ProvenanceCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pc.serverDetails, nil
+}
+
+func (pc *ProvenanceCommand) CommandName() string {
+	return "rt_build_provenance"
+}
+
+func (pc *ProvenanceCommand) Run() (err error) {
+	if pc.signingKeyPath == "" {
+		return errorutils.CheckErrorf("a signing key is required to sign the provenance. Set the --signing-key option or the JFROG_CLI_PROVENANCE_SIGNING_KEY environment variable")
+	}
+	key, err := loadPrivateKey(pc.signingKeyPath)
+	if err != nil {
+		return
+	}
+	showCommand := builds.NewShowCommand().SetServerDetails(pc.serverDetails).SetBuild(pc.build.Name, pc.build.Number, pc.build.Project).
+		SetLocal(pc.local).SetBuildUrl(pc.buildUrl).SetEnvInclude(pc.envInclude).SetEnvExclude(pc.envExclude)
+	if err = showCommand.Run(); err != nil {
+		return
+	}
+	s, err := newStatement(showCommand.BuildInfo(), pc.build.Project, coreutils.GetCliUserAgentVersion(), time.Now())
+	if err != nil {
+		return
+	}
+	payload, err := json.Marshal(s)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	e, err := sign(payload, key)
+	if err != nil {
+		return
+	}
+	content, err := json.Marshal(e)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if pc.uploadPath, err = pc.getUploadPath(); err != nil {
+		return
+	}
+	log.Info(fmt.Sprintf("Signed the provenance of %d artifacts with key %s.", len(s.Subject), e.Signatures[0].KeyId))
+	// The envelope is stored as JSON Lines, following the convention of the .intoto.jsonl files.
+	return builds.UploadArtifact(pc.serverDetails, showCommand.BuildInfo(), pc.build, pc.local, provenanceModuleId, pc.uploadPath, append(content, '\n'))
+}
+
+// Returns the path in Artifactory to upload the attestation to. By default, it's uploaded to the directory of the first artifact
+// of the build, which is found by the build properties of the artifacts.
+func (pc *ProvenanceCommand) getUploadPath() (string, error) {
+	fileName := strings.ReplaceAll(pc.build.Name, "/", "-") + "-" + pc.build.Number + ".intoto.jsonl"
+	if pc.target != "" {
+		if strings.HasSuffix(pc.target, "/") {
+			return pc.target + fileName, nil
+		}
+		return pc.target, nil
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(pc.serverDetails, -1, 0, false)
+	if err != nil {
+		return "", err
+	}
+	query := fmt.Sprintf(`items.find({"@build.name":%s,"@build.number":%s}).include("repo","path","name").sort({"$asc":["repo","path","name"]}).limit(1)`,
+		builds.QuoteAql(pc.build.Name), builds.QuoteAql(pc.build.Number))
+	items, err := builds.SearchItems(servicesManager, query)
+	if err != nil {
+		return "", err
+	}
+	if len(items) == 0 {
+		return "", errorutils.CheckErrorf("no artifacts of build %s/%s were found in Artifactory. Set the target path of the provenance", pc.build.Name, pc.build.Number)
+	}
+	return path.Join(items[0].Repo, items[0].Path, fileName), nil
+}
@@ -0,0 +1,171 @@
+package buildprovenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const payloadType = "application/vnd.in-toto+json"
+
+// A DSSE envelope, as specified in https://github.com/secure-systems-lab/dsse/blob/master/envelope.md.
+type envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     string      `json:"payload"`
+	Signatures  []signature `json:"signatures"`
+}
+
+type signature struct {
+	KeyId string `json:"keyid,omitempty"`
+	Sig   string `json:"sig"`
+}
+
+// Signs the payload with an ed25519 or an ECDSA key. ECDSA signatures are ASN.1 encoded signatures of the SHA-256 digest.
+func sign(payload []byte, key crypto.Signer) (*envelope, error) {
+	message := pae(payloadType, payload)
+	var sig []byte
+	var err error
+	switch key.(type) {
+	case ed25519.PrivateKey:
+		sig, err = key.Sign(rand.Reader, message, crypto.Hash(0))
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256(message)
+		sig, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, errorutils.CheckErrorf("only ed25519 and ECDSA keys are supported")
+	}
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	keyId, err := getKeyId(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &envelope{
+		PayloadType: payloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []signature{{KeyId: keyId, Sig: base64.StdEncoding.EncodeToString(sig)}},
+	}, nil
+}
+
+// Returns the payload of the envelope, if one of its signatures was made by the key.
+func verify(e *envelope, key crypto.PublicKey) ([]byte, error) {
+	if e.PayloadType != payloadType {
+		return nil, errorutils.CheckErrorf("unexpected payload type '%s'", e.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(e.Payload)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to decode the payload of the envelope: %s", err.Error())
+	}
+	message := pae(e.PayloadType, payload)
+	for _, s := range e.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			continue
+		}
+		switch publicKey := key.(type) {
+		case ed25519.PublicKey:
+			if ed25519.Verify(publicKey, message, sig) {
+				return payload, nil
+			}
+		case *ecdsa.PublicKey:
+			digest := sha256.Sum256(message)
+			if ecdsa.VerifyASN1(publicKey, digest[:], sig) {
+				return payload, nil
+			}
+		default:
+			return nil, errorutils.CheckErrorf("only ed25519 and ECDSA keys are supported")
+		}
+	}
+	return nil, errorutils.CheckErrorf("the envelope wasn't signed by the key")
+}
+
+// The pre-authentication encoding, which is the signed message of DSSE.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// The ID of a key is the SHA-256 digest of its public key, in the PKIX form.
+func getKeyId(key crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	digest := sha256.Sum256(der)
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// Loads an unencrypted ed25519 or ECDSA private key from a PEM file, in the PKCS #8 or SEC 1 form.
+func loadPrivateKey(keyPath string) (crypto.Signer, error) {
+	block, err := readPem(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	var key interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, errorutils.CheckErrorf("%s doesn't contain an unencrypted private key in the PKCS #8 or SEC 1 form", keyPath)
+	}
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the private key in %s: %s", keyPath, err.Error())
+	}
+	switch signer := key.(type) {
+	case ed25519.PrivateKey:
+		return signer, nil
+	case *ecdsa.PrivateKey:
+		return signer, nil
+	default:
+		return nil, errorutils.CheckErrorf("the key in %s isn't an ed25519 or ECDSA key", keyPath)
+	}
+}
+
+// Loads an ed25519 or ECDSA public key from a PEM file. If the file contains a private key, its public key is returned.
+func loadPublicKey(keyPath string) (crypto.PublicKey, error) {
+	block, err := readPem(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != "PUBLIC KEY" {
+		privateKey, err := loadPrivateKey(keyPath)
+		if err != nil {
+			return nil, err
+		}
+		return privateKey.Public(), nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the public key in %s: %s", keyPath, err.Error())
+	}
+	switch key.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, errorutils.CheckErrorf("the key in %s isn't an ed25519 or ECDSA key", keyPath)
+	}
+}
+
+func readPem(keyPath string) (*pem.Block, error) {
+	content, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errorutils.CheckErrorf("%s isn't a PEM file", keyPath)
+	}
+	return block, nil
+}
@@ -0,0 +1,163 @@
+package buildprovenance
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestBuildInfo() *buildinfo.BuildInfo {
+	return &buildinfo.BuildInfo{
+		Name:     "app",
+		Number:   "3",
+		Started:  "2024-03-01T10:00:00.000+0000",
+		BuildUrl: "https://ci.acme.io/job/app/3",
+		Modules: []buildinfo.Module{
+			{
+				Id: "org.acme:app:1.0",
+				Artifacts: []buildinfo.Artifact{
+					{Name: "app-1.0.jar", Path: "org/acme/app/1.0/app-1.0.jar", Checksum: buildinfo.Checksum{Sha1: "a1", Sha256: "a256"}},
+					{Name: "app-1.0.pom", Path: "org/acme/app/1.0/app-1.0.pom", Checksum: buildinfo.Checksum{Sha1: "p1"}},
+					{Name: "no-checksum.txt"},
+				},
+				Dependencies: []buildinfo.Dependency{{Id: "junit:junit:4.13.2", Checksum: buildinfo.Checksum{Sha1: "j1"}}},
+			},
+			{Id: provenanceModuleId, Artifacts: []buildinfo.Artifact{{Name: "app-2.intoto.jsonl", Checksum: buildinfo.Checksum{Sha1: "old"}}}},
+		},
+		VcsList:    []buildinfo.Vcs{{Url: "https://github.com/acme/app.git", Revision: "abc123", Branch: "main"}},
+		Properties: buildinfo.Env{"buildInfo.env.JAVA_VERSION": "17", "build.property": "ignored"},
+	}
+}
+
+func TestNewStatement(t *testing.T) {
+	s, err := newStatement(getTestBuildInfo(), "acme", "2.0.0", time.Date(2024, 3, 1, 10, 5, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, statementType, s.Type)
+	assert.Equal(t, predicateType, s.PredicateType)
+	// The provenance of a previous run and artifacts without checksums aren't subjects.
+	assert.Equal(t, []resourceDescriptor{
+		{Name: "org/acme/app/1.0/app-1.0.jar", Digest: map[string]string{"sha1": "a1", "sha256": "a256"}},
+		{Name: "org/acme/app/1.0/app-1.0.pom", Digest: map[string]string{"sha1": "p1"}},
+	}, s.Subject)
+
+	definition := s.Predicate.BuildDefinition
+	assert.Equal(t, externalParameters{BuildName: "app", BuildNumber: "3", Project: "acme", Vcs: getTestBuildInfo().VcsList}, definition.ExternalParameters)
+	assert.Equal(t, map[string]string{"JAVA_VERSION": "17"}, definition.InternalParameters.Environment)
+	assert.Equal(t, []resourceDescriptor{
+		{Uri: "git+https://github.com/acme/app.git@refs/heads/main", Digest: map[string]string{"gitCommit": "abc123"}},
+		{Name: "junit:junit:4.13.2", Digest: map[string]string{"sha1": "j1"}},
+	}, definition.ResolvedDependencies)
+	assert.Equal(t, metadata{InvocationId: "https://ci.acme.io/job/app/3", StartedOn: "2024-03-01T10:00:00Z", FinishedOn: "2024-03-01T10:05:00Z"}, s.Predicate.RunDetails.Metadata)
+
+	_, err = newStatement(&buildinfo.BuildInfo{Name: "empty", Number: "1"}, "", "2.0.0", time.Now())
+	assert.Error(t, err)
+}
+
+func TestVerifyStatement(t *testing.T) {
+	buildInfo := getTestBuildInfo()
+	s, err := newStatement(buildInfo, "", "2.0.0", time.Now())
+	require.NoError(t, err)
+	assert.NoError(t, verifyStatement(s, buildInfo))
+
+	// An artifact which was added to the build after the provenance was generated.
+	buildInfo.Modules[0].Artifacts = append(buildInfo.Modules[0].Artifacts, buildinfo.Artifact{Name: "extra.jar", Checksum: buildinfo.Checksum{Sha1: "e1"}})
+	assert.ErrorContains(t, verifyStatement(s, buildInfo), "extra.jar")
+
+	// An artifact whose checksum was changed.
+	buildInfo = getTestBuildInfo()
+	buildInfo.Modules[0].Artifacts[1].Sha1 = "changed"
+	assert.Error(t, verifyStatement(s, buildInfo))
+
+	buildInfo = getTestBuildInfo()
+	buildInfo.Number = "4"
+	assert.ErrorContains(t, verifyStatement(s, buildInfo), "app/3")
+}
+
+func TestSignAndVerify(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	for name, key := range map[string]crypto.Signer{"ed25519": ed25519Key, "ecdsa": ecdsaKey} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			privateKeyPath := writePem(t, dir, "key.pem", "PRIVATE KEY", func() ([]byte, error) { return x509.MarshalPKCS8PrivateKey(key) })
+			publicKeyPath := writePem(t, dir, "key.pub", "PUBLIC KEY", func() ([]byte, error) { return x509.MarshalPKIXPublicKey(key.Public()) })
+
+			signer, err := loadPrivateKey(privateKeyPath)
+			require.NoError(t, err)
+			payload := []byte(`{"_type":"https://in-toto.io/Statement/v1"}`)
+			e, err := sign(payload, signer)
+			require.NoError(t, err)
+			assert.Equal(t, payloadType, e.PayloadType)
+			require.Len(t, e.Signatures, 1)
+
+			// The public key may be loaded from the public key file or from the private key file.
+			for _, keyPath := range []string{publicKeyPath, privateKeyPath} {
+				publicKey, err := loadPublicKey(keyPath)
+				require.NoError(t, err)
+				keyId, err := getKeyId(publicKey)
+				require.NoError(t, err)
+				assert.Equal(t, keyId, e.Signatures[0].KeyId)
+				verified, err := verify(e, publicKey)
+				assert.NoError(t, err)
+				assert.Equal(t, payload, verified)
+			}
+
+			// The envelope survives a JSON round trip.
+			content, err := json.Marshal(e)
+			require.NoError(t, err)
+			decoded := new(envelope)
+			require.NoError(t, json.Unmarshal(content, decoded))
+			_, err = verify(decoded, key.Public())
+			assert.NoError(t, err)
+
+			tampered := *e
+			tampered.Payload = base64.StdEncoding.EncodeToString([]byte(`{"_type":"tampered"}`))
+			_, err = verify(&tampered, key.Public())
+			assert.Error(t, err)
+		})
+	}
+
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	e, err := sign([]byte("{}"), ed25519Key)
+	require.NoError(t, err)
+	_, err = verify(e, otherKey)
+	assert.Error(t, err)
+}
+
+func TestLoadPrivateKeySec1(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	keyPath := writePem(t, t.TempDir(), "key.pem", "EC PRIVATE KEY", func() ([]byte, error) { return x509.MarshalECPrivateKey(key) })
+	signer, err := loadPrivateKey(keyPath)
+	assert.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(signer.Public()))
+
+	rsaKeyPath := writePem(t, t.TempDir(), "key.pem", "RSA PRIVATE KEY", func() ([]byte, error) { return []byte("rsa"), nil })
+	_, err = loadPrivateKey(rsaKeyPath)
+	assert.Error(t, err)
+}
+
+func writePem(t *testing.T, dir, name, blockType string, marshal func() ([]byte, error)) string {
+	der, err := marshal()
+	require.NoError(t, err)
+	keyPath := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+	return keyPath
+}
@@ -0,0 +1,185 @@
+package buildprovenance
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	statementType = "https://in-toto.io/Statement/v1"
+	predicateType = "https://slsa.dev/provenance/v1"
+	// Identifies the build-info of the CLI as the way the subjects were built. The parameters are described by externalParameters.
+	buildType = "https://jfrog.com/jfrog-cli/build-info/v1"
+	builderId = "https://github.com/jfrog/jfrog-cli"
+	// The ID of the module of the build-info, which holds the uploaded provenance.
+	provenanceModuleId = "provenance"
+)
+
+// An in-toto statement, as specified in https://github.com/in-toto/attestation/blob/main/spec/v1/statement.md.
+type statement struct {
+	Type          string               `json:"_type"`
+	Subject       []resourceDescriptor `json:"subject"`
+	PredicateType string               `json:"predicateType"`
+	Predicate     provenance           `json:"predicate"`
+}
+
+type resourceDescriptor struct {
+	Name   string            `json:"name,omitempty"`
+	Uri    string            `json:"uri,omitempty"`
+	Digest map[string]string `json:"digest"`
+}
+
+// A SLSA v1 provenance predicate, as specified in https://slsa.dev/spec/v1.0/provenance.
+type provenance struct {
+	BuildDefinition buildDefinition `json:"buildDefinition"`
+	RunDetails      runDetails      `json:"runDetails"`
+}
+
+type buildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   externalParameters   `json:"externalParameters"`
+	InternalParameters   *internalParameters  `json:"internalParameters,omitempty"`
+	ResolvedDependencies []resourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+type externalParameters struct {
+	BuildName   string          `json:"buildName"`
+	BuildNumber string          `json:"buildNumber"`
+	Project     string          `json:"project,omitempty"`
+	Vcs         []buildinfo.Vcs `json:"vcs,omitempty"`
+}
+
+type internalParameters struct {
+	// The environment variables collected by the build-info, after the --env-include and --env-exclude patterns were applied.
+	Environment map[string]string `json:"environment,omitempty"`
+}
+
+type runDetails struct {
+	Builder  builder  `json:"builder"`
+	Metadata metadata `json:"metadata"`
+}
+
+type builder struct {
+	Id      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+type metadata struct {
+	InvocationId string `json:"invocationId,omitempty"`
+	StartedOn    string `json:"startedOn,omitempty"`
+	FinishedOn   string `json:"finishedOn,omitempty"`
+}
+
+// Creates the provenance statement of the build. Its subjects are the artifacts of the build.
+func newStatement(buildInfo *buildinfo.BuildInfo, project, toolVersion string, finishedOn time.Time) (*statement, error) {
+	subjects := getSubjects(buildInfo)
+	if len(subjects) == 0 {
+		return nil, errorutils.CheckErrorf("build %s/%s has no artifacts with checksums to attest", buildInfo.Name, buildInfo.Number)
+	}
+	s := &statement{
+		Type:          statementType,
+		Subject:       subjects,
+		PredicateType: predicateType,
+		Predicate: provenance{
+			BuildDefinition: buildDefinition{
+				BuildType:            buildType,
+				ExternalParameters:   externalParameters{BuildName: buildInfo.Name, BuildNumber: buildInfo.Number, Project: project, Vcs: buildInfo.VcsList},
+				ResolvedDependencies: getResolvedDependencies(buildInfo),
+			},
+			RunDetails: runDetails{
+				Builder:  builder{Id: builderId, Version: map[string]string{"jfrog-cli": toolVersion}},
+				Metadata: metadata{InvocationId: buildInfo.BuildUrl, FinishedOn: finishedOn.UTC().Format(time.RFC3339)},
+			},
+		},
+	}
+	if started, err := time.Parse(buildinfo.TimeFormat, buildInfo.Started); err == nil {
+		s.Predicate.RunDetails.Metadata.StartedOn = started.UTC().Format(time.RFC3339)
+	}
+	environment := map[string]string{}
+	for key, value := range buildInfo.Properties {
+		if name, found := strings.CutPrefix(key, buildinfo.BuildInfoEnvPrefix); found {
+			environment[name] = value
+		}
+	}
+	if len(environment) > 0 {
+		s.Predicate.BuildDefinition.InternalParameters = &internalParameters{Environment: environment}
+	}
+	return s, nil
+}
+
+// Returns the artifacts of the build, except for the provenance itself, sorted by their names.
+func getSubjects(buildInfo *buildinfo.BuildInfo) []resourceDescriptor {
+	subjects := map[string]resourceDescriptor{}
+	for _, module := range buildInfo.Modules {
+		if module.Id == provenanceModuleId {
+			continue
+		}
+		for _, artifact := range module.Artifacts {
+			digest := getDigest(artifact.Checksum)
+			if len(digest) == 0 {
+				continue
+			}
+			name := artifact.Path
+			if name == "" {
+				name = artifact.Name
+			}
+			subjects[name+"@"+artifact.Sha1+artifact.Sha256] = resourceDescriptor{Name: name, Digest: digest}
+		}
+	}
+	return sortDescriptors(subjects)
+}
+
+// Returns the VCS revisions and the dependencies of the build.
+func getResolvedDependencies(buildInfo *buildinfo.BuildInfo) []resourceDescriptor {
+	var dependencies []resourceDescriptor
+	for _, vcs := range buildInfo.VcsList {
+		if vcs.Url == "" || vcs.Revision == "" {
+			continue
+		}
+		uri := "git+" + vcs.Url
+		if vcs.Branch != "" {
+			uri += "@refs/heads/" + vcs.Branch
+		}
+		dependencies = append(dependencies, resourceDescriptor{Uri: uri, Digest: map[string]string{"gitCommit": vcs.Revision}})
+	}
+	moduleDependencies := map[string]resourceDescriptor{}
+	for _, module := range buildInfo.Modules {
+		for _, dependency := range module.Dependencies {
+			if digest := getDigest(dependency.Checksum); len(digest) > 0 {
+				moduleDependencies[dependency.Id+"@"+dependency.Sha1+dependency.Sha256] = resourceDescriptor{Name: dependency.Id, Digest: digest}
+			}
+		}
+	}
+	return append(dependencies, sortDescriptors(moduleDependencies)...)
+}
+
+func getDigest(checksum buildinfo.Checksum) map[string]string {
+	digest := map[string]string{}
+	if checksum.Sha256 != "" {
+		digest["sha256"] = checksum.Sha256
+	}
+	if checksum.Sha1 != "" {
+		digest["sha1"] = checksum.Sha1
+	}
+	if checksum.Md5 != "" {
+		digest["md5"] = checksum.Md5
+	}
+	return digest
+}
+
+func sortDescriptors(descriptors map[string]resourceDescriptor) []resourceDescriptor {
+	keys := make([]string, 0, len(descriptors))
+	for key := range descriptors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sorted := make([]resourceDescriptor, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, descriptors[key])
+	}
+	return sorted
+}
@@ -0,0 +1,178 @@
+package buildprovenance
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// VerifyCommand verifies the provenance of a published build. The attestation must be signed by the key, and attest exactly
+// the artifacts of the build.
+type VerifyCommand struct {
+	serverDetails *config.ServerDetails
+	build         buildindex.Build
+	// A PEM file of an ed25519 or ECDSA public key, or of the private key.
+	publicKeyPath string
+}
+
+func NewVerifyCommand() *VerifyCommand {
+	return &VerifyCommand{}
+}
+
+func (vc *VerifyCommand) SetServerDetails(serverDetails *config.ServerDetails) *VerifyCommand {
+	vc.serverDetails = serverDetails
+	return vc
+}
+
+func (vc *VerifyCommand) SetBuild(buildName, buildNumber, project string) *VerifyCommand {
+	vc.build = buildindex.Build{Name: buildName, Number: buildNumber, Project: project}
+	return vc
+}
+
+func (vc *VerifyCommand) SetPublicKeyPath(publicKeyPath string) *VerifyCommand {
+	vc.publicKeyPath = publicKeyPath
+	return vc
+}
+
+func (vc *VerifyCommand) ServerDetails() (*config.ServerDetails, error) {
+	return vc.serverDetails, nil
+}
+
+func (vc *VerifyCommand) CommandName() string {
+	return "rt_build_provenance_verify"
+}
+
+func (vc *VerifyCommand) Run() error {
+	if vc.publicKeyPath == "" {
+		return errorutils.CheckErrorf("a public key is required to verify the provenance. Set the --public-key option")
+	}
+	key, err := loadPublicKey(vc.publicKeyPath)
+	if err != nil {
+		return err
+	}
+	showCommand := builds.NewShowCommand().SetServerDetails(vc.serverDetails).SetBuild(vc.build.Name, vc.build.Number, vc.build.Project)
+	if err = showCommand.Run(); err != nil {
+		return err
+	}
+	buildInfo := showCommand.BuildInfo()
+	content, err := vc.downloadProvenance(buildInfo)
+	if err != nil {
+		return err
+	}
+	var e envelope
+	if err = json.Unmarshal(content, &e); err != nil {
+		return errorutils.CheckErrorf("failed to parse the provenance envelope: %s", err.Error())
+	}
+	payload, err := verify(&e, key)
+	if err != nil {
+		return err
+	}
+	var s statement
+	if err = json.Unmarshal(payload, &s); err != nil {
+		return errorutils.CheckErrorf("failed to parse the provenance statement: %s", err.Error())
+	}
+	if err = verifyStatement(&s, buildInfo); err != nil {
+		return err
+	}
+	keyId, err := getKeyId(key)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("The provenance of build %s/%s is valid. It was signed by key %s and attests %d artifacts.", vc.build.Name, vc.build.Number, keyId, len(s.Subject)))
+	return nil
+}
+
+// Downloads the attestation, which is the artifact of the 'provenance' module of the build. It's found by its checksum.
+func (vc *VerifyCommand) downloadProvenance(buildInfo *buildinfo.BuildInfo) (content []byte, err error) {
+	var artifact *buildinfo.Artifact
+	for _, module := range buildInfo.Modules {
+		if module.Id == provenanceModuleId && len(module.Artifacts) > 0 {
+			artifact = &module.Artifacts[len(module.Artifacts)-1]
+		}
+	}
+	if artifact == nil || artifact.Sha1 == "" {
+		return nil, errorutils.CheckErrorf("build %s/%s has no provenance. Publish it with 'jf rt build-publish --provenance', or run 'jf rt build-provenance'", vc.build.Name, vc.build.Number)
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(vc.serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	query := fmt.Sprintf(`items.find({"name":%s,"actual_sha1":%s}).include("repo","path","name").limit(1)`, builds.QuoteAql(artifact.Name), builds.QuoteAql(artifact.Sha1))
+	items, err := builds.SearchItems(servicesManager, query)
+	if err != nil {
+		return
+	}
+	if len(items) == 0 {
+		return nil, errorutils.CheckErrorf("the provenance of build %s/%s (%s) wasn't found in Artifactory", vc.build.Name, vc.build.Number, artifact.Path)
+	}
+	if content, err = readRemoteFile(servicesManager, path.Join(items[0].Repo, items[0].Path, items[0].Name)); err != nil {
+		return
+	}
+	if artifact.Sha256 != "" {
+		if digest := sha256.Sum256(content); hex.EncodeToString(digest[:]) != artifact.Sha256 {
+			return nil, errorutils.CheckErrorf("the checksum of the provenance doesn't match the build-info")
+		}
+	}
+	return
+}
+
+func readRemoteFile(servicesManager artifactory.ArtifactoryServicesManager, remotePath string) (content []byte, err error) {
+	reader, err := servicesManager.ReadRemoteFile(remotePath)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if e := reader.Close(); err == nil {
+			err = errorutils.CheckError(e)
+		}
+	}()
+	content, err = io.ReadAll(reader)
+	return content, errorutils.CheckError(err)
+}
+
+// Verifies that the statement is the provenance of the build, and that its subjects are the artifacts of the build.
+func verifyStatement(s *statement, buildInfo *buildinfo.BuildInfo) error {
+	if s.Type != statementType || s.PredicateType != predicateType {
+		return errorutils.CheckErrorf("the attestation isn't a SLSA v1 provenance statement")
+	}
+	parameters := s.Predicate.BuildDefinition.ExternalParameters
+	if parameters.BuildName != buildInfo.Name || parameters.BuildNumber != buildInfo.Number {
+		return errorutils.CheckErrorf("the provenance is of build %s/%s", parameters.BuildName, parameters.BuildNumber)
+	}
+	attested := map[string]bool{}
+	for _, subject := range s.Subject {
+		attested[getSubjectKey(subject)] = true
+	}
+	var missing []string
+	for _, subject := range getSubjects(buildInfo) {
+		key := getSubjectKey(subject)
+		if !attested[key] {
+			missing = append(missing, subject.Name)
+		}
+		delete(attested, key)
+	}
+	if len(missing) > 0 {
+		return errorutils.CheckErrorf("the following artifacts of the build aren't attested by the provenance: %s", strings.Join(missing, ", "))
+	}
+	if len(attested) > 0 {
+		return errorutils.CheckErrorf("the provenance attests %d artifacts which aren't artifacts of the build", len(attested))
+	}
+	return nil
+}
+
+func getSubjectKey(subject resourceDescriptor) string {
+	return subject.Name + "@" + subject.Digest["sha1"] + subject.Digest["sha256"]
+}
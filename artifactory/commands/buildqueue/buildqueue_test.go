@@ -0,0 +1,59 @@
+package buildqueue
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/buildqueue"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPublishedResult(t *testing.T) {
+	queued := &buildinfo.BuildInfo{Name: "app", Number: "1", Started: "2024-01-01T10:00:00.000+0000"}
+	assert.Equal(t, "", getPublishedResult(queued, nil, false))
+	assert.Equal(t, resultAlreadyPublished, getPublishedResult(queued, &buildinfo.BuildInfo{Started: queued.Started}, true))
+	assert.Equal(t, resultConflict, getPublishedResult(queued, &buildinfo.BuildInfo{Started: "2024-01-02T10:00:00.000+0000"}, true))
+}
+
+func TestIsTransientError(t *testing.T) {
+	assert.True(t, IsTransientError(&url.Error{Op: "Put", URL: "https://acme.jfrog.io/artifactory/api/build", Err: errors.New("connection refused")}))
+	assert.True(t, IsTransientError(clientutils.RetryExecutorTimeoutError{}))
+	assert.True(t, IsTransientError(errors.New("server response: 503 Service Unavailable")))
+	assert.False(t, IsTransientError(errors.New("server response: 400 Bad Request\n{\"errors\":[]}")))
+	assert.False(t, IsTransientError(errors.New("server response: 403 Forbidden")))
+	assert.False(t, IsTransientError(errors.New("no build-info was collected")))
+}
+
+func TestIsSameUrl(t *testing.T) {
+	assert.True(t, isSameUrl("https://acme.jfrog.io/artifactory", "https://acme.jfrog.io/artifactory/"))
+	assert.True(t, isSameUrl("", "https://acme.jfrog.io/artifactory/"))
+	assert.False(t, isSameUrl("https://other.jfrog.io/artifactory/", "https://acme.jfrog.io/artifactory/"))
+}
+
+func TestDrop(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	now := time.Now()
+	for _, number := range []string{"1", "2", "3"} {
+		assert.NoError(t, buildqueue.Save(buildqueue.NewEntry(&buildinfo.BuildInfo{Name: "app", Number: number}, "", "", "", nil, now)))
+	}
+
+	dropCmd := NewDropCommand().SetBuild("app", "2", "")
+	assert.NoError(t, dropCmd.Run())
+	assert.Len(t, dropCmd.Dropped(), 1)
+	assert.Error(t, NewDropCommand().SetBuild("app", "2", "").Run())
+
+	listCmd := NewListCommand()
+	assert.NoError(t, listCmd.Run())
+	assert.Len(t, listCmd.Entries(), 2)
+
+	dropCmd = NewDropCommand()
+	assert.NoError(t, dropCmd.Run())
+	assert.Len(t, dropCmd.Dropped(), 2)
+	assert.NoError(t, listCmd.Run())
+	assert.Empty(t, listCmd.Entries())
+}
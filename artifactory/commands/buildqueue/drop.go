@@ -0,0 +1,61 @@
+package buildqueue
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/buildqueue"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// DropCommand removes queued builds from the queue, without publishing them.
+type DropCommand struct {
+	// The build to drop. If nil, all the queued builds are dropped.
+	build   *buildindex.Build
+	dropped []*buildqueue.Entry
+}
+
+func NewDropCommand() *DropCommand {
+	return &DropCommand{}
+}
+
+func (dc *DropCommand) SetBuild(buildName, buildNumber, project string) *DropCommand {
+	dc.build = &buildindex.Build{Name: buildName, Number: buildNumber, Project: project}
+	return dc
+}
+
+func (dc *DropCommand) Dropped() []*buildqueue.Entry {
+	return dc.dropped
+}
+
+func (dc *DropCommand) Run() (err error) {
+	unlock, err := buildqueue.Lock()
+	defer func() {
+		if e := unlock(); err == nil {
+			err = e
+		}
+	}()
+	if err != nil {
+		return
+	}
+	entries, err := buildqueue.List()
+	if err != nil {
+		return
+	}
+	dc.dropped = nil
+	for _, entry := range entries {
+		if dc.build != nil && entry.Build != *dc.build {
+			continue
+		}
+		if err = buildqueue.Remove(entry.Build); err != nil {
+			return
+		}
+		dc.dropped = append(dc.dropped, entry)
+	}
+	if dc.build != nil && len(dc.dropped) == 0 {
+		return errorutils.CheckErrorf("build %s/%s isn't queued", dc.build.Name, dc.build.Number)
+	}
+	log.Info(fmt.Sprintf("Dropped %d queued builds.", len(dc.dropped)))
+	return
+}
@@ -0,0 +1,122 @@
+package buildqueue
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildqueue"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Matches the status code in the errors of the Artifactory responses, such as 'server response: 502 Bad Gateway'.
+var responseStatusPattern = regexp.MustCompile(`server response: (\d{3})`)
+
+// EnqueueCommand adds the local build-info of a build, which failed to be published, to the queue.
+// The build-info is aggregated the same way 'jf rt bp' does, and its local partials are removed once it's queued.
+type EnqueueCommand struct {
+	serverDetails      *config.ServerDetails
+	buildConfiguration *build.BuildConfiguration
+	buildUrl           string
+	envInclude         string
+	envExclude         string
+	publishErr         error
+}
+
+func NewEnqueueCommand() *EnqueueCommand {
+	return &EnqueueCommand{}
+}
+
+func (ec *EnqueueCommand) SetServerDetails(serverDetails *config.ServerDetails) *EnqueueCommand {
+	ec.serverDetails = serverDetails
+	return ec
+}
+
+func (ec *EnqueueCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *EnqueueCommand {
+	ec.buildConfiguration = buildConfiguration
+	return ec
+}
+
+func (ec *EnqueueCommand) SetBuildUrl(buildUrl string) *EnqueueCommand {
+	ec.buildUrl = buildUrl
+	return ec
+}
+
+func (ec *EnqueueCommand) SetEnvInclude(envInclude string) *EnqueueCommand {
+	ec.envInclude = envInclude
+	return ec
+}
+
+func (ec *EnqueueCommand) SetEnvExclude(envExclude string) *EnqueueCommand {
+	ec.envExclude = envExclude
+	return ec
+}
+
+// SetPublishError sets the error the build-info failed to be published with.
+func (ec *EnqueueCommand) SetPublishError(publishErr error) *EnqueueCommand {
+	ec.publishErr = publishErr
+	return ec
+}
+
+func (ec *EnqueueCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ec.serverDetails, nil
+}
+
+func (ec *EnqueueCommand) CommandName() string {
+	return "rt_build_queue_add"
+}
+
+func (ec *EnqueueCommand) Run() (err error) {
+	buildName, err := ec.buildConfiguration.GetBuildName()
+	if err != nil {
+		return
+	}
+	buildNumber, err := ec.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return
+	}
+	project := ec.buildConfiguration.GetProject()
+	showCommand := builds.NewShowCommand().SetServerDetails(ec.serverDetails).SetBuild(buildName, buildNumber, project).SetLocal(true).
+		SetBuildUrl(ec.buildUrl).SetEnvInclude(ec.envInclude).SetEnvExclude(ec.envExclude)
+	if err = showCommand.Run(); err != nil {
+		return
+	}
+	entry := buildqueue.NewEntry(showCommand.BuildInfo(), project, ec.serverDetails.ServerId, ec.serverDetails.ArtifactoryUrl, ec.publishErr, time.Now())
+	unlock, err := buildqueue.Lock()
+	defer func() {
+		if e := unlock(); err == nil {
+			err = e
+		}
+	}()
+	if err != nil {
+		return
+	}
+	if err = buildqueue.Save(entry); err != nil {
+		return
+	}
+	// The queue holds the build-info from now on, so the build isn't published twice.
+	if err = build.RemoveBuildDir(buildName, buildNumber, project); err != nil {
+		return
+	}
+	log.Info(fmt.Sprintf("The build-info of build %s/%s was queued.", buildName, buildNumber))
+	return
+}
+
+// IsTransientError returns true if the build-info failed to be published with an error which may not recur, so it can be queued:
+// a failure to send the request, or a 5xx response, which is returned after the retries of the client are exhausted.
+// Other responses, such as 400, 403 or 404, would be returned again when the queued build is published.
+func IsTransientError(err error) bool {
+	var netErr net.Error
+	var retriesErr clientutils.RetryExecutorTimeoutError
+	if errors.As(err, &netErr) || errors.As(err, &retriesErr) {
+		return true
+	}
+	match := responseStatusPattern.FindStringSubmatch(err.Error())
+	return match != nil && match[1][0] == '5'
+}
@@ -0,0 +1,184 @@
+package buildqueue
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/buildqueue"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	resultPublished        = "PUBLISHED"
+	resultAlreadyPublished = "ALREADY PUBLISHED"
+	resultConflict         = "CONFLICT"
+	resultFailed           = "FAILED"
+	resultSkipped          = "SKIPPED"
+)
+
+// Result is the result of the publishing of a queued build.
+type Result struct {
+	Entry   *buildqueue.Entry
+	Result  string
+	Details string
+}
+
+// FlushCommand publishes the queued builds, and removes them from the queue once they're published.
+// A build which is already published with the same build-info isn't published again.
+type FlushCommand struct {
+	serverDetails *config.ServerDetails
+	// The build to publish. If nil, all the queued builds are published.
+	build         *buildindex.Build
+	retries       int
+	retryWaitTime time.Duration
+	results       []Result
+}
+
+func NewFlushCommand() *FlushCommand {
+	return &FlushCommand{}
+}
+
+func (fc *FlushCommand) SetServerDetails(serverDetails *config.ServerDetails) *FlushCommand {
+	fc.serverDetails = serverDetails
+	return fc
+}
+
+func (fc *FlushCommand) SetBuild(buildName, buildNumber, project string) *FlushCommand {
+	fc.build = &buildindex.Build{Name: buildName, Number: buildNumber, Project: project}
+	return fc
+}
+
+// SetRetries sets the number of times the publishing of a build is retried after it fails.
+func (fc *FlushCommand) SetRetries(retries int) *FlushCommand {
+	fc.retries = retries
+	return fc
+}
+
+// SetRetryWaitTime sets the time to wait before the first retry. The time is doubled after each retry.
+func (fc *FlushCommand) SetRetryWaitTime(retryWaitTime time.Duration) *FlushCommand {
+	fc.retryWaitTime = retryWaitTime
+	return fc
+}
+
+func (fc *FlushCommand) Results() []Result {
+	return fc.results
+}
+
+func (fc *FlushCommand) ServerDetails() (*config.ServerDetails, error) {
+	return fc.serverDetails, nil
+}
+
+func (fc *FlushCommand) CommandName() string {
+	return "rt_build_queue_flush"
+}
+
+func (fc *FlushCommand) Run() (err error) {
+	// The queue is locked while the builds are published, so that concurrent flushes don't publish them twice.
+	unlock, err := buildqueue.Lock()
+	defer func() {
+		if e := unlock(); err == nil {
+			err = e
+		}
+	}()
+	if err != nil {
+		return
+	}
+	entries, err := buildqueue.List()
+	if err != nil {
+		return
+	}
+	// The requests aren't retried by the client, since the publishing of each build is retried with backoff.
+	servicesManager, err := credentialhelper.CreateServiceManager(fc.serverDetails, 0, 0, false)
+	if err != nil {
+		return
+	}
+	fc.results = nil
+	unpublished := 0
+	for _, entry := range entries {
+		if fc.build != nil && entry.Build != *fc.build {
+			continue
+		}
+		result := Result{Entry: entry}
+		if !isSameUrl(entry.Url, fc.serverDetails.ArtifactoryUrl) {
+			result.Result, result.Details = resultSkipped, "queued to be published to "+entry.Url
+		} else {
+			log.Info(fmt.Sprintf("Publishing the queued build %s/%s...", entry.Name, entry.Number))
+			result.Result, result.Details, err = fc.publish(servicesManager, entry)
+			if err != nil {
+				return
+			}
+		}
+		if result.Result == resultFailed || result.Result == resultConflict {
+			unpublished++
+		}
+		fc.results = append(fc.results, result)
+	}
+	if fc.build != nil && len(fc.results) == 0 {
+		return errorutils.CheckErrorf("build %s/%s isn't queued", fc.build.Name, fc.build.Number)
+	}
+	if unpublished > 0 {
+		return errorutils.CheckErrorf("%d queued builds weren't published", unpublished)
+	}
+	return
+}
+
+// Publishes the build-info with retries, and removes it from the queue once it's published.
+// Returns the result of the publishing and its details. A build which failed to be published is updated in the queue.
+func (fc *FlushCommand) publish(servicesManager artifactory.ArtifactoryServicesManager, entry *buildqueue.Entry) (result, details string, err error) {
+	wait := fc.retryWaitTime
+	var publishErr error
+	for attempt := 0; ; attempt++ {
+		// The build is checked before every attempt, since an attempt which failed may still have published it.
+		var published *buildinfo.BuildInfo
+		var found bool
+		published, _, found, publishErr = builds.GetPublishedBuildInfo(servicesManager, entry.Build)
+		if publishErr == nil {
+			switch getPublishedResult(entry.BuildInfo, published, found) {
+			case resultAlreadyPublished:
+				return resultAlreadyPublished, "the build-info was already published", buildqueue.Remove(entry.Build)
+			case resultConflict:
+				return resultConflict, fmt.Sprintf("a build-info which started at %s was published as this build", published.Started), nil
+			}
+			if _, publishErr = servicesManager.PublishBuildInfo(entry.BuildInfo, entry.Project); publishErr == nil {
+				return resultPublished, fmt.Sprintf("published after %d failed attempts", entry.Attempts), buildqueue.Remove(entry.Build)
+			}
+		}
+		if attempt >= fc.retries {
+			break
+		}
+		log.Warn(fmt.Sprintf("Failed to publish build %s/%s: %s\nRetrying in %s...", entry.Name, entry.Number, publishErr.Error(), wait))
+		time.Sleep(wait)
+		wait *= 2
+	}
+	entry.Attempts++
+	entry.LastAttempt = time.Now()
+	entry.LastError = publishErr.Error()
+	return resultFailed, strings.Join(strings.Fields(publishErr.Error()), " "), buildqueue.Save(entry)
+}
+
+// Returns resultAlreadyPublished if the queued build-info was published, resultConflict if a different build-info was
+// published as the same build, or an empty string if the build wasn't published.
+func getPublishedResult(queued, published *buildinfo.BuildInfo, found bool) string {
+	switch {
+	case !found:
+		return ""
+	case published.Started == queued.Started:
+		return resultAlreadyPublished
+	default:
+		return resultConflict
+	}
+}
+
+// Returns true if the queued build should be published to the Artifactory URL. Builds which were queued without a URL can be published anywhere.
+func isSameUrl(queuedUrl, artifactoryUrl string) bool {
+	return queuedUrl == "" || clientutils.AddTrailingSlashIfNeeded(queuedUrl) == clientutils.AddTrailingSlashIfNeeded(artifactoryUrl)
+}
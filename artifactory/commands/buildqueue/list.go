@@ -0,0 +1,24 @@
+package buildqueue
+
+import (
+	"github.com/jfrog/jfrog-cli/utils/buildqueue"
+)
+
+// ListCommand lists the queued builds.
+type ListCommand struct {
+	entries []*buildqueue.Entry
+}
+
+func NewListCommand() *ListCommand {
+	return &ListCommand{}
+}
+
+func (lc *ListCommand) Entries() []*buildqueue.Entry {
+	return lc.entries
+}
+
+// Run reads the queued builds. The queue isn't locked, since the queued builds are replaced atomically.
+func (lc *ListCommand) Run() (err error) {
+	lc.entries, err = buildqueue.List()
+	return
+}
@@ -0,0 +1,62 @@
+package buildqueue
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/buildqueue"
+)
+
+type entryRow struct {
+	Name      string `col-name:"Build Name"`
+	Number    string `col-name:"Build Number"`
+	Project   string `col-name:"Project" omitempty:"true"`
+	Url       string `col-name:"Artifactory URL"`
+	QueuedAt  string `col-name:"Queued At"`
+	Attempts  string `col-name:"Attempts"`
+	Artifacts string `col-name:"Artifacts"`
+	LastError string `col-name:"Last Error" omitempty:"true"`
+}
+
+type resultRow struct {
+	Name    string `col-name:"Build Name"`
+	Number  string `col-name:"Build Number"`
+	Project string `col-name:"Project" omitempty:"true"`
+	Result  string `col-name:"Result"`
+	Details string `col-name:"Details" omitempty:"true"`
+}
+
+// PrintQueue prints the queued builds.
+func PrintQueue(entries []*buildqueue.Entry) error {
+	var rows []entryRow
+	for _, entry := range entries {
+		rows = append(rows, entryRow{
+			Name:      entry.Name,
+			Number:    entry.Number,
+			Project:   entry.Project,
+			Url:       entry.Url,
+			QueuedAt:  entry.QueuedAt.Local().Format(time.RFC3339),
+			Attempts:  strconv.Itoa(entry.Attempts),
+			Artifacts: strconv.Itoa(len(entry.Artifacts)),
+			LastError: strings.Join(strings.Fields(entry.LastError), " "),
+		})
+	}
+	return coreutils.PrintTable(rows, "Queued Builds", "No builds are queued", false)
+}
+
+// PrintResults prints the results of publishing the queued builds.
+func PrintResults(results []Result) error {
+	var rows []resultRow
+	for _, result := range results {
+		rows = append(rows, resultRow{
+			Name:    result.Entry.Name,
+			Number:  result.Entry.Number,
+			Project: result.Entry.Project,
+			Result:  result.Result,
+			Details: result.Details,
+		})
+	}
+	return coreutils.PrintTable(rows, "Published Queued Builds", "No builds are queued", false)
+}
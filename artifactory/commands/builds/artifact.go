@@ -0,0 +1,144 @@
+package builds
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	servicesutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// UploadArtifact uploads a file generated from the build-info, such as an SBOM, with the properties of the build, and adds it
+// to the build-info as an artifact of the module. The target is in the form of repo/path.
+// The local build-info gets the artifact when it's published. The published build-info is published again with the artifact.
+func UploadArtifact(serverDetails *config.ServerDetails, buildInfo *buildinfo.BuildInfo, b buildindex.Build, local bool, moduleId, target string, content []byte) (err error) {
+	repo, repoPath, found := strings.Cut(target, "/")
+	if !found || repo == "" || repoPath == "" || strings.HasSuffix(repoPath, "/") {
+		return errorutils.CheckErrorf("the upload target should be in the form of repo/path, but got '%s'", target)
+	}
+	tempDir, err := fileutils.CreateTempDir()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if e := fileutils.RemoveTempDir(tempDir); err == nil {
+			err = e
+		}
+	}()
+	// The local file has a fixed name, so that the upload pattern has no wildcards. It's renamed by the upload.
+	localPath := filepath.Join(tempDir, moduleId)
+	if err = os.WriteFile(localPath, content, 0600); err != nil {
+		return errorutils.CheckError(err)
+	}
+	fileDetails, err := fileutils.GetFileDetails(localPath, true)
+	if err != nil {
+		return
+	}
+
+	servicesManager, err := credentialhelper.CreateServiceManager(serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	buildProps, err := getBuildProps(buildInfo, b, local)
+	if err != nil {
+		return
+	}
+	uploadParams := services.NewUploadParams()
+	uploadParams.Pattern = localPath
+	uploadParams.Target = target
+	uploadParams.Flat = true
+	uploadParams.BuildProps = buildProps
+	uploaded, failed, err := servicesManager.UploadFiles(uploadParams)
+	if err != nil {
+		return
+	}
+	if uploaded != 1 || failed > 0 {
+		return errorutils.CheckErrorf("failed to upload %s", target)
+	}
+	log.Info(fmt.Sprintf("Uploaded %s.", target))
+
+	artifact := buildinfo.Artifact{Name: path.Base(repoPath), Type: strings.TrimPrefix(path.Ext(repoPath), "."), Path: repoPath, Checksum: fileDetails.Checksum}
+	if local {
+		return build.SavePartialBuildInfo(b.Name, b.Number, b.Project, func(partial *buildinfo.Partial) {
+			partial.ModuleId = moduleId
+			partial.ModuleType = buildinfo.Generic
+			partial.Artifacts = []buildinfo.Artifact{artifact}
+		})
+	}
+	return addToPublishedBuild(servicesManager, b, moduleId, artifact)
+}
+
+// Returns the build properties of the uploaded file, like the properties set by 'jf rt upload --build-name --build-number'.
+func getBuildProps(buildInfo *buildinfo.BuildInfo, b buildindex.Build, local bool) (string, error) {
+	if local {
+		return build.CreateBuildProperties(b.Name, b.Number, b.Project)
+	}
+	started, err := time.Parse(buildinfo.TimeFormat, buildInfo.Started)
+	if err != nil {
+		return "", errorutils.CheckErrorf("failed to parse the start time of the build '%s': %s", buildInfo.Started, err.Error())
+	}
+	return fmt.Sprintf("build.name=%s;build.number=%s;build.timestamp=%s", b.Name, b.Number, strconv.FormatInt(started.UnixMilli(), 10)), nil
+}
+
+// Publishes the build-info again with the artifact, replacing the module if it already exists. The build-info is modified
+// as JSON, so that fields which are not known to the CLI, such as the promotion statuses, are kept.
+func addToPublishedBuild(servicesManager artifactory.ArtifactoryServicesManager, b buildindex.Build, moduleId string, artifact buildinfo.Artifact) error {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	projectParam := servicesutils.GetProjectQueryParam(b.Project)
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+"api/build/"+url.PathEscape(b.Name)+"/"+url.PathEscape(b.Number)+projectParam, true, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	var published struct {
+		BuildInfo map[string]interface{} `json:"buildInfo"`
+	}
+	if err = json.Unmarshal(body, &published); err != nil {
+		return errorutils.CheckError(err)
+	}
+	var modules []interface{}
+	if existing, ok := published.BuildInfo["modules"].([]interface{}); ok {
+		for _, module := range existing {
+			if moduleMap, ok := module.(map[string]interface{}); ok && moduleMap["id"] == moduleId {
+				continue
+			}
+			modules = append(modules, module)
+		}
+	}
+	published.BuildInfo["modules"] = append(modules, buildinfo.Module{Id: moduleId, Type: buildinfo.Generic, Artifacts: []buildinfo.Artifact{artifact}})
+	content, err := json.Marshal(published.BuildInfo)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpDetails = serviceDetails.CreateHttpClientDetails()
+	servicesutils.SetContentType("application/vnd.org.jfrog.artifactory+json", &httpDetails.Headers)
+	resp, body, err = servicesManager.Client().SendPut(serviceDetails.GetUrl()+"api/build"+projectParam, content, &httpDetails)
+	if err != nil {
+		return err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Added %s to the artifacts of build %s.", artifact.Name, FormatBuild(b)))
+	return nil
+}
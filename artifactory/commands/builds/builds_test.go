@@ -0,0 +1,138 @@
+package builds
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShowLocal(t *testing.T) {
+	buildName := fmt.Sprintf("builds-test-%d", time.Now().UnixNano())
+	defer func() {
+		assert.NoError(t, build.RemoveBuildDir(buildName, "1", ""))
+	}()
+	showCommand := NewShowCommand().SetBuild(buildName, "1", "").SetLocal(true).SetEnvInclude("*").SetEnvExclude("*secret*")
+	assert.ErrorContains(t, showCommand.Run(), "no build-info was collected locally")
+
+	assert.NoError(t, build.SaveBuildGeneralDetails(buildName, "1", ""))
+	assert.NoError(t, build.SavePartialBuildInfo(buildName, "1", "", func(partial *buildinfo.Partial) {
+		partial.ModuleId = "app"
+		partial.ModuleType = buildinfo.Generic
+		partial.Artifacts = []buildinfo.Artifact{{Name: "app.zip", Checksum: buildinfo.Checksum{Sha1: "a1"}}}
+	}))
+	assert.NoError(t, build.SavePartialBuildInfo(buildName, "1", "", func(partial *buildinfo.Partial) {
+		partial.Env = buildinfo.Env{"buildInfo.env.JAVA_HOME": "/opt/java", "buildInfo.env.MY_SECRET": "secret"}
+	}))
+	assert.NoError(t, showCommand.SetServerDetails(&config.ServerDetails{User: "ci"}).SetBuildUrl("https://ci.acme.io/1").Run())
+	buildInfo := showCommand.BuildInfo()
+	assert.Equal(t, buildName, buildInfo.Name)
+	assert.Equal(t, "ci", buildInfo.Principal)
+	assert.Equal(t, "https://ci.acme.io/1", buildInfo.BuildUrl)
+	if assert.Len(t, buildInfo.Modules, 1) {
+		assert.Len(t, buildInfo.Modules[0].Artifacts, 1)
+	}
+	assert.Equal(t, buildinfo.Env{"buildInfo.env.JAVA_HOME": "/opt/java"}, buildInfo.Properties)
+
+	// Showing the build indexes it, so that it is listed.
+	listCommand := NewListCommand().SetLocal(true).SetNamePattern("builds-test-*")
+	assert.NoError(t, listCommand.Run())
+	assert.Contains(t, getBuildNames(listCommand.Runs()), buildName)
+	pending, err := buildindex.List()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pending)
+}
+
+func TestListPublished(t *testing.T) {
+	responses := map[string]string{
+		"/api/build":          `{"builds":[{"uri":"/app","lastStarted":"2024-05-02T10:00:00.000+0000"},{"uri":"/my%20lib"},{"uri":"/other"}]}`,
+		"/api/build/app":      `{"buildsNumbers":[{"uri":"/1","started":"2024-05-01T10:00:00.000+0000"},{"uri":"/3","started":"2024-05-03T10:00:00.000+0000"},{"uri":"/2","started":"2024-05-02T10:00:00.000+0000"}]}`,
+		"/api/build/app/3":    `{"buildInfo":{"name":"app","number":"3"}}`,
+		"/api/build/app/2":    `{"buildInfo":{"name":"app","number":"2","statuses":[{"status":"staged"},{"status":"released"}]}}`,
+		"/api/build/my lib":   `{"buildsNumbers":[{"uri":"/7","started":"2024-05-01T10:00:00.000+0000"}]}`,
+		"/api/build/my lib/7": `{"buildInfo":{"name":"my lib","number":"7"}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "acme", r.URL.Query().Get("project"))
+		response, exists := responses[r.URL.Path]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(response))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	listCommand := NewListCommand().SetServerDetails(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}).
+		SetNamePattern("*").SetProject("acme").SetLimit(2)
+	assert.NoError(t, listCommand.Run())
+	var runs []string
+	for _, run := range listCommand.Runs() {
+		runs = append(runs, run.Name+"/"+run.Number+":"+run.Status)
+	}
+	assert.Equal(t, []string{"app/3:published", "app/2:released", "my lib/7:published"}, runs)
+
+	assert.NoError(t, listCommand.SetNamePattern("my*").Run())
+	assert.Equal(t, []string{"my lib"}, getBuildNames(listCommand.Runs()))
+}
+
+func getBuildNames(runs []BuildRun) (names []string) {
+	for _, run := range runs {
+		names = append(names, run.Name)
+	}
+	return
+}
+
+func TestSearchProjectBuildItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+		switch r.URL.Path {
+		case "/api/build/app/1":
+			assert.Equal(t, "acme", r.URL.Query().Get("project"))
+			response = `{"buildInfo":{"name":"app","number":"1","modules":[{"id":"a","artifacts":[{"name":"a.jar","sha1":"sha1-a"},{"name":"b.jar","sha1":"sha1-b"}],` +
+				`"dependencies":[{"id":"dep","sha1":"sha1-dep"}]},{"id":"b","artifacts":[{"name":"a.jar","sha1":"sha1-a"}]}]}}`
+		case "/api/search/aql":
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			// The items are searched by the checksums of the build-info, rather than by the build name, which isn't unique across projects.
+			assert.Equal(t, `items.find({"$or":[{"actual_sha1":"sha1-a"},{"actual_sha1":"sha1-b"}]}).include("repo","path","name","actual_sha1","sha256","size")`, string(body))
+			response = `{"results":[{"repo":"libs","path":"app","name":"a.jar","size":10},{"repo":"libs","path":"app","name":"b.jar","size":20}]}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(response))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	servicesManager, err := credentialhelper.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, -1, 0, false)
+	assert.NoError(t, err)
+	items, err := SearchBuildItems(servicesManager, buildindex.Build{Name: "app", Number: "1", Project: "acme"}, "artifact")
+	assert.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	items, err = SearchBuildItems(servicesManager, buildindex.Build{Name: "app", Number: "2", Project: "acme"}, "artifact")
+	assert.NoError(t, err)
+	assert.Empty(t, items)
+}
+
+func TestFormatBuild(t *testing.T) {
+	assert.Equal(t, "app/1", FormatBuild(buildindex.Build{Name: "app", Number: "1"}))
+	assert.Equal(t, "app/1 of project acme", FormatBuild(buildindex.Build{Name: "app", Number: "1", Project: "acme"}))
+}
+
+func TestSortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, SortedKeys(map[string]int{"c": 1, "a": 2}, map[string]int{"b": 3, "a": 4}))
+	assert.Empty(t, SortedKeys[bool]())
+}
@@ -0,0 +1,283 @@
+package builds
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	// The status of a local build, whose build-info wasn't published yet.
+	PendingStatus = "pending"
+	// The status of a published build, which wasn't promoted.
+	PublishedStatus = "published"
+)
+
+// BuildRun is a build number of a build, which is either published or pending.
+type BuildRun struct {
+	Name    string `json:"name"`
+	Number  string `json:"number"`
+	Project string `json:"project,omitempty"`
+	Started string `json:"started"`
+	// 'pending', 'published', or the status of the last promotion of the build.
+	Status string `json:"status"`
+	// The local build directory of a pending build which isn't in the builds index, and therefore has no name and number.
+	Dir string `json:"dir,omitempty"`
+	// Used for sorting.
+	startedTime time.Time
+}
+
+// ListCommand lists the numbers of the published builds, or the builds whose build-info was collected locally.
+type ListCommand struct {
+	serverDetails *config.ServerDetails
+	local         bool
+	// A wildcard pattern of the names of the builds to list. If empty, all builds are listed.
+	namePattern string
+	project     string
+	// The maximum number of published build numbers to list per build.
+	limit int
+	runs  []BuildRun
+}
+
+func NewListCommand() *ListCommand {
+	return &ListCommand{}
+}
+
+func (lc *ListCommand) SetServerDetails(serverDetails *config.ServerDetails) *ListCommand {
+	lc.serverDetails = serverDetails
+	return lc
+}
+
+// SetLocal sets whether to list the builds whose build-info was collected locally, rather than the published builds.
+func (lc *ListCommand) SetLocal(local bool) *ListCommand {
+	lc.local = local
+	return lc
+}
+
+func (lc *ListCommand) SetNamePattern(namePattern string) *ListCommand {
+	lc.namePattern = namePattern
+	return lc
+}
+
+func (lc *ListCommand) SetProject(project string) *ListCommand {
+	lc.project = project
+	return lc
+}
+
+func (lc *ListCommand) SetLimit(limit int) *ListCommand {
+	lc.limit = limit
+	return lc
+}
+
+func (lc *ListCommand) Runs() []BuildRun {
+	return lc.runs
+}
+
+func (lc *ListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return lc.serverDetails, nil
+}
+
+func (lc *ListCommand) CommandName() string {
+	return "rt_build_list"
+}
+
+func (lc *ListCommand) Run() (err error) {
+	if lc.local {
+		lc.runs, err = lc.listLocalBuilds()
+	} else {
+		lc.runs, err = lc.listPublishedBuilds()
+	}
+	if err != nil {
+		return
+	}
+	sortRuns(lc.runs)
+	return
+}
+
+func (lc *ListCommand) listLocalBuilds() ([]BuildRun, error) {
+	pending, err := buildindex.List()
+	if err != nil {
+		return nil, err
+	}
+	runs := []BuildRun{}
+	for _, pendingBuild := range pending {
+		// The builds which aren't in the index can't be filtered, so they are listed by their directories only if no filter is set.
+		// Running 'jf rt build-show --local' with the build name and number adds a build to the index.
+		if pendingBuild.Name == "" {
+			if lc.project == "" && lc.namePattern == "" {
+				runs = append(runs, BuildRun{Started: buildindex.FormatTime(pendingBuild.Started), Status: PendingStatus, Dir: pendingBuild.Dir,
+					startedTime: pendingBuild.Started})
+			}
+			continue
+		}
+		if lc.project != "" && pendingBuild.Project != lc.project {
+			continue
+		}
+		matched, err := matchName(lc.namePattern, pendingBuild.Name)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			runs = append(runs, BuildRun{Name: pendingBuild.Name, Number: pendingBuild.Number, Project: pendingBuild.Project,
+				Started: buildindex.FormatTime(pendingBuild.Started), Status: PendingStatus, startedTime: pendingBuild.Started})
+		}
+	}
+	return runs, nil
+}
+
+func (lc *ListCommand) listPublishedBuilds() ([]BuildRun, error) {
+	servicesManager, err := credentialhelper.CreateServiceManager(lc.serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	names, err := ListBuildNames(servicesManager, lc.namePattern, lc.project)
+	if err != nil {
+		return nil, err
+	}
+	runs := []BuildRun{}
+	for _, name := range names {
+		nameRuns, err := lc.getBuildRuns(servicesManager, name)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, nameRuns...)
+	}
+	return runs, nil
+}
+
+// ListBuildNames returns the names of the published builds which match the wildcard pattern. If the pattern is empty, all names are returned.
+func ListBuildNames(servicesManager artifactory.ArtifactoryServicesManager, namePattern, project string) ([]string, error) {
+	body, found, err := sendGet(servicesManager, "api/build", project)
+	if err != nil || !found {
+		return nil, err
+	}
+	var result struct {
+		Builds []struct {
+			Uri string `json:"uri"`
+		} `json:"builds"`
+	}
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var names []string
+	for _, b := range result.Builds {
+		name, err := url.PathUnescape(strings.TrimPrefix(b.Uri, "/"))
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		matched, err := matchName(namePattern, name)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Returns the most recent numbers of the build, along with their statuses.
+func (lc *ListCommand) getBuildRuns(servicesManager artifactory.ArtifactoryServicesManager, name string) ([]BuildRun, error) {
+	runs, err := ListBuildRuns(servicesManager, name, lc.project)
+	if err != nil {
+		return nil, err
+	}
+	if lc.limit > 0 && len(runs) > lc.limit {
+		runs = runs[:lc.limit]
+	}
+	for i := range runs {
+		if runs[i].Status, err = lc.getStatus(servicesManager, name, runs[i].Number); err != nil {
+			return nil, err
+		}
+	}
+	return runs, nil
+}
+
+// ListBuildRuns returns the published numbers of the build from the most recent, without their statuses.
+func ListBuildRuns(servicesManager artifactory.ArtifactoryServicesManager, name, project string) ([]BuildRun, error) {
+	body, found, err := sendGet(servicesManager, "api/build/"+url.PathEscape(name), project)
+	if err != nil || !found {
+		return nil, err
+	}
+	var result struct {
+		BuildsNumbers []struct {
+			Uri     string `json:"uri"`
+			Started string `json:"started"`
+		} `json:"buildsNumbers"`
+	}
+	if err = json.Unmarshal(body, &result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var runs []BuildRun
+	for _, buildNumber := range result.BuildsNumbers {
+		number, err := url.PathUnescape(strings.TrimPrefix(buildNumber.Uri, "/"))
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		started, _ := time.Parse(buildinfo.TimeFormat, buildNumber.Started)
+		runs = append(runs, BuildRun{Name: name, Number: number, Project: project, Started: buildNumber.Started, startedTime: started})
+	}
+	sortRuns(runs)
+	return runs, nil
+}
+
+// Returns the status of the last promotion of the build, or 'published' if the build wasn't promoted.
+func (lc *ListCommand) getStatus(servicesManager artifactory.ArtifactoryServicesManager, name, number string) (string, error) {
+	_, statuses, found, err := GetPublishedBuildInfo(servicesManager, buildindex.Build{Name: name, Number: number, Project: lc.project})
+	if err != nil || !found {
+		return PublishedStatus, err
+	}
+	if len(statuses) == 0 || statuses[len(statuses)-1].Status == "" {
+		return PublishedStatus, nil
+	}
+	return statuses[len(statuses)-1].Status, nil
+}
+
+func matchName(namePattern, name string) (bool, error) {
+	if namePattern == "" {
+		return true, nil
+	}
+	matched, err := stringutils.MatchWildcardPattern(namePattern, name)
+	return matched, errorutils.CheckError(err)
+}
+
+// Sends a GET request with the project. A 404 response means that there are no builds.
+func sendGet(servicesManager artifactory.ArtifactoryServicesManager, restApi, project string) (body []byte, found bool, err error) {
+	if project != "" {
+		restApi += "?project=" + url.QueryEscape(project)
+	}
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+restApi, true, &httpDetails)
+	if err != nil {
+		return nil, false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, false, err
+	}
+	return body, true, nil
+}
+
+// Sorts the runs by the build names, and then from the most recent.
+func sortRuns(runs []BuildRun) {
+	sort.SliceStable(runs, func(i, j int) bool {
+		if runs[i].Name != runs[j].Name {
+			return runs[i].Name < runs[j].Name
+		}
+		return runs[i].startedTime.After(runs[j].startedTime)
+	})
+}
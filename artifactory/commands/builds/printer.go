@@ -0,0 +1,118 @@
+package builds
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/buildenv"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type moduleRow struct {
+	Id           string `col-name:"Module"`
+	Type         string `col-name:"Type"`
+	Artifacts    int    `col-name:"Artifacts"`
+	Dependencies int    `col-name:"Dependencies"`
+}
+
+type vcsRow struct {
+	Url      string `col-name:"URL"`
+	Branch   string `col-name:"Branch"`
+	Revision string `col-name:"Revision"`
+}
+
+type envRow struct {
+	Name  string `col-name:"Name"`
+	Value string `col-name:"Value"`
+}
+
+type runRow struct {
+	Name    string `col-name:"Build Name"`
+	Number  string `col-name:"Build Number"`
+	Project string `col-name:"Project" omitempty:"true"`
+	Started string `col-name:"Started"`
+	Status  string `col-name:"Status"`
+}
+
+// PrintBuildInfo prints the build-info as JSON, or its modules, VCS details and environment variables as tables.
+func PrintBuildInfo(buildInfo *buildinfo.BuildInfo, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		return printJson(buildInfo)
+	}
+	log.Output(fmt.Sprintf("Build:     %s/%s", buildInfo.Name, buildInfo.Number))
+	log.Output("Started:   " + buildInfo.Started)
+	if buildInfo.Principal != "" {
+		log.Output("Principal: " + buildInfo.Principal)
+	}
+	if buildInfo.BuildUrl != "" {
+		log.Output("URL:       " + buildInfo.BuildUrl)
+	}
+	if buildInfo.Issues != nil && len(buildInfo.Issues.AffectedIssues) > 0 {
+		log.Output(fmt.Sprintf("Issues:    %d", len(buildInfo.Issues.AffectedIssues)))
+	}
+	var modules []moduleRow
+	for _, module := range buildInfo.Modules {
+		modules = append(modules, moduleRow{Id: module.Id, Type: string(module.Type), Artifacts: len(module.Artifacts), Dependencies: len(module.Dependencies)})
+	}
+	if err := coreutils.PrintTable(modules, "Modules", "No modules were collected", false); err != nil {
+		return err
+	}
+	var vcs []vcsRow
+	for _, vcsDetails := range buildInfo.VcsList {
+		vcs = append(vcs, vcsRow{Url: vcsDetails.Url, Branch: vcsDetails.Branch, Revision: vcsDetails.Revision})
+	}
+	if err := coreutils.PrintTable(vcs, "VCS", "No VCS details were collected", false); err != nil {
+		return err
+	}
+	var env []envRow
+	// The tool and host facts collected by 'jf rt bce --tools --host'.
+	var facts []envRow
+	for key, value := range buildInfo.Properties {
+		if name, found := strings.CutPrefix(key, buildinfo.BuildInfoEnvPrefix); found {
+			env = append(env, envRow{Name: name, Value: value})
+		} else if strings.HasPrefix(key, buildenv.ToolsPrefix) || strings.HasPrefix(key, buildenv.HostPrefix) {
+			facts = append(facts, envRow{Name: strings.TrimPrefix(key, "buildInfo."), Value: value})
+		}
+	}
+	sort.Slice(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+	if err := coreutils.PrintTable(env, "Environment Variables", "No environment variables were collected", false); err != nil {
+		return err
+	}
+	if len(facts) == 0 {
+		return nil
+	}
+	sort.Slice(facts, func(i, j int) bool { return facts[i].Name < facts[j].Name })
+	return coreutils.PrintTable(facts, "Tools and Host", "", false)
+}
+
+// PrintRuns prints the build runs as a table or as JSON.
+func PrintRuns(runs []BuildRun, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		return printJson(runs)
+	}
+	var rows []runRow
+	for _, run := range runs {
+		name := run.Name
+		if name == "" {
+			name = "unknown (" + run.Dir + ")"
+		}
+		rows = append(rows, runRow{Name: name, Number: run.Number, Project: run.Project, Started: run.Started, Status: run.Status})
+	}
+	return coreutils.PrintTable(rows, "Builds", "No builds were found", false)
+}
+
+func printJson(value interface{}) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(clientutils.IndentJson(content))
+	return nil
+}
@@ -0,0 +1,149 @@
+package builds
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	servicesutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// PromotionStatus is a status set by a promotion of a published build.
+type PromotionStatus struct {
+	Status     string `json:"status"`
+	Repository string `json:"repository,omitempty"`
+	Timestamp  string `json:"timestamp,omitempty"`
+	User       string `json:"user,omitempty"`
+	Comment    string `json:"comment,omitempty"`
+}
+
+// GetPublishedBuildInfo returns the published build-info along with its promotion statuses, which aren't a part of the build-info entity.
+// found is false if the build wasn't published.
+func GetPublishedBuildInfo(servicesManager artifactory.ArtifactoryServicesManager, b buildindex.Build) (buildInfo *buildinfo.BuildInfo, statuses []PromotionStatus, found bool, err error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	restApi := "api/build/" + url.PathEscape(b.Name) + "/" + url.PathEscape(b.Number) + servicesutils.GetProjectQueryParam(b.Project)
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+restApi, true, &httpDetails)
+	if err != nil {
+		return
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil, false, nil
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return
+	}
+	var published struct {
+		BuildInfo struct {
+			buildinfo.BuildInfo
+			Statuses []PromotionStatus `json:"statuses"`
+		} `json:"buildInfo"`
+	}
+	if err = json.Unmarshal(body, &published); err != nil {
+		return nil, nil, false, errorutils.CheckError(err)
+	}
+	return &published.BuildInfo.BuildInfo, published.BuildInfo.Statuses, true, nil
+}
+
+// AqlItem is an item found by an AQL query. Only the fields included by the query are set.
+type AqlItem struct {
+	Repo       string `json:"repo"`
+	Path       string `json:"path"`
+	Name       string `json:"name"`
+	ActualSha1 string `json:"actual_sha1,omitempty"`
+	Sha256     string `json:"sha256,omitempty"`
+	Size       int64  `json:"size,omitempty"`
+}
+
+// SearchItems returns the items found by the AQL query.
+func SearchItems(servicesManager artifactory.ArtifactoryServicesManager, query string) (items []AqlItem, err error) {
+	reader, err := servicesManager.Aql(query)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if e := reader.Close(); err == nil {
+			err = errorutils.CheckError(e)
+		}
+	}()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var result struct {
+		Results []AqlItem `json:"results"`
+	}
+	if err = json.Unmarshal(content, &result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return result.Results, nil
+}
+
+// The maximal number of checksums searched by a single AQL query.
+const aqlChecksumsBatchSize = 100
+
+// SearchBuildItems returns the items which are the artifacts of the build, or its dependencies if domain is "dependency".
+// The items are matched to the build by their checksums, so copies of the items in other repositories are also returned.
+func SearchBuildItems(servicesManager artifactory.ArtifactoryServicesManager, b buildindex.Build, domain string) ([]AqlItem, error) {
+	if b.Project != "" {
+		return searchProjectBuildItems(servicesManager, b, domain)
+	}
+	query := fmt.Sprintf(`items.find({"%[1]s.module.build.name":%[2]s,"%[1]s.module.build.number":%[3]s}).include("repo","path","name","actual_sha1","sha256","size")`,
+		domain, QuoteAql(b.Name), QuoteAql(b.Number))
+	return SearchItems(servicesManager, query)
+}
+
+// The build fields of AQL don't tell apart builds with the same name and number in different projects,
+// so the items of a build in a project are searched by the checksums in its build-info, which is read from the project.
+func searchProjectBuildItems(servicesManager artifactory.ArtifactoryServicesManager, b buildindex.Build, domain string) ([]AqlItem, error) {
+	buildInfo, _, found, err := GetPublishedBuildInfo(servicesManager, b)
+	if err != nil || !found {
+		return nil, err
+	}
+	var conditions []string
+	added := map[string]bool{}
+	addChecksum := func(sha1 string) {
+		if sha1 != "" && !added[sha1] {
+			added[sha1] = true
+			conditions = append(conditions, fmt.Sprintf(`{"actual_sha1":%s}`, QuoteAql(sha1)))
+		}
+	}
+	for _, module := range buildInfo.Modules {
+		if domain == "dependency" {
+			for _, dependency := range module.Dependencies {
+				addChecksum(dependency.Sha1)
+			}
+			continue
+		}
+		for _, artifact := range module.Artifacts {
+			addChecksum(artifact.Sha1)
+		}
+	}
+	var items []AqlItem
+	for start := 0; start < len(conditions); start += aqlChecksumsBatchSize {
+		end := start + aqlChecksumsBatchSize
+		if end > len(conditions) {
+			end = len(conditions)
+		}
+		query := fmt.Sprintf(`items.find({"$or":[%s]}).include("repo","path","name","actual_sha1","sha256","size")`, strings.Join(conditions[start:end], ","))
+		batch, err := SearchItems(servicesManager, query)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, batch...)
+	}
+	return items, nil
+}
+
+// QuoteAql quotes a value of an AQL query.
+func QuoteAql(value string) string {
+	quoted, _ := json.Marshal(value)
+	return string(quoted)
+}
@@ -0,0 +1,169 @@
+package builds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// ShowCommand gets the build-info of a published build, or the build-info collected locally for a build which wasn't published yet.
+type ShowCommand struct {
+	// Used to get the published build, and as the principal of a local build. May be nil for a local build.
+	serverDetails *config.ServerDetails
+	build         buildindex.Build
+	local         bool
+	buildUrl      string
+	envInclude    string
+	envExclude    string
+	buildInfo     *buildinfo.BuildInfo
+}
+
+func NewShowCommand() *ShowCommand {
+	return &ShowCommand{}
+}
+
+func (sc *ShowCommand) SetServerDetails(serverDetails *config.ServerDetails) *ShowCommand {
+	sc.serverDetails = serverDetails
+	return sc
+}
+
+func (sc *ShowCommand) SetBuild(buildName, buildNumber, project string) *ShowCommand {
+	sc.build = buildindex.Build{Name: buildName, Number: buildNumber, Project: project}
+	return sc
+}
+
+// SetLocal sets whether to show the build-info collected locally, rather than the published build-info.
+func (sc *ShowCommand) SetLocal(local bool) *ShowCommand {
+	sc.local = local
+	return sc
+}
+
+// SetBuildUrl sets the build URL, which is added to the local build-info by 'jf rt bp --build-url'.
+func (sc *ShowCommand) SetBuildUrl(buildUrl string) *ShowCommand {
+	sc.buildUrl = buildUrl
+	return sc
+}
+
+// SetEnvInclude sets the patterns, separated by semicolons, of the environment variables to include in the local build-info.
+func (sc *ShowCommand) SetEnvInclude(envInclude string) *ShowCommand {
+	sc.envInclude = envInclude
+	return sc
+}
+
+// SetEnvExclude sets the patterns, separated by semicolons, of the environment variables to exclude from the local build-info.
+func (sc *ShowCommand) SetEnvExclude(envExclude string) *ShowCommand {
+	sc.envExclude = envExclude
+	return sc
+}
+
+func (sc *ShowCommand) BuildInfo() *buildinfo.BuildInfo {
+	return sc.buildInfo
+}
+
+func (sc *ShowCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sc.serverDetails, nil
+}
+
+func (sc *ShowCommand) CommandName() string {
+	return "rt_build_show"
+}
+
+func (sc *ShowCommand) Run() (err error) {
+	if sc.local {
+		sc.buildInfo, err = sc.getLocalBuildInfo()
+		return
+	}
+	sc.buildInfo, err = sc.getPublishedBuildInfo()
+	return
+}
+
+// Aggregates the local build-info the same way 'jf rt bp' does, without publishing it.
+func (sc *ShowCommand) getLocalBuildInfo() (*buildinfo.BuildInfo, error) {
+	exists, err := buildindex.Exists(sc.build)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errorutils.CheckErrorf("no build-info was collected locally for build %s", FormatBuild(sc.build))
+	}
+	if err = buildindex.Record(sc.build); err != nil {
+		return nil, err
+	}
+	localBuild, err := build.CreateBuildInfoService().GetOrCreateBuildWithProject(sc.build.Name, sc.build.Number, sc.build.Project)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	localBuild.SetAgentName(coreutils.GetCliUserAgentName())
+	localBuild.SetAgentVersion(coreutils.GetCliUserAgentVersion())
+	localBuild.SetBuildAgentVersion(coreutils.GetClientAgentVersion())
+	if sc.serverDetails != nil {
+		localBuild.SetPrincipal(sc.serverDetails.User)
+	}
+	localBuild.SetBuildUrl(sc.buildUrl)
+	buildInfo, err := localBuild.ToBuildInfo()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if err = buildInfo.IncludeEnv(strings.Split(sc.envInclude, ";")...); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if err = buildInfo.ExcludeEnv(strings.Split(sc.envExclude, ";")...); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return buildInfo, nil
+}
+
+func (sc *ShowCommand) getPublishedBuildInfo() (*buildinfo.BuildInfo, error) {
+	servicesManager, err := credentialhelper.CreateServiceManager(sc.serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	publishedBuildInfo, found, err := servicesManager.GetBuildInfo(services.BuildInfoParams{BuildName: sc.build.Name, BuildNumber: sc.build.Number, ProjectKey: sc.build.Project})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorutils.CheckErrorf("build %s was not found. To show a build which wasn't published yet, use the --local option", FormatBuild(sc.build))
+	}
+	return &publishedBuildInfo.BuildInfo, nil
+}
+
+// FormatBuild returns the name and the number of the build, and its project if it has one, for messages.
+func FormatBuild(b buildindex.Build) string {
+	if b.Project != "" {
+		return fmt.Sprintf("%s/%s of project %s", b.Name, b.Number, b.Project)
+	}
+	return fmt.Sprintf("%s/%s", b.Name, b.Number)
+}
+
+// SortedKeys returns the keys of all the maps, without duplicates, sorted.
+func SortedKeys[V any](maps ...map[string]V) []string {
+	var keys []string
+	for i, m := range maps {
+		for key := range m {
+			if !isInMaps(key, maps[:i]) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isInMaps[V any](key string, maps []map[string]V) bool {
+	for _, m := range maps {
+		if _, exists := m[key]; exists {
+			return true
+		}
+	}
+	return false
+}
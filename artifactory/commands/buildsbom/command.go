@@ -0,0 +1,142 @@
+package buildsbom
+
+import (
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+type Format string
+
+const (
+	CycloneDxJson Format = "cyclonedx-json"
+	SpdxJson      Format = "spdx-json"
+)
+
+const (
+	toolGroup = "jfrog"
+	toolName  = "jfrog-cli"
+	// The ID of the module of the build-info, which holds the uploaded SBOM.
+	sbomModuleId = "sbom"
+)
+
+// GetFormat returns the SBOM format matching the value of the --format option.
+func GetFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(value)) {
+	case CycloneDxJson:
+		return CycloneDxJson, nil
+	case SpdxJson:
+		return SpdxJson, nil
+	default:
+		return "", errorutils.CheckErrorf("only the following SBOM formats are supported: %s, %s", CycloneDxJson, SpdxJson)
+	}
+}
+
+// Returns the extension of the SBOM files, following the conventions of the formats.
+func (f Format) extension() string {
+	if f == SpdxJson {
+		return ".spdx.json"
+	}
+	return ".cdx.json"
+}
+
+// SbomCommand converts the build-info of a build to an SBOM, and optionally uploads it to Artifactory as an artifact of the build.
+type SbomCommand struct {
+	serverDetails *config.ServerDetails
+	buildName     string
+	buildNumber   string
+	project       string
+	local         bool
+	format        Format
+	// The path in Artifactory to upload the SBOM to, in the form of repo/path.
+	uploadTarget string
+	content      []byte
+}
+
+func NewSbomCommand() *SbomCommand {
+	return &SbomCommand{format: CycloneDxJson}
+}
+
+func (sc *SbomCommand) SetServerDetails(serverDetails *config.ServerDetails) *SbomCommand {
+	sc.serverDetails = serverDetails
+	return sc
+}
+
+func (sc *SbomCommand) SetBuild(buildName, buildNumber, project string) *SbomCommand {
+	sc.buildName, sc.buildNumber, sc.project = buildName, buildNumber, project
+	return sc
+}
+
+// SetLocal sets whether to convert the build-info collected locally, rather than the published build-info.
+func (sc *SbomCommand) SetLocal(local bool) *SbomCommand {
+	sc.local = local
+	return sc
+}
+
+func (sc *SbomCommand) SetFormat(format Format) *SbomCommand {
+	sc.format = format
+	return sc
+}
+
+func (sc *SbomCommand) SetUploadTarget(uploadTarget string) *SbomCommand {
+	sc.uploadTarget = uploadTarget
+	return sc
+}
+
+// Content returns the SBOM document.
+func (sc *SbomCommand) Content() []byte {
+	return sc.content
+}
+
+func (sc *SbomCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sc.serverDetails, nil
+}
+
+func (sc *SbomCommand) CommandName() string {
+	return "rt_build_sbom"
+}
+
+func (sc *SbomCommand) Run() (err error) {
+	// The environment variables are not a part of the SBOM.
+	showCommand := builds.NewShowCommand().SetServerDetails(sc.serverDetails).SetBuild(sc.buildName, sc.buildNumber, sc.project).
+		SetLocal(sc.local).SetEnvInclude("*").SetEnvExclude("*")
+	if err = showCommand.Run(); err != nil {
+		return
+	}
+	if sc.content, err = Convert(showCommand.BuildInfo(), sc.format, time.Now()); err != nil {
+		return
+	}
+	if sc.uploadTarget == "" {
+		return
+	}
+	return builds.UploadArtifact(sc.serverDetails, showCommand.BuildInfo(), buildindex.Build{Name: sc.buildName, Number: sc.buildNumber, Project: sc.project},
+		sc.local, sbomModuleId, sc.getUploadPath(), sc.content)
+}
+
+// Convert converts the build-info to an SBOM document in the format.
+func Convert(buildInfo *buildinfo.BuildInfo, format Format, timestamp time.Time) ([]byte, error) {
+	s := newSbom(buildInfo)
+	if format == SpdxJson {
+		return s.toSpdx(coreutils.GetCliUserAgentVersion(), timestamp)
+	}
+	return s.toCycloneDx(coreutils.GetCliUserAgentVersion(), timestamp)
+}
+
+// Returns the path in Artifactory to upload the SBOM to. If the target ends with a slash, the file name is generated.
+func (sc *SbomCommand) getUploadPath() string {
+	if strings.HasSuffix(sc.uploadTarget, "/") {
+		return sc.uploadTarget + strings.ReplaceAll(sc.buildName, "/", "-") + "-" + sc.buildNumber + sc.format.extension()
+	}
+	return sc.uploadTarget
+}
+
+// The reference of the build itself, which depends on its modules.
+func (s *sbom) getBuildRef() string {
+	return "build:" + builds.FormatBuild(buildindex.Build{Name: s.buildName, Number: s.buildNumber})
+}
@@ -0,0 +1,74 @@
+package buildsbom
+
+import (
+	"bytes"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+var cycloneDxKinds = map[componentKind]cdx.ComponentType{
+	application: cdx.ComponentTypeApplication,
+	library:     cdx.ComponentTypeLibrary,
+	container:   cdx.ComponentTypeContainer,
+	file:        cdx.ComponentTypeFile,
+}
+
+// Converts the SBOM to a CycloneDX 1.5 JSON document.
+func (s *sbom) toCycloneDx(toolVersion string, timestamp time.Time) ([]byte, error) {
+	buildRef := s.getBuildRef()
+	bom := cdx.NewBOM()
+	bom.SerialNumber = uuid.New().URN()
+	bom.Metadata = &cdx.Metadata{
+		Timestamp: timestamp.UTC().Format(time.RFC3339),
+		Tools:     &cdx.ToolsChoice{Components: &[]cdx.Component{{Type: cdx.ComponentTypeApplication, Group: toolGroup, Name: toolName, Version: toolVersion}}},
+		Component: &cdx.Component{BOMRef: buildRef, Type: cdx.ComponentTypeApplication, Name: s.buildName, Version: s.buildNumber},
+	}
+	components := []cdx.Component{}
+	for _, c := range s.components {
+		components = append(components, cdx.Component{
+			BOMRef:     c.ref,
+			Type:       cycloneDxKinds[c.kind],
+			Group:      c.group,
+			Name:       c.name,
+			Version:    c.version,
+			PackageURL: c.purl,
+			Hashes:     getCycloneDxHashes(c),
+		})
+	}
+	bom.Components = &components
+	dependencies := []cdx.Dependency{{Ref: buildRef}}
+	if moduleRefs := s.getModuleRefs(); len(moduleRefs) > 0 {
+		dependencies[0].Dependencies = &moduleRefs
+	}
+	for _, c := range s.components {
+		dependency := cdx.Dependency{Ref: c.ref}
+		if dependsOn := s.dependsOn[c.ref]; len(dependsOn) > 0 {
+			dependency.Dependencies = &dependsOn
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	bom.Dependencies = &dependencies
+
+	content := new(bytes.Buffer)
+	if err := cdx.NewBOMEncoder(content, cdx.BOMFileFormatJSON).SetPretty(true).Encode(bom); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return content.Bytes(), nil
+}
+
+// Returns the known checksums of the component. Unknown checksums are left out, since empty hashes are invalid.
+func getCycloneDxHashes(c *component) *[]cdx.Hash {
+	var hashes []cdx.Hash
+	for _, hash := range []cdx.Hash{{Algorithm: cdx.HashAlgoSHA256, Value: c.Sha256}, {Algorithm: cdx.HashAlgoSHA1, Value: c.Sha1}, {Algorithm: cdx.HashAlgoMD5, Value: c.Md5}} {
+		if hash.Value != "" {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+	return &hashes
+}
@@ -0,0 +1,196 @@
+package buildsbom
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+)
+
+type componentKind string
+
+const (
+	application componentKind = "application"
+	library     componentKind = "library"
+	container   componentKind = "container"
+	file        componentKind = "file"
+)
+
+// The package types of the purls, by the types of the build-info modules.
+var packageTypes = map[buildinfo.ModuleType]string{
+	buildinfo.Maven:  "maven",
+	buildinfo.Gradle: "maven",
+	buildinfo.Npm:    "npm",
+	buildinfo.Go:     "golang",
+	buildinfo.Python: "pypi",
+	buildinfo.Nuget:  "nuget",
+	buildinfo.Docker: "docker",
+}
+
+// A module or a dependency of the build.
+type component struct {
+	// A unique reference to the component in the SBOM. The purl of the component, or its ID if it has no purl.
+	ref     string
+	kind    componentKind
+	group   string
+	name    string
+	version string
+	purl    string
+	buildinfo.Checksum
+}
+
+// sbom is the format-independent content of the SBOM, which is converted to CycloneDX or SPDX.
+type sbom struct {
+	buildName   string
+	buildNumber string
+	// The modules of the build, followed by their dependencies.
+	components []*component
+	// The references of the direct dependencies of the modules and the dependencies, by the references of the components which depend on them.
+	dependsOn map[string][]string
+}
+
+// The modules of the build are the dependencies of the build itself.
+func (s *sbom) getModuleRefs() (refs []string) {
+	for _, c := range s.components {
+		if c.kind == application || c.kind == container {
+			refs = append(refs, c.ref)
+		}
+	}
+	return
+}
+
+// newSbom collects the components of the SBOM from the modules of the build-info and their dependencies.
+// The dependency graph is built from the 'requestedBy' paths of the dependencies.
+func newSbom(buildInfo *buildinfo.BuildInfo) *sbom {
+	s := &sbom{buildName: buildInfo.Name, buildNumber: buildInfo.Number, dependsOn: map[string][]string{}}
+	refs := map[string]*component{}
+	add := func(c *component) *component {
+		if existing, exists := refs[c.ref]; exists {
+			return existing
+		}
+		refs[c.ref] = c
+		s.components = append(s.components, c)
+		return c
+	}
+	edges := map[string]map[string]bool{}
+	addEdge := func(from, to string) {
+		if from == to {
+			return
+		}
+		if edges[from] == nil {
+			edges[from] = map[string]bool{}
+		}
+		edges[from][to] = true
+	}
+	for _, module := range buildInfo.Modules {
+		// Aggregated builds only reference other builds.
+		if module.Type == buildinfo.Build {
+			continue
+		}
+		packageType := packageTypes[module.Type]
+		moduleKind := application
+		if module.Type == buildinfo.Docker {
+			moduleKind = container
+		}
+		moduleComponent := add(newComponent(module.Id, packageType, moduleKind, module.Checksum))
+		// The references of the dependencies of the module, by their IDs.
+		moduleRefs := map[string]string{module.Id: moduleComponent.ref}
+		for _, dependency := range module.Dependencies {
+			dependencyKind := library
+			dependencyPackageType := packageType
+			// The dependencies of Docker images are their layers, and generic dependencies are files.
+			if packageType == "" || module.Type == buildinfo.Docker {
+				dependencyKind, dependencyPackageType = file, ""
+			}
+			moduleRefs[dependency.Id] = add(newComponent(dependency.Id, dependencyPackageType, dependencyKind, dependency.Checksum)).ref
+		}
+		for _, dependency := range module.Dependencies {
+			ref := moduleRefs[dependency.Id]
+			if len(dependency.RequestedBy) == 0 {
+				addEdge(moduleComponent.ref, ref)
+				continue
+			}
+			for _, path := range dependency.RequestedBy {
+				parentRef, known := "", false
+				if len(path) > 0 {
+					parentRef, known = moduleRefs[path[0]]
+				}
+				if !known {
+					parentRef = moduleComponent.ref
+				}
+				addEdge(parentRef, ref)
+			}
+		}
+	}
+	for from, to := range edges {
+		for ref := range to {
+			s.dependsOn[from] = append(s.dependsOn[from], ref)
+		}
+		sort.Strings(s.dependsOn[from])
+	}
+	return s
+}
+
+func newComponent(id, packageType string, kind componentKind, checksum buildinfo.Checksum) *component {
+	c := &component{kind: kind, Checksum: checksum}
+	c.group, c.name, c.version = parseId(id, packageType)
+	if packageType != "" && c.version != "" {
+		c.purl = createPurl(packageType, c.group, c.name, c.version)
+	}
+	c.ref = c.purl
+	if c.ref == "" {
+		c.ref = id
+	}
+	return c
+}
+
+// Parses the ID of a module or a dependency, such as 'org.acme:app:1.0.0' or '@acme/ui:2.1.0'.
+func parseId(id, packageType string) (group, name, version string) {
+	if packageType == "maven" {
+		if parts := strings.Split(id, ":"); len(parts) >= 3 {
+			return parts[0], parts[1], parts[2]
+		}
+	}
+	name = id
+	// The version follows the last colon. A colon which is followed by a slash is a part of the name, such as the port of a Docker registry.
+	if i := strings.LastIndex(id, ":"); i > 0 && !strings.Contains(id[i:], "/") {
+		name, version = id[:i], id[i+1:]
+	}
+	switch packageType {
+	case "npm":
+		// Scoped npm packages, such as '@acme/ui'.
+		if strings.HasPrefix(name, "@") {
+			if i := strings.Index(name, "/"); i > 0 {
+				group, name = name[:i], name[i+1:]
+			}
+		}
+	case "pypi":
+		// Python package names are normalized in purls.
+		name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	}
+	return
+}
+
+// Creates a package URL, as specified in https://github.com/package-url/purl-spec.
+func createPurl(packageType, group, name, version string) string {
+	var segments []string
+	if group != "" {
+		segments = append(segments, escapePurlSegments(group)...)
+	}
+	segments = append(segments, escapePurlSegments(name)...)
+	return "pkg:" + packageType + "/" + strings.Join(segments, "/") + "@" + escapePurl(version)
+}
+
+// Escapes the segments of a name which contains slashes, such as a Go module path or a Docker image path.
+func escapePurlSegments(name string) []string {
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		segments[i] = escapePurl(segment)
+	}
+	return segments
+}
+
+func escapePurl(value string) string {
+	return strings.ReplaceAll(url.PathEscape(value), "@", "%40")
+}
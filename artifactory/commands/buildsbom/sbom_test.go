@@ -0,0 +1,195 @@
+package buildsbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func getTestBuildInfo() *buildinfo.BuildInfo {
+	return &buildinfo.BuildInfo{
+		Name:   "app",
+		Number: "7",
+		Modules: []buildinfo.Module{
+			{
+				Id:   "org.acme:app:1.0",
+				Type: buildinfo.Maven,
+				Dependencies: []buildinfo.Dependency{
+					{Id: "org.slf4j:slf4j-api:1.7.36", Checksum: buildinfo.Checksum{Sha1: "s1", Sha256: "s256"}},
+					{Id: "ch.qos.logback:logback-classic:1.2.11", Checksum: buildinfo.Checksum{Md5: "l5"}},
+					{Id: "ch.qos.logback:logback-core:1.2.11", RequestedBy: [][]string{{"ch.qos.logback:logback-classic:1.2.11", "org.acme:app:1.0"}}},
+				},
+			},
+			{
+				Id:           "@acme/ui:2.1.0",
+				Type:         buildinfo.Npm,
+				Dependencies: []buildinfo.Dependency{{Id: "react:18.2.0"}, {Id: "@types/react:18.2.1"}},
+			},
+			{
+				Id:           "github.com/acme/cli:v1.2.3",
+				Type:         buildinfo.Go,
+				Dependencies: []buildinfo.Dependency{{Id: "github.com/spf13/cobra:v1.8.0"}},
+			},
+			{
+				Id:           "acme-tools:0.3",
+				Type:         buildinfo.Python,
+				Dependencies: []buildinfo.Dependency{{Id: "Typing_Extensions:4.9.0"}},
+			},
+			{Id: "Acme.Core:3.0.0", Type: buildinfo.Nuget},
+			{
+				Id:           "registry.acme.io:8081/acme/web:1.4",
+				Type:         buildinfo.Docker,
+				Dependencies: []buildinfo.Dependency{{Id: "sha256__abc", Checksum: buildinfo.Checksum{Sha1: "layer"}}},
+			},
+			{Id: "docs", Type: buildinfo.Generic, Dependencies: []buildinfo.Dependency{{Id: "style.css"}}},
+			{Id: "other-build/3", Type: buildinfo.Build},
+		},
+	}
+}
+
+func TestParseId(t *testing.T) {
+	testCases := []struct {
+		id                   string
+		packageType          string
+		group, name, version string
+	}{
+		{"org.acme:app:1.0", "maven", "org.acme", "app", "1.0"},
+		{"org.acme:app:1.0:jdk8", "maven", "org.acme", "app", "1.0"},
+		{"@acme/ui:2.1.0", "npm", "@acme", "ui", "2.1.0"},
+		{"react:18.2.0", "npm", "", "react", "18.2.0"},
+		{"github.com/spf13/cobra:v1.8.0", "golang", "", "github.com/spf13/cobra", "v1.8.0"},
+		{"Typing_Extensions:4.9.0", "pypi", "", "typing-extensions", "4.9.0"},
+		{"registry.acme.io:8081/acme/web:1.4", "docker", "", "registry.acme.io:8081/acme/web", "1.4"},
+		{"registry.acme.io:8081/acme/web", "docker", "", "registry.acme.io:8081/acme/web", ""},
+		{"style.css", "", "", "style.css", ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.id, func(t *testing.T) {
+			group, name, version := parseId(testCase.id, testCase.packageType)
+			assert.Equal(t, testCase.group, group)
+			assert.Equal(t, testCase.name, name)
+			assert.Equal(t, testCase.version, version)
+		})
+	}
+}
+
+func TestNewSbom(t *testing.T) {
+	s := newSbom(getTestBuildInfo())
+	purls := map[string]componentKind{}
+	for _, c := range s.components {
+		purls[c.ref] = c.kind
+	}
+	assert.Equal(t, map[string]componentKind{
+		"pkg:maven/org.acme/app@1.0":                      application,
+		"pkg:maven/org.slf4j/slf4j-api@1.7.36":            library,
+		"pkg:maven/ch.qos.logback/logback-classic@1.2.11": library,
+		"pkg:maven/ch.qos.logback/logback-core@1.2.11":    library,
+		"pkg:npm/%40acme/ui@2.1.0":                        application,
+		"pkg:npm/react@18.2.0":                            library,
+		"pkg:npm/%40types/react@18.2.1":                   library,
+		"pkg:golang/github.com/acme/cli@v1.2.3":           application,
+		"pkg:golang/github.com/spf13/cobra@v1.8.0":        library,
+		"pkg:pypi/acme-tools@0.3":                         application,
+		"pkg:pypi/typing-extensions@4.9.0":                library,
+		"pkg:nuget/Acme.Core@3.0.0":                       application,
+		"pkg:docker/registry.acme.io:8081/acme/web@1.4":   container,
+		"sha256__abc":                                     file,
+		"docs":                                            application,
+		"style.css":                                       file,
+	}, purls)
+
+	// Transitive dependencies depend on the dependencies which requested them.
+	assert.Equal(t, []string{"pkg:maven/ch.qos.logback/logback-classic@1.2.11", "pkg:maven/org.slf4j/slf4j-api@1.7.36"}, s.dependsOn["pkg:maven/org.acme/app@1.0"])
+	assert.Equal(t, []string{"pkg:maven/ch.qos.logback/logback-core@1.2.11"}, s.dependsOn["pkg:maven/ch.qos.logback/logback-classic@1.2.11"])
+	assert.Equal(t, []string{"sha256__abc"}, s.dependsOn["pkg:docker/registry.acme.io:8081/acme/web@1.4"])
+	assert.Len(t, s.getModuleRefs(), 7)
+}
+
+func TestToCycloneDx(t *testing.T) {
+	content, err := Convert(getTestBuildInfo(), CycloneDxJson, testTime)
+	require.NoError(t, err)
+	bom := new(cdx.BOM)
+	require.NoError(t, cdx.NewBOMDecoder(bytes.NewReader(content), cdx.BOMFileFormatJSON).Decode(bom))
+
+	assert.Equal(t, "CycloneDX", bom.BOMFormat)
+	assert.Equal(t, cdx.SpecVersion1_5, bom.SpecVersion)
+	assert.Regexp(t, "^urn:uuid:", bom.SerialNumber)
+	assert.Equal(t, "2024-03-01T12:00:00Z", bom.Metadata.Timestamp)
+	assert.Equal(t, "build:app/7", bom.Metadata.Component.BOMRef)
+	require.NotNil(t, bom.Components)
+	assert.Len(t, *bom.Components, 16)
+	for _, component := range *bom.Components {
+		if component.BOMRef == "pkg:maven/org.slf4j/slf4j-api@1.7.36" {
+			assert.Equal(t, "org.slf4j", component.Group)
+			assert.Equal(t, component.BOMRef, component.PackageURL)
+			assert.Equal(t, &[]cdx.Hash{{Algorithm: cdx.HashAlgoSHA256, Value: "s256"}, {Algorithm: cdx.HashAlgoSHA1, Value: "s1"}}, component.Hashes)
+		}
+		if component.BOMRef == "style.css" {
+			assert.Equal(t, cdx.ComponentTypeFile, component.Type)
+			assert.Empty(t, component.PackageURL)
+			assert.Nil(t, component.Hashes)
+		}
+	}
+
+	// Every reference in the dependency graph is a component or the build.
+	refs := map[string]bool{bom.Metadata.Component.BOMRef: true}
+	for _, component := range *bom.Components {
+		refs[component.BOMRef] = true
+	}
+	require.NotNil(t, bom.Dependencies)
+	for _, dependency := range *bom.Dependencies {
+		assert.True(t, refs[dependency.Ref], dependency.Ref)
+		if dependency.Dependencies != nil {
+			for _, ref := range *dependency.Dependencies {
+				assert.True(t, refs[ref], ref)
+			}
+		}
+	}
+}
+
+func TestToSpdx(t *testing.T) {
+	content, err := Convert(getTestBuildInfo(), SpdxJson, testTime)
+	require.NoError(t, err)
+	var document spdxDocument
+	require.NoError(t, json.Unmarshal(content, &document))
+
+	assert.Equal(t, "SPDX-2.3", document.SpdxVersion)
+	assert.Equal(t, "app/7", document.Name)
+	assert.Regexp(t, "^https://spdx.org/spdxdocs/jfrog-cli/app-7-", document.DocumentNamespace)
+	assert.Equal(t, "2024-03-01T12:00:00Z", document.CreationInfo.Created)
+	// The build and its components.
+	assert.Len(t, document.Packages, 17)
+
+	ids := map[string]bool{"SPDXRef-DOCUMENT": true}
+	for _, spdxPackage := range document.Packages {
+		assert.Regexp(t, "^SPDXRef-[a-zA-Z0-9.-]+$", spdxPackage.SpdxId)
+		assert.False(t, ids[spdxPackage.SpdxId], "duplicate SPDX ID "+spdxPackage.SpdxId)
+		ids[spdxPackage.SpdxId] = true
+		if spdxPackage.Name == "@acme/ui" {
+			assert.Equal(t, []spdxExternalRef{{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: "pkg:npm/%40acme/ui@2.1.0"}}, spdxPackage.ExternalRefs)
+		}
+	}
+	relationshipTypes := map[string]int{}
+	for _, relationship := range document.Relationships {
+		assert.True(t, ids[relationship.SpdxElementId], relationship.SpdxElementId)
+		assert.True(t, ids[relationship.RelatedSpdxElement], relationship.RelatedSpdxElement)
+		relationshipTypes[relationship.RelationshipType]++
+	}
+	assert.Equal(t, map[string]int{"DESCRIBES": 1, "CONTAINS": 7, "DEPENDS_ON": 9}, relationshipTypes)
+}
+
+func TestGetFormat(t *testing.T) {
+	format, err := GetFormat("SPDX-JSON")
+	assert.NoError(t, err)
+	assert.Equal(t, SpdxJson, format)
+	_, err = GetFormat("xml")
+	assert.Error(t, err)
+}
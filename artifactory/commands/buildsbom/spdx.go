@@ -0,0 +1,137 @@
+package buildsbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/builds"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	spdxVersion     = "SPDX-2.3"
+	spdxNoAssertion = "NOASSERTION"
+	// The namespace of the SPDX documents, following the convention of the SPDX tools. It only has to be unique, and doesn't have to be accessible.
+	spdxNamespaceBase = "https://spdx.org/spdxdocs/" + toolName
+)
+
+var spdxPurposes = map[componentKind]string{
+	application: "APPLICATION",
+	library:     "LIBRARY",
+	container:   "CONTAINER",
+	file:        "FILE",
+}
+
+// SPDX IDs may only contain letters, digits, dots and hyphens.
+var spdxIdInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9.-]`)
+
+type spdxDocument struct {
+	SpdxVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SpdxId            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SpdxId                string            `json:"SPDXID"`
+	Name                  string            `json:"name"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	Supplier              string            `json:"supplier,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	Checksums             []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs          []spdxExternalRef `json:"externalRefs,omitempty"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SpdxElementId      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
+
+// Converts the SBOM to an SPDX 2.3 JSON document.
+func (s *sbom) toSpdx(toolVersion string, timestamp time.Time) ([]byte, error) {
+	buildId := "SPDXRef-Build"
+	document := spdxDocument{
+		SpdxVersion:       spdxVersion,
+		DataLicense:       "CC0-1.0",
+		SpdxId:            "SPDXRef-DOCUMENT",
+		Name:              builds.FormatBuild(buildindex.Build{Name: s.buildName, Number: s.buildNumber}),
+		DocumentNamespace: fmt.Sprintf("%s/%s-%s-%s", spdxNamespaceBase, url.PathEscape(s.buildName), url.PathEscape(s.buildNumber), uuid.New().String()),
+		CreationInfo: spdxCreationInfo{
+			Created:  timestamp.UTC().Format(time.RFC3339),
+			Creators: []string{fmt.Sprintf("Tool: %s-%s", toolName, toolVersion)},
+		},
+		Packages:      []spdxPackage{{SpdxId: buildId, Name: s.buildName, VersionInfo: s.buildNumber, DownloadLocation: spdxNoAssertion, PrimaryPackagePurpose: spdxPurposes[application]}},
+		Relationships: []spdxRelationship{{SpdxElementId: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSpdxElement: buildId}},
+	}
+	spdxIds := map[string]string{}
+	for i, c := range s.components {
+		spdxIds[c.ref] = fmt.Sprintf("SPDXRef-Package-%d-%s", i+1, spdxIdInvalidChars.ReplaceAllString(c.name, "-"))
+		name := c.name
+		if strings.HasPrefix(c.group, "@") {
+			// Scoped npm packages.
+			name = c.group + "/" + c.name
+		} else if c.group != "" {
+			name = c.group + ":" + c.name
+		}
+		spdxPackage := spdxPackage{
+			SpdxId:                spdxIds[c.ref],
+			Name:                  name,
+			VersionInfo:           c.version,
+			DownloadLocation:      spdxNoAssertion,
+			Checksums:             getSpdxChecksums(c),
+			PrimaryPackagePurpose: spdxPurposes[c.kind],
+		}
+		if c.purl != "" {
+			spdxPackage.ExternalRefs = []spdxExternalRef{{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: c.purl}}
+		}
+		document.Packages = append(document.Packages, spdxPackage)
+	}
+	for _, moduleRef := range s.getModuleRefs() {
+		document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: buildId, RelationshipType: "CONTAINS", RelatedSpdxElement: spdxIds[moduleRef]})
+	}
+	for _, c := range s.components {
+		for _, ref := range s.dependsOn[c.ref] {
+			document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: spdxIds[c.ref], RelationshipType: "DEPENDS_ON", RelatedSpdxElement: spdxIds[ref]})
+		}
+	}
+	content, err := json.MarshalIndent(document, "", "  ")
+	return content, errorutils.CheckError(err)
+}
+
+func getSpdxChecksums(c *component) (checksums []spdxChecksum) {
+	for _, checksum := range []spdxChecksum{{Algorithm: "SHA256", ChecksumValue: c.Sha256}, {Algorithm: "SHA1", ChecksumValue: c.Sha1}, {Algorithm: "MD5", ChecksumValue: c.Md5}} {
+		if checksum.ChecksumValue != "" {
+			checksums = append(checksums, checksum)
+		}
+	}
+	return
+}
@@ -0,0 +1,112 @@
+package replications
+
+import (
+	"encoding/json"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Replication is a replication configured in Artifactory, along with its status.
+type Replication struct {
+	RepoKey string `json:"repoKey"`
+	// push or pull.
+	Type    string `json:"type,omitempty"`
+	Url     string `json:"url,omitempty"`
+	Enabled bool   `json:"enabled"`
+	CronExp string `json:"cronExp,omitempty"`
+	// The status of the last replication to the target.
+	Status        string `json:"status"`
+	LastCompleted string `json:"lastCompleted,omitempty"`
+	LagSeconds    *int64 `json:"lagSeconds,omitempty"`
+}
+
+// ListCommand lists the replications and their status.
+type ListCommand struct {
+	serverDetails *config.ServerDetails
+	replications  []Replication
+}
+
+func NewListCommand() *ListCommand {
+	return &ListCommand{}
+}
+
+func (lc *ListCommand) SetServerDetails(serverDetails *config.ServerDetails) *ListCommand {
+	lc.serverDetails = serverDetails
+	return lc
+}
+
+func (lc *ListCommand) Replications() []Replication {
+	return lc.replications
+}
+
+func (lc *ListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return lc.serverDetails, nil
+}
+
+func (lc *ListCommand) CommandName() string {
+	return "rt_replication_list"
+}
+
+func (lc *ListCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(lc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	lc.replications, err = getReplications(servicesManager)
+	if err != nil {
+		return err
+	}
+	statuses := map[string]*RepoStatus{}
+	for i := range lc.replications {
+		replication := &lc.replications[i]
+		repoStatus, exists := statuses[replication.RepoKey]
+		if !exists {
+			if repoStatus, err = GetRepoStatus(servicesManager, replication.RepoKey); err != nil {
+				return err
+			}
+			statuses[replication.RepoKey] = repoStatus
+		}
+		targetStatus := getTargetStatus(repoStatus, replication.Url)
+		replication.Status, replication.LastCompleted, replication.LagSeconds = targetStatus.Status, targetStatus.LastCompleted, targetStatus.LagSeconds
+	}
+	return nil
+}
+
+// Returns the status of the replication to the target. If the status of the target is unknown, the status of the repository is returned.
+func getTargetStatus(repoStatus *RepoStatus, targetUrl string) TargetStatus {
+	if target := repoStatus.getTarget(targetUrl); target != nil {
+		return *target
+	}
+	return TargetStatus{Url: targetUrl, Status: repoStatus.Status, LastCompleted: repoStatus.LastCompleted, LagSeconds: repoStatus.LagSeconds}
+}
+
+func getReplications(servicesManager artifactory.ArtifactoryServicesManager) ([]Replication, error) {
+	body, err := sendGet(servicesManager, "api/replications")
+	if err != nil {
+		return nil, err
+	}
+	var configs []struct {
+		RepoKey         string `json:"repoKey"`
+		ReplicationType string `json:"replicationType"`
+		Url             string `json:"url"`
+		Enabled         bool   `json:"enabled"`
+		CronExp         string `json:"cronExp"`
+	}
+	if err = json.Unmarshal(body, &configs); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	replications := []Replication{}
+	for _, replicationConfig := range configs {
+		replications = append(replications, Replication{
+			RepoKey: replicationConfig.RepoKey,
+			Type:    replicationConfig.ReplicationType,
+			Url:     replicationConfig.Url,
+			Enabled: replicationConfig.Enabled,
+			CronExp: replicationConfig.CronExp,
+		})
+	}
+	return replications, nil
+}
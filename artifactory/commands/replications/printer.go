@@ -0,0 +1,106 @@
+package replications
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type replicationRow struct {
+	RepoKey       string `col-name:"Repository"`
+	Type          string `col-name:"Type"`
+	Url           string `col-name:"Target URL"`
+	Enabled       string `col-name:"Enabled"`
+	CronExp       string `col-name:"Cron"`
+	Status        string `col-name:"Status"`
+	LastCompleted string `col-name:"Last Completed"`
+	Lag           string `col-name:"Lag"`
+}
+
+type targetRow struct {
+	Url           string `col-name:"Target URL"`
+	RepoKey       string `col-name:"Target Repository"`
+	Status        string `col-name:"Status"`
+	LastCompleted string `col-name:"Last Completed"`
+	Lag           string `col-name:"Lag"`
+}
+
+// PrintReplications prints the replications as a table or as JSON.
+func PrintReplications(replications []Replication, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		return printJson(replications)
+	}
+	var rows []replicationRow
+	for _, replication := range replications {
+		enabled := "false"
+		if replication.Enabled {
+			enabled = "true"
+		}
+		rows = append(rows, replicationRow{RepoKey: replication.RepoKey, Type: replication.Type, Url: replication.Url, Enabled: enabled,
+			CronExp: replication.CronExp, Status: replication.Status, LastCompleted: replication.LastCompleted, Lag: formatLag(replication.LagSeconds)})
+	}
+	return coreutils.PrintTable(rows, "Replications", "No replications were found", false)
+}
+
+// PrintRepoStatus prints the replication status of a repository as a table or as JSON.
+func PrintRepoStatus(repoStatus *RepoStatus, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		return printJson(repoStatus)
+	}
+	var rows []targetRow
+	for _, target := range repoStatus.Targets {
+		rows = append(rows, targetRow{Url: target.Url, RepoKey: target.RepoKey, Status: target.Status, LastCompleted: target.LastCompleted, Lag: formatLag(target.LagSeconds)})
+	}
+	if len(rows) == 0 {
+		rows = append(rows, targetRow{Status: repoStatus.Status, LastCompleted: repoStatus.LastCompleted, Lag: formatLag(repoStatus.LagSeconds)})
+	}
+	return coreutils.PrintTable(rows, "Replication Status of "+repoStatus.RepoKey, "", false)
+}
+
+// CheckReplications returns an error if the last run of any of the replications failed.
+func CheckReplications(replications []Replication) error {
+	failed := 0
+	for _, replication := range replications {
+		if IsFailedStatus(replication.Status) {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("%d of the %d replications failed", failed, len(replications))
+	}
+	return nil
+}
+
+// CheckRepoStatus returns an error if the last replication of the repository failed.
+func CheckRepoStatus(repoStatus *RepoStatus) error {
+	if IsFailedStatus(repoStatus.Status) {
+		return errorutils.CheckErrorf("the replication of %s failed with status '%s'", repoStatus.RepoKey, repoStatus.Status)
+	}
+	for _, target := range repoStatus.Targets {
+		if IsFailedStatus(target.Status) {
+			return errorutils.CheckErrorf("the replication of %s to %s failed with status '%s'", repoStatus.RepoKey, target.Url, target.Status)
+		}
+	}
+	return nil
+}
+
+func formatLag(lagSeconds *int64) string {
+	if lagSeconds == nil {
+		return ""
+	}
+	return (time.Duration(*lagSeconds) * time.Second).String()
+}
+
+func printJson(value interface{}) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(clientutils.IndentJson(content))
+	return nil
+}
@@ -0,0 +1,127 @@
+package replications
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const DefaultWaitTimeout = time.Hour
+
+// The interval between status checks while waiting for a replication to complete.
+var pollInterval = 5 * time.Second
+
+// RunCommand triggers the replications of a repository, and optionally waits for them to complete.
+type RunCommand struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+	wait          bool
+	timeout       time.Duration
+	// The status after the replication completed. Set only when waiting.
+	status *RepoStatus
+}
+
+func NewRunCommand() *RunCommand {
+	return &RunCommand{timeout: DefaultWaitTimeout}
+}
+
+func (rc *RunCommand) SetServerDetails(serverDetails *config.ServerDetails) *RunCommand {
+	rc.serverDetails = serverDetails
+	return rc
+}
+
+func (rc *RunCommand) SetRepoKey(repoKey string) *RunCommand {
+	rc.repoKey = repoKey
+	return rc
+}
+
+func (rc *RunCommand) SetWait(wait bool) *RunCommand {
+	rc.wait = wait
+	return rc
+}
+
+func (rc *RunCommand) SetTimeout(timeout time.Duration) *RunCommand {
+	if timeout > 0 {
+		rc.timeout = timeout
+	}
+	return rc
+}
+
+func (rc *RunCommand) Status() *RepoStatus {
+	return rc.status
+}
+
+func (rc *RunCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rc.serverDetails, nil
+}
+
+func (rc *RunCommand) CommandName() string {
+	return "rt_replication_run"
+}
+
+func (rc *RunCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(rc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	var previous *RepoStatus
+	if rc.wait {
+		if previous, err = GetRepoStatus(servicesManager, rc.repoKey); err != nil {
+			return err
+		}
+	}
+	if err = executeReplication(servicesManager, rc.repoKey); err != nil {
+		return err
+	}
+	log.Info("Triggered the replication of " + rc.repoKey + ".")
+	if !rc.wait {
+		return nil
+	}
+	if rc.status, err = rc.waitForCompletion(servicesManager, previous); err != nil {
+		return err
+	}
+	if err = CheckRepoStatus(rc.status); err != nil {
+		return err
+	}
+	log.Info("The replication of " + rc.repoKey + " completed with status '" + rc.status.Status + "'.")
+	return nil
+}
+
+// Polls the status of the replication until it completes.
+// The replication is considered completed once it is no longer in progress, after it was seen running or after its completion time changed.
+func (rc *RunCommand) waitForCompletion(servicesManager artifactory.ArtifactoryServicesManager, previous *RepoStatus) (*RepoStatus, error) {
+	deadline := time.Now().Add(rc.timeout)
+	seenRunning := false
+	for {
+		time.Sleep(pollInterval)
+		status, err := GetRepoStatus(servicesManager, rc.repoKey)
+		if err != nil {
+			return nil, err
+		}
+		if status.Status == StatusInProgress {
+			seenRunning = true
+		} else if seenRunning || status.LastCompleted != previous.LastCompleted {
+			return status, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, errorutils.CheckErrorf("timed out after %s while waiting for the replication of %s to complete", rc.timeout, rc.repoKey)
+		}
+		log.Debug("Waiting for the replication of " + rc.repoKey + " to complete. Current status: " + status.Status)
+	}
+}
+
+func executeReplication(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) error {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendPost(serviceDetails.GetUrl()+"api/replication/execute/"+url.PathEscape(repoKey), nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted)
+}
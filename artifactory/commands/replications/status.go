@@ -0,0 +1,120 @@
+package replications
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The statuses of replications, as reported by Artifactory.
+const (
+	StatusOk         = "ok"
+	StatusNeverRun   = "never_run"
+	StatusInProgress = "inprogress"
+	StatusIncomplete = "incomplete"
+	StatusWarn       = "warn"
+	StatusError      = "error"
+	StatusFailure    = "failure"
+)
+
+// TargetStatus is the status of the replication of a repository to a single target.
+type TargetStatus struct {
+	Url           string `json:"url,omitempty"`
+	RepoKey       string `json:"repoKey,omitempty"`
+	Status        string `json:"status"`
+	LastCompleted string `json:"lastCompleted,omitempty"`
+	// The number of seconds since the last completed replication. Not set if the replication never completed.
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+}
+
+// RepoStatus is the status of the replications of a repository.
+type RepoStatus struct {
+	RepoKey       string         `json:"repoKey"`
+	Status        string         `json:"status"`
+	LastCompleted string         `json:"lastCompleted,omitempty"`
+	LagSeconds    *int64         `json:"lagSeconds,omitempty"`
+	Targets       []TargetStatus `json:"targets,omitempty"`
+}
+
+// IsFailed returns true if the last replication of the repository, or of one of its targets, failed.
+func (rs *RepoStatus) IsFailed() bool {
+	if IsFailedStatus(rs.Status) {
+		return true
+	}
+	for _, target := range rs.Targets {
+		if IsFailedStatus(target.Status) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the status of the target with the given URL, or nil if the repository isn't replicated to it.
+func (rs *RepoStatus) getTarget(targetUrl string) *TargetStatus {
+	for i := range rs.Targets {
+		if rs.Targets[i].Url == targetUrl {
+			return &rs.Targets[i]
+		}
+	}
+	return nil
+}
+
+func IsFailedStatus(status string) bool {
+	return status == StatusError || status == StatusFailure
+}
+
+// GetRepoStatus returns the replication status of the repository.
+func GetRepoStatus(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (*RepoStatus, error) {
+	body, err := sendGet(servicesManager, "api/replication/"+url.PathEscape(repoKey))
+	if err != nil {
+		return nil, err
+	}
+	return parseRepoStatus(repoKey, body, time.Now())
+}
+
+func parseRepoStatus(repoKey string, body []byte, now time.Time) (*RepoStatus, error) {
+	repoStatus := &RepoStatus{RepoKey: repoKey}
+	if err := json.Unmarshal(body, repoStatus); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	// The response doesn't include the key of the source repository.
+	repoStatus.RepoKey = repoKey
+	repoStatus.LagSeconds = getLag(repoStatus.LastCompleted, now)
+	for i := range repoStatus.Targets {
+		repoStatus.Targets[i].LagSeconds = getLag(repoStatus.Targets[i].LastCompleted, now)
+	}
+	return repoStatus, nil
+}
+
+// Returns the number of seconds since the last completed replication, or nil if it's unknown.
+func getLag(lastCompleted string, now time.Time) *int64 {
+	if lastCompleted == "" {
+		return nil
+	}
+	completedAt, err := time.Parse(time.RFC3339, lastCompleted)
+	if err != nil {
+		return nil
+	}
+	lag := int64(now.Sub(completedAt).Seconds())
+	if lag < 0 {
+		lag = 0
+	}
+	return &lag
+}
+
+func sendGet(servicesManager artifactory.ArtifactoryServicesManager, restApi string) ([]byte, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+restApi, true, &httpDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -0,0 +1,48 @@
+package replications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRepoStatus(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	body := []byte(`{
+  "status": "error",
+  "lastCompleted": "2024-05-01T11:00:00.000Z",
+  "targets": [
+    {"url": "https://a.acme.io/artifactory/libs-local", "repoKey": "libs-local", "status": "ok", "lastCompleted": "2024-05-01T11:59:30.000+00:00"},
+    {"url": "https://b.acme.io/artifactory/libs-local", "repoKey": "libs-local", "status": "error", "lastCompleted": null}
+  ]
+}`)
+	repoStatus, err := parseRepoStatus("libs-local", body, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "libs-local", repoStatus.RepoKey)
+	assert.Equal(t, int64(3600), *repoStatus.LagSeconds)
+	assert.Equal(t, int64(30), *repoStatus.Targets[0].LagSeconds)
+	assert.Nil(t, repoStatus.Targets[1].LagSeconds)
+	assert.True(t, repoStatus.IsFailed())
+	assert.ErrorContains(t, CheckRepoStatus(repoStatus), "failed with status 'error'")
+
+	targetStatus := getTargetStatus(repoStatus, "https://a.acme.io/artifactory/libs-local")
+	assert.Equal(t, StatusOk, targetStatus.Status)
+	// Unknown targets get the status of the repository.
+	targetStatus = getTargetStatus(repoStatus, "https://c.acme.io/artifactory/libs-local")
+	assert.Equal(t, StatusError, targetStatus.Status)
+	assert.Equal(t, "2024-05-01T11:00:00.000Z", targetStatus.LastCompleted)
+}
+
+func TestCheckReplications(t *testing.T) {
+	replications := []Replication{{RepoKey: "a", Status: StatusOk}, {RepoKey: "b", Status: StatusNeverRun}}
+	assert.NoError(t, CheckReplications(replications))
+	replications = append(replications, Replication{RepoKey: "c", Status: StatusFailure})
+	assert.EqualError(t, CheckReplications(replications), "1 of the 3 replications failed")
+}
+
+func TestFormatLag(t *testing.T) {
+	lag := int64(3725)
+	assert.Equal(t, "1h2m5s", formatLag(&lag))
+	assert.Equal(t, "", formatLag(nil))
+}
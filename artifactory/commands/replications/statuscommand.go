@@ -0,0 +1,48 @@
+package replications
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+)
+
+// StatusCommand gets the replication status of a repository.
+type StatusCommand struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+	status        *RepoStatus
+}
+
+func NewStatusCommand() *StatusCommand {
+	return &StatusCommand{}
+}
+
+func (sc *StatusCommand) SetServerDetails(serverDetails *config.ServerDetails) *StatusCommand {
+	sc.serverDetails = serverDetails
+	return sc
+}
+
+func (sc *StatusCommand) SetRepoKey(repoKey string) *StatusCommand {
+	sc.repoKey = repoKey
+	return sc
+}
+
+func (sc *StatusCommand) Status() *RepoStatus {
+	return sc.status
+}
+
+func (sc *StatusCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sc.serverDetails, nil
+}
+
+func (sc *StatusCommand) CommandName() string {
+	return "rt_replication_status"
+}
+
+func (sc *StatusCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(sc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	sc.status, err = GetRepoStatus(servicesManager, sc.repoKey)
+	return err
+}
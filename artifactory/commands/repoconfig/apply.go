@@ -0,0 +1,210 @@
+package repoconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// RepoApplyCommand brings the repositories in Artifactory to the state described by a directory of repository templates.
+// It computes a plan, prints it and applies it after confirmation.
+type RepoApplyCommand struct {
+	serverDetails *config.ServerDetails
+	templatesDir  string
+	vars          string
+	// A wildcard pattern of the keys of the managed repositories. Templates and repositories that don't match it are ignored.
+	includePattern string
+	// If true, managed repositories without a template are deleted.
+	prune bool
+	// If true, the plan is printed but not applied.
+	dryRun bool
+	quiet  bool
+	plan   *Plan
+}
+
+func NewRepoApplyCommand() *RepoApplyCommand {
+	return &RepoApplyCommand{}
+}
+
+func (rac *RepoApplyCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoApplyCommand {
+	rac.serverDetails = serverDetails
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetTemplatesDir(templatesDir string) *RepoApplyCommand {
+	rac.templatesDir = templatesDir
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetVars(vars string) *RepoApplyCommand {
+	rac.vars = vars
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetIncludePattern(includePattern string) *RepoApplyCommand {
+	rac.includePattern = includePattern
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetPrune(prune bool) *RepoApplyCommand {
+	rac.prune = prune
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetDryRun(dryRun bool) *RepoApplyCommand {
+	rac.dryRun = dryRun
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetQuiet(quiet bool) *RepoApplyCommand {
+	rac.quiet = quiet
+	return rac
+}
+
+func (rac *RepoApplyCommand) Plan() *Plan {
+	return rac.plan
+}
+
+func (rac *RepoApplyCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rac.serverDetails, nil
+}
+
+func (rac *RepoApplyCommand) CommandName() string {
+	return "rt_repo_apply"
+}
+
+func (rac *RepoApplyCommand) Run() (err error) {
+	desired, err := ReadTemplates(rac.templatesDir, rac.vars, rac.includePattern)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(rac.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	current, err := getCurrentConfigs(servicesManager, desired, rac.includePattern)
+	if err != nil {
+		return err
+	}
+	if rac.plan, err = CreatePlan(desired, current, rac.prune); err != nil {
+		return err
+	}
+	log.Output(rac.plan.String())
+	if rac.plan.IsEmpty() || rac.dryRun {
+		return nil
+	}
+	if !rac.quiet && !coreutils.AskYesNo("Apply the plan?", false) {
+		return nil
+	}
+	return applyPlan(servicesManager, rac.plan)
+}
+
+// ReadTemplates reads the repository templates (*.json files) from the directory, after replacing the template variables.
+// Templates of repositories that don't match the include pattern are ignored.
+func ReadTemplates(templatesDir, vars, includePattern string) ([]RepoConfig, error) {
+	templatePaths, err := filepath.Glob(filepath.Join(templatesDir, "*"+templateFileExtension))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(templatePaths) == 0 {
+		return nil, errorutils.CheckErrorf("no repository templates (*%s files) were found in %s", templateFileExtension, templatesDir)
+	}
+	templateVars := coreutils.SpecVarsStringToMap(vars)
+	templates := make([]RepoConfig, 0, len(templatePaths))
+	templateByKey := make(map[string]string, len(templatePaths))
+	for _, templatePath := range templatePaths {
+		content, err := os.ReadFile(templatePath)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		repoConfig := RepoConfig{}
+		if err = json.Unmarshal(coreutils.ReplaceVars(content, templateVars), &repoConfig); err != nil {
+			return nil, errorutils.CheckErrorf("failed parsing the repository template %s: %s", templatePath, err.Error())
+		}
+		if err = validateTemplate(repoConfig); err != nil {
+			return nil, errorutils.CheckErrorf("invalid repository template %s: %s", templatePath, err.Error())
+		}
+		if otherPath, exists := templateByKey[repoConfig.Key()]; exists {
+			return nil, errorutils.CheckErrorf("the repository '%s' is defined in both %s and %s", repoConfig.Key(), otherPath, templatePath)
+		}
+		templateByKey[repoConfig.Key()] = templatePath
+		matched, err := matchRepoKey(includePattern, repoConfig.Key())
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			log.Debug("Skipping the repository template", templatePath, "since it doesn't match the include pattern")
+			continue
+		}
+		templates = append(templates, repoConfig)
+	}
+	return templates, nil
+}
+
+func validateTemplate(repoConfig RepoConfig) error {
+	if repoConfig.Key() == "" {
+		return fmt.Errorf("the '%s' field is missing", keyField)
+	}
+	switch repoConfig.Rclass() {
+	case Local, Remote, Virtual, Federated:
+	case "":
+		return fmt.Errorf("the '%s' field is missing", rclassField)
+	default:
+		return fmt.Errorf("unsupported %s: %s", rclassField, repoConfig.Rclass())
+	}
+	if repoConfig.PackageType() == "" {
+		return fmt.Errorf("the '%s' field is missing", packageTypeField)
+	}
+	return nil
+}
+
+// Returns the current configuration of the managed repositories that exist in Artifactory.
+// The full configuration is fetched only for the repositories with a template. For the rest, only the key, rclass and package type are needed.
+func getCurrentConfigs(servicesManager artifactory.ArtifactoryServicesManager, desired []RepoConfig, includePattern string) (map[string]RepoConfig, error) {
+	repos, err := getRepositories(servicesManager, includePattern)
+	if err != nil {
+		return nil, err
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, desiredConfig := range desired {
+		desiredKeys[desiredConfig.Key()] = true
+	}
+	current := make(map[string]RepoConfig, len(repos))
+	for _, repo := range repos {
+		if !desiredKeys[repo.Key] {
+			current[repo.Key] = RepoConfig{keyField: repo.Key, rclassField: strings.ToLower(repo.GetRepoType()), packageTypeField: strings.ToLower(repo.PackageType)}
+			continue
+		}
+		if current[repo.Key], err = getRepoConfig(servicesManager, repo.Key); err != nil {
+			return nil, err
+		}
+	}
+	return current, nil
+}
+
+func applyPlan(servicesManager artifactory.ArtifactoryServicesManager, plan *Plan) (err error) {
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case Create:
+			err = servicesManager.CreateRepositoryWithParams(change.config, change.Key)
+		case Update:
+			err = servicesManager.UpdateRepositoryWithParams(change.config, change.Key)
+		case Delete:
+			err = servicesManager.DeleteRepository(change.Key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	log.Info(fmt.Sprintf("Applied %d changes successfully.", len(plan.Changes)))
+	return nil
+}
@@ -0,0 +1,133 @@
+package repoconfig
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const templateFileExtension = ".json"
+
+// RepoExportCommand writes the configuration of the existing repositories to a directory, one template file per repository.
+// The templates can be applied back using the RepoApplyCommand.
+type RepoExportCommand struct {
+	serverDetails *config.ServerDetails
+	exportDir     string
+	// A wildcard pattern of the keys of the repositories to export. If empty, all repositories are exported.
+	includePattern string
+	exportedCount  int
+}
+
+func NewRepoExportCommand() *RepoExportCommand {
+	return &RepoExportCommand{}
+}
+
+func (rec *RepoExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoExportCommand {
+	rec.serverDetails = serverDetails
+	return rec
+}
+
+func (rec *RepoExportCommand) SetExportDir(exportDir string) *RepoExportCommand {
+	rec.exportDir = exportDir
+	return rec
+}
+
+func (rec *RepoExportCommand) SetIncludePattern(includePattern string) *RepoExportCommand {
+	rec.includePattern = includePattern
+	return rec
+}
+
+func (rec *RepoExportCommand) ExportedCount() int {
+	return rec.exportedCount
+}
+
+func (rec *RepoExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rec.serverDetails, nil
+}
+
+func (rec *RepoExportCommand) CommandName() string {
+	return "rt_repo_export"
+}
+
+func (rec *RepoExportCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(rec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repos, err := getRepositories(servicesManager, rec.includePattern)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(rec.exportDir, 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, repo := range repos {
+		repoConfig, err := getRepoConfig(servicesManager, repo.Key)
+		if err != nil {
+			return err
+		}
+		// Secrets are not exported, and should be provided using template variables.
+		for _, field := range writeOnlyFields {
+			delete(repoConfig, field)
+		}
+		content, err := json.MarshalIndent(repoConfig, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		templatePath := filepath.Join(rec.exportDir, repo.Key+templateFileExtension)
+		log.Debug("Writing", templatePath)
+		if err = os.WriteFile(templatePath, append(content, '\n'), 0644); err != nil {
+			return errorutils.CheckError(err)
+		}
+		rec.exportedCount++
+	}
+	log.Info("Exported", rec.exportedCount, "repositories to", rec.exportDir)
+	return nil
+}
+
+// Returns the local, remote, virtual and federated repositories with keys matching the pattern.
+func getRepositories(servicesManager artifactory.ArtifactoryServicesManager, includePattern string) (repos []services.RepositoryDetails, err error) {
+	allRepos, err := servicesManager.GetAllRepositories()
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range *allRepos {
+		switch strings.ToLower(repo.GetRepoType()) {
+		case Local, Remote, Virtual, Federated:
+		default:
+			continue
+		}
+		matched, err := matchRepoKey(includePattern, repo.Key)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			repos = append(repos, repo)
+		}
+	}
+	return
+}
+
+func matchRepoKey(includePattern, repoKey string) (bool, error) {
+	if includePattern == "" {
+		return true, nil
+	}
+	matched, err := filepath.Match(includePattern, repoKey)
+	return matched, errorutils.CheckError(err)
+}
+
+func getRepoConfig(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (RepoConfig, error) {
+	repoConfig := RepoConfig{}
+	if err := servicesManager.GetRepository(repoKey, &repoConfig); err != nil {
+		return nil, err
+	}
+	return repoConfig, nil
+}
@@ -0,0 +1,287 @@
+package repoconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	keyField         = "key"
+	rclassField      = "rclass"
+	packageTypeField = "packageType"
+	// The list of the repositories aggregated by a virtual repository.
+	repositoriesField = "repositories"
+	// The local repository of a virtual repository, to which artifacts are deployed.
+	defaultDeploymentRepoField = "defaultDeploymentRepo"
+
+	Local     = "local"
+	Remote    = "remote"
+	Virtual   = "virtual"
+	Federated = "federated"
+)
+
+// Fields that are never returned by Artifactory as is, and therefore can't be compared.
+var writeOnlyFields = []string{"password"}
+
+// RepoConfig is the configuration of a single repository, in the format of the Artifactory repositories REST API.
+type RepoConfig map[string]interface{}
+
+func (rc RepoConfig) Key() string {
+	return rc.getString(keyField)
+}
+
+func (rc RepoConfig) Rclass() string {
+	return strings.ToLower(rc.getString(rclassField))
+}
+
+func (rc RepoConfig) PackageType() string {
+	return rc.getString(packageTypeField)
+}
+
+func (rc RepoConfig) getString(field string) string {
+	if value, ok := rc[field].(string); ok {
+		return value
+	}
+	return ""
+}
+
+// Returns the keys of the repositories this repository depends on.
+func (rc RepoConfig) dependencies() (dependencies []string) {
+	if members, ok := rc[repositoriesField].([]interface{}); ok {
+		for _, member := range members {
+			if memberKey, ok := member.(string); ok {
+				dependencies = append(dependencies, memberKey)
+			}
+		}
+	}
+	if defaultDeploymentRepo := rc.getString(defaultDeploymentRepoField); defaultDeploymentRepo != "" {
+		dependencies = append(dependencies, defaultDeploymentRepo)
+	}
+	return
+}
+
+type Action string
+
+const (
+	Create Action = "create"
+	Update Action = "update"
+	Delete Action = "delete"
+)
+
+// FieldChange is a change of a single field of a repository configuration.
+type FieldChange struct {
+	Field   string      `json:"field"`
+	Current interface{} `json:"current,omitempty"`
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// RepoChange is a single step of the plan.
+type RepoChange struct {
+	Action      Action        `json:"action"`
+	Key         string        `json:"key"`
+	Rclass      string        `json:"rclass"`
+	PackageType string        `json:"packageType,omitempty"`
+	Changes     []FieldChange `json:"changes,omitempty"`
+	// The desired configuration, sent to Artifactory on create and update.
+	config RepoConfig
+}
+
+// Plan is the ordered list of changes required to bring the repositories in Artifactory to the desired state.
+type Plan struct {
+	Changes []RepoChange `json:"changes"`
+}
+
+func (p *Plan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+func (p *Plan) count(action Action) (count int) {
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+// String returns a human-readable description of the plan.
+func (p *Plan) String() string {
+	if p.IsEmpty() {
+		return "No changes. The repositories are up-to-date."
+	}
+	lines := []string{fmt.Sprintf("Plan: %d to create, %d to update, %d to delete.", p.count(Create), p.count(Update), p.count(Delete)), ""}
+	symbols := map[Action]string{Create: "+", Update: "~", Delete: "-"}
+	for _, change := range p.Changes {
+		lines = append(lines, fmt.Sprintf("%s %-6s %s (%s, %s)", symbols[change.Action], change.Action, change.Key, change.Rclass, change.PackageType))
+		for _, fieldChange := range change.Changes {
+			lines = append(lines, fmt.Sprintf("      %s: %s -> %s", fieldChange.Field, formatValue(fieldChange.Current), formatValue(fieldChange.Desired)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "(none)"
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
+
+// CreatePlan compares the desired configurations with the current ones and returns the changes in the order they should be applied.
+// Repositories are created and updated before the virtual repositories that include them, and deleted after them.
+// current maps the keys of the existing repositories to their configuration. If prune is false, existing repositories
+// that have no desired configuration are left as is.
+func CreatePlan(desired []RepoConfig, current map[string]RepoConfig, prune bool) (*Plan, error) {
+	desiredKeys := make(map[string]bool, len(desired))
+	var upserts []RepoChange
+	for _, desiredConfig := range desired {
+		desiredKeys[desiredConfig.Key()] = true
+		currentConfig, exists := current[desiredConfig.Key()]
+		if !exists {
+			upserts = append(upserts, newRepoChange(Create, desiredConfig))
+			continue
+		}
+		if currentConfig.Rclass() != desiredConfig.Rclass() || currentConfig.PackageType() != desiredConfig.PackageType() {
+			return nil, errorutils.CheckErrorf("the repository '%s' is a %s %s repository and can't be changed to a %s %s repository. Delete it first, or use a different key",
+				desiredConfig.Key(), currentConfig.PackageType(), currentConfig.Rclass(), desiredConfig.PackageType(), desiredConfig.Rclass())
+		}
+		if fieldChanges := diff(currentConfig, desiredConfig); len(fieldChanges) > 0 {
+			change := newRepoChange(Update, desiredConfig)
+			change.Changes = fieldChanges
+			upserts = append(upserts, change)
+		}
+	}
+	upserts, err := sortByDependencies(upserts)
+	if err != nil {
+		return nil, err
+	}
+
+	var deletes []RepoChange
+	if prune {
+		for key, currentConfig := range current {
+			if !desiredKeys[key] {
+				deletes = append(deletes, newRepoChange(Delete, currentConfig))
+			}
+		}
+		if deletes, err = sortByDependencies(deletes); err != nil {
+			return nil, err
+		}
+		// Virtual repositories are deleted before their members.
+		for i, j := 0, len(deletes)-1; i < j; i, j = i+1, j-1 {
+			deletes[i], deletes[j] = deletes[j], deletes[i]
+		}
+	}
+	return &Plan{Changes: append(upserts, deletes...)}, nil
+}
+
+func newRepoChange(action Action, config RepoConfig) RepoChange {
+	return RepoChange{Action: action, Key: config.Key(), Rclass: config.Rclass(), PackageType: config.PackageType(), config: config}
+}
+
+// Returns the fields of the desired configuration that differ from the current configuration.
+// Fields that don't appear in the desired configuration keep their current value, and are therefore ignored.
+func diff(current, desired RepoConfig) (changes []FieldChange) {
+	for field, desiredValue := range desired {
+		if field == keyField || isWriteOnlyField(field) {
+			continue
+		}
+		currentValue := current[field]
+		if isEqual(currentValue, desiredValue) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field, Current: currentValue, Desired: desiredValue})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return
+}
+
+func isWriteOnlyField(field string) bool {
+	for _, writeOnlyField := range writeOnlyFields {
+		if field == writeOnlyField {
+			return true
+		}
+	}
+	return false
+}
+
+func isEqual(current, desired interface{}) bool {
+	if reflect.DeepEqual(current, desired) {
+		return true
+	}
+	// Artifactory omits some of the empty values.
+	return isEmptyValue(current) && isEmptyValue(desired)
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch typedValue := value.(type) {
+	case nil:
+		return true
+	case string:
+		return typedValue == ""
+	case []interface{}:
+		return len(typedValue) == 0
+	case map[string]interface{}:
+		return len(typedValue) == 0
+	}
+	return false
+}
+
+// Sorts the changes so that each repository comes after the repositories it depends on.
+// Non-virtual repositories come first, in the order of their rclass and key.
+func sortByDependencies(changes []RepoChange) ([]RepoChange, error) {
+	rclassOrder := map[string]int{Local: 0, Federated: 1, Remote: 2, Virtual: 3}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Rclass != changes[j].Rclass {
+			return rclassOrder[changes[i].Rclass] < rclassOrder[changes[j].Rclass]
+		}
+		return changes[i].Key < changes[j].Key
+	})
+	indexes := make(map[string]int, len(changes))
+	for i, change := range changes {
+		indexes[change.Key] = i
+	}
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make([]int, len(changes))
+	sorted := make([]RepoChange, 0, len(changes))
+	var visit func(i int, path []string) error
+	visit = func(i int, path []string) error {
+		switch states[i] {
+		case visited:
+			return nil
+		case visiting:
+			return errorutils.CheckErrorf("circular dependency between virtual repositories: %s", strings.Join(append(path, changes[i].Key), " -> "))
+		}
+		states[i] = visiting
+		for _, dependency := range changes[i].config.dependencies() {
+			if dependencyIndex, exists := indexes[dependency]; exists {
+				if err := visit(dependencyIndex, append(path, changes[i].Key)); err != nil {
+					return err
+				}
+			}
+		}
+		states[i] = visited
+		sorted = append(sorted, changes[i])
+		return nil
+	}
+	for i := range changes {
+		if err := visit(i, nil); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
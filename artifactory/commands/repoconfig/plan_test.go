@@ -0,0 +1,91 @@
+package repoconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePlan(t *testing.T) {
+	desired := []RepoConfig{
+		{"key": "libs-virtual", "rclass": "virtual", "packageType": "maven", "repositories": []interface{}{"libs-local", "libs-remote"}},
+		{"key": "libs-remote", "rclass": "remote", "packageType": "maven", "url": "https://repo1.maven.org/maven2"},
+		{"key": "libs-local", "rclass": "local", "packageType": "maven", "description": "new description"},
+		{"key": "all-virtual", "rclass": "virtual", "packageType": "maven", "repositories": []interface{}{"libs-virtual"}},
+	}
+	current := map[string]RepoConfig{
+		"libs-local":    {"key": "libs-local", "rclass": "local", "packageType": "maven", "description": "old description", "notes": "unmanaged"},
+		"libs-remote":   {"key": "libs-remote", "rclass": "remote", "packageType": "maven", "url": "https://repo1.maven.org/maven2"},
+		"old-local":     {"key": "old-local", "rclass": "local", "packageType": "maven"},
+		"old-virtual":   {"key": "old-virtual", "rclass": "virtual", "packageType": "maven"},
+		"all-virtual":   {"key": "all-virtual", "rclass": "virtual", "packageType": "maven", "repositories": []interface{}{}},
+		"unused-remote": {"key": "unused-remote", "rclass": "remote", "packageType": "npm"},
+	}
+
+	plan, err := CreatePlan(desired, current, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"update libs-local", "create libs-virtual", "update all-virtual"}, getSteps(plan))
+	assert.Equal(t, []FieldChange{{Field: "description", Current: "old description", Desired: "new description"}}, plan.Changes[0].Changes)
+
+	plan, err = CreatePlan(desired, current, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"update libs-local", "create libs-virtual", "update all-virtual",
+		"delete old-virtual", "delete unused-remote", "delete old-local"}, getSteps(plan))
+	assert.Contains(t, plan.String(), "Plan: 1 to create, 2 to update, 3 to delete.")
+}
+
+func TestCreatePlanNoChanges(t *testing.T) {
+	repoConfig := RepoConfig{"key": "libs-local", "rclass": "local", "packageType": "maven", "password": "secret", "includesPattern": ""}
+	plan, err := CreatePlan([]RepoConfig{repoConfig}, map[string]RepoConfig{"libs-local": {"key": "libs-local", "rclass": "local", "packageType": "maven"}}, true)
+	assert.NoError(t, err)
+	assert.True(t, plan.IsEmpty())
+	assert.Equal(t, "No changes. The repositories are up-to-date.", plan.String())
+}
+
+func TestCreatePlanRclassChange(t *testing.T) {
+	_, err := CreatePlan([]RepoConfig{{"key": "libs", "rclass": "virtual", "packageType": "maven"}},
+		map[string]RepoConfig{"libs": {"key": "libs", "rclass": "local", "packageType": "maven"}}, false)
+	assert.ErrorContains(t, err, "can't be changed")
+}
+
+func TestCreatePlanCircularDependency(t *testing.T) {
+	_, err := CreatePlan([]RepoConfig{
+		{"key": "a", "rclass": "virtual", "packageType": "maven", "repositories": []interface{}{"b"}},
+		{"key": "b", "rclass": "virtual", "packageType": "maven", "repositories": []interface{}{"a"}},
+	}, map[string]RepoConfig{}, false)
+	assert.ErrorContains(t, err, "circular dependency")
+}
+
+func TestReadTemplates(t *testing.T) {
+	templatesDir := t.TempDir()
+	writeTemplate := func(name, content string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(templatesDir, name), []byte(content), 0644))
+	}
+	writeTemplate("libs-local.json", `{"key": "libs-local", "rclass": "local", "packageType": "maven", "description": "${desc}"}`)
+	writeTemplate("npm-remote.json", `{"key": "npm-remote", "rclass": "remote", "packageType": "npm"}`)
+	writeTemplate("README.md", "Not a template")
+
+	templates, err := ReadTemplates(templatesDir, "desc=Maven releases", "libs-*")
+	assert.NoError(t, err)
+	assert.Equal(t, []RepoConfig{{"key": "libs-local", "rclass": "local", "packageType": "maven", "description": "Maven releases"}}, templates)
+
+	writeTemplate("duplicate.json", `{"key": "npm-remote", "rclass": "remote", "packageType": "npm"}`)
+	_, err = ReadTemplates(templatesDir, "", "")
+	assert.ErrorContains(t, err, "is defined in both")
+
+	writeTemplate("duplicate.json", `{"key": "invalid", "packageType": "npm"}`)
+	_, err = ReadTemplates(templatesDir, "", "")
+	assert.ErrorContains(t, err, "'rclass' field is missing")
+
+	_, err = ReadTemplates(t.TempDir(), "", "")
+	assert.ErrorContains(t, err, "no repository templates")
+}
+
+func getSteps(plan *Plan) (steps []string) {
+	for _, change := range plan.Changes {
+		steps = append(steps, string(change.Action)+" "+change.Key)
+	}
+	return
+}
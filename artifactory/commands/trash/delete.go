@@ -0,0 +1,131 @@
+package trash
+
+import (
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/generic"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	servicesutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// DeleteCommand deletes the items matched by the spec, while keeping them restorable.
+// If a trash repository is set, the items are moved into it together with properties recording their original location.
+// Otherwise, the items are deleted only if the server trash can is enabled, so that they are moved into it by Artifactory.
+type DeleteCommand struct {
+	generic.DeleteCommand
+	trashRepo string
+}
+
+func NewDeleteCommand() *DeleteCommand {
+	return &DeleteCommand{DeleteCommand: *generic.NewDeleteCommand()}
+}
+
+func (dc *DeleteCommand) SetTrashRepo(trashRepo string) *DeleteCommand {
+	dc.trashRepo = trashRepo
+	return dc
+}
+
+func (dc *DeleteCommand) CommandName() string {
+	return "rt_delete_to_trash"
+}
+
+func (dc *DeleteCommand) Run() error {
+	if dc.trashRepo == "" {
+		if err := dc.assertServerTrashCanEnabled(); err != nil {
+			return err
+		}
+		return dc.DeleteCommand.Run()
+	}
+	return dc.moveToTrashRepo()
+}
+
+func (dc *DeleteCommand) assertServerTrashCanEnabled() error {
+	serverDetails, err := dc.ServerDetails()
+	if err != nil {
+		return err
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(serverDetails, dc.Retries(), 0, false)
+	if err != nil {
+		return err
+	}
+	enabled, err := IsServerTrashCanEnabled(servicesManager)
+	if err != nil {
+		return errorutils.CheckErrorf("failed to verify that the trash can is enabled in Artifactory. "+
+			"Use the --trash-repo option to move the items to a quarantine repository instead: %s", err.Error())
+	}
+	if !enabled {
+		return errorutils.CheckErrorf("the trash can is disabled in Artifactory, so deleted items would not be restorable. " +
+			"Enable the trash can or use the --trash-repo option to move the items to a quarantine repository instead")
+	}
+	return nil
+}
+
+func (dc *DeleteCommand) moveToTrashRepo() (err error) {
+	reader, err := dc.GetPathsToDelete()
+	if err != nil {
+		return
+	}
+	defer func() {
+		e := reader.Close()
+		if err == nil {
+			err = e
+		}
+	}()
+	if !dc.Quiet() {
+		allowDelete, e := utils.ConfirmDelete(reader)
+		if e != nil || !allowDelete {
+			return e
+		}
+	}
+	successCount, failedCount, err := dc.moveItems(reader)
+	result := dc.Result()
+	result.SetSuccessCount(successCount)
+	result.SetFailCount(failedCount)
+	return
+}
+
+func (dc *DeleteCommand) moveItems(reader *content.ContentReader) (successCount, failedCount int, err error) {
+	serverDetails, err := dc.ServerDetails()
+	if err != nil {
+		return
+	}
+	servicesManager, err := credentialhelper.CreateServiceManager(serverDetails, dc.Retries(), 0, dc.DryRun())
+	if err != nil {
+		return
+	}
+	deletedBy := serverDetails.GetUser()
+	deletedAt := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	for item := new(servicesutils.ResultItem); reader.NextRecord(item) == nil; item = new(servicesutils.ResultItem) {
+		sourcePath := item.GetItemRelativePath()
+		trashPath := path.Join(dc.trashRepo, sourcePath)
+		log.Info("Moving", sourcePath, "to", trashPath)
+		if e := moveItem(servicesManager, sourcePath, trashPath, dc.DryRun()); e != nil {
+			log.Error("Failed moving", sourcePath, "to the trash repository:", e.Error())
+			failedCount++
+			continue
+		}
+		if !dc.DryRun() {
+			props := servicesutils.NewProperties()
+			props.AddProperty(originalRepoProp, item.Repo)
+			props.AddProperty(originalPathProp, path.Join(item.Path, item.Name))
+			props.AddProperty(deletedAtProp, deletedAt)
+			if deletedBy != "" {
+				props.AddProperty(deletedByProp, deletedBy)
+			}
+			if e := setItemProps(servicesManager, trashPath, props); e != nil {
+				log.Error("Failed recording the original location of", sourcePath, "on", trashPath+":", e.Error())
+				failedCount++
+				continue
+			}
+		}
+		successCount++
+	}
+	err = reader.GetError()
+	return
+}
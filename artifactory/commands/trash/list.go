@@ -0,0 +1,104 @@
+package trash
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ListCommand lists the trashed items that can be restored.
+type ListCommand struct {
+	serverDetails *config.ServerDetails
+	pattern       string
+	trashRepo     string
+	before        time.Time
+	items         []Item
+}
+
+func NewListCommand() *ListCommand {
+	return &ListCommand{pattern: "*", trashRepo: DefaultTrashRepo}
+}
+
+func (lc *ListCommand) SetServerDetails(serverDetails *config.ServerDetails) *ListCommand {
+	lc.serverDetails = serverDetails
+	return lc
+}
+
+func (lc *ListCommand) SetPattern(pattern string) *ListCommand {
+	if pattern != "" {
+		lc.pattern = pattern
+	}
+	return lc
+}
+
+// If empty, the items of the server trash can are listed.
+func (lc *ListCommand) SetTrashRepo(trashRepo string) *ListCommand {
+	if trashRepo != "" {
+		lc.trashRepo = trashRepo
+	}
+	return lc
+}
+
+func (lc *ListCommand) SetBefore(before time.Time) *ListCommand {
+	lc.before = before
+	return lc
+}
+
+func (lc *ListCommand) Items() []Item {
+	return lc.items
+}
+
+func (lc *ListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return lc.serverDetails, nil
+}
+
+func (lc *ListCommand) CommandName() string {
+	return "rt_trash_list"
+}
+
+func (lc *ListCommand) Run() (err error) {
+	servicesManager, err := credentialhelper.CreateServiceManager(lc.serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	lc.items, err = FindItems(servicesManager, lc.trashRepo, lc.pattern, lc.before)
+	return
+}
+
+type itemRow struct {
+	OriginalLocation string `col-name:"Original Location"`
+	TrashPath        string `col-name:"Trash Path"`
+	DeletedBy        string `col-name:"Deleted By"`
+	DeletedAt        string `col-name:"Deleted At"`
+}
+
+// PrintItems prints the trashed items as a table or as JSON.
+func PrintItems(items []Item, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		if items == nil {
+			items = []Item{}
+		}
+		content, err := json.Marshal(items)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(clientutils.IndentJson(content))
+		return nil
+	}
+	var rows []itemRow
+	for _, item := range items {
+		row := itemRow{OriginalLocation: item.OriginalLocation(), TrashPath: item.TrashPath, DeletedBy: item.DeletedBy}
+		if !item.DeletedAt.IsZero() {
+			row.DeletedAt = item.DeletedAt.Format(time.RFC3339)
+		}
+		rows = append(rows, row)
+	}
+	return coreutils.PrintTable(rows, "Restorable Items", "No restorable items were found", false)
+}
@@ -0,0 +1,156 @@
+package trash
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// RestoreCommand moves trashed items back to their original location.
+type RestoreCommand struct {
+	serverDetails *config.ServerDetails
+	pattern       string
+	trashRepo     string
+	before        time.Time
+	dryRun        bool
+	quiet         bool
+	retries       int
+	successCount  int
+	failCount     int
+}
+
+func NewRestoreCommand() *RestoreCommand {
+	return &RestoreCommand{trashRepo: DefaultTrashRepo}
+}
+
+func (rc *RestoreCommand) SetServerDetails(serverDetails *config.ServerDetails) *RestoreCommand {
+	rc.serverDetails = serverDetails
+	return rc
+}
+
+func (rc *RestoreCommand) SetPattern(pattern string) *RestoreCommand {
+	rc.pattern = pattern
+	return rc
+}
+
+// If empty, the items are restored from the server trash can.
+func (rc *RestoreCommand) SetTrashRepo(trashRepo string) *RestoreCommand {
+	if trashRepo != "" {
+		rc.trashRepo = trashRepo
+	}
+	return rc
+}
+
+func (rc *RestoreCommand) SetBefore(before time.Time) *RestoreCommand {
+	rc.before = before
+	return rc
+}
+
+func (rc *RestoreCommand) SetDryRun(dryRun bool) *RestoreCommand {
+	rc.dryRun = dryRun
+	return rc
+}
+
+func (rc *RestoreCommand) SetQuiet(quiet bool) *RestoreCommand {
+	rc.quiet = quiet
+	return rc
+}
+
+func (rc *RestoreCommand) SetRetries(retries int) *RestoreCommand {
+	rc.retries = retries
+	return rc
+}
+
+func (rc *RestoreCommand) SuccessCount() int {
+	return rc.successCount
+}
+
+func (rc *RestoreCommand) FailCount() int {
+	return rc.failCount
+}
+
+func (rc *RestoreCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rc.serverDetails, nil
+}
+
+func (rc *RestoreCommand) CommandName() string {
+	return "rt_restore"
+}
+
+func (rc *RestoreCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(rc.serverDetails, rc.retries, 0, rc.dryRun)
+	if err != nil {
+		return err
+	}
+	items, err := FindItems(servicesManager, rc.trashRepo, rc.pattern, rc.before)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		log.Info("No restorable items were found.")
+		return nil
+	}
+	if !rc.quiet && !confirmRestore(items) {
+		return nil
+	}
+	for _, item := range items {
+		log.Info("Restoring", item.TrashPath, "to", item.OriginalLocation())
+		if err = rc.restoreItem(servicesManager, item); err != nil {
+			log.Error("Failed restoring", item.TrashPath+":", err.Error())
+			rc.failCount++
+			continue
+		}
+		rc.successCount++
+	}
+	return nil
+}
+
+func (rc *RestoreCommand) restoreItem(servicesManager artifactory.ArtifactoryServicesManager, item Item) error {
+	if rc.trashRepo == DefaultTrashRepo {
+		return rc.restoreFromServerTrashCan(servicesManager, item)
+	}
+	if err := moveItem(servicesManager, item.TrashPath, item.OriginalLocation(), rc.dryRun); err != nil {
+		return err
+	}
+	if rc.dryRun {
+		return nil
+	}
+	return deleteItemProps(servicesManager, item.OriginalLocation(), originalRepoProp, originalPathProp, deletedAtProp, deletedByProp)
+}
+
+func (rc *RestoreCommand) restoreFromServerTrashCan(servicesManager artifactory.ArtifactoryServicesManager, item Item) error {
+	if rc.dryRun {
+		return nil
+	}
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	// The trash can API expects the path of the item relative to the trash can repository.
+	fromPath := strings.TrimPrefix(item.TrashPath, DefaultTrashRepo+"/")
+	restoreUrl, err := clientutils.BuildUrl(serviceDetails.GetUrl(), path.Join("api", "trash", "restore", fromPath), map[string]string{
+		"to": item.OriginalLocation(),
+	})
+	if err != nil {
+		return err
+	}
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendPost(restoreUrl, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted)
+}
+
+func confirmRestore(items []Item) bool {
+	for _, item := range items {
+		log.Output("  " + item.TrashPath + " -> " + item.OriginalLocation())
+	}
+	return coreutils.AskYesNo("Are you sure you want to restore the above paths?", false)
+}
@@ -0,0 +1,202 @@
+package trash
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	servicesutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The repository used by Artifactory's trash can.
+	DefaultTrashRepo = "auto-trashcan"
+
+	// Properties attached to trashed items. These are the same properties Artifactory sets on items in its trash can,
+	// so items moved to a quarantine repository can be handled exactly like server trash can items.
+	originalRepoProp = "trash.originalRepository"
+	originalPathProp = "trash.originalPath"
+	deletedAtProp    = "trash.time"
+	deletedByProp    = "trash.deletedBy"
+)
+
+var timestampLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+// Item represents a trashed item that can be restored to its original location.
+type Item struct {
+	// The path of the item inside the trash repository, including the trash repository key.
+	TrashPath    string    `json:"trashPath"`
+	OriginalRepo string    `json:"originalRepository"`
+	OriginalPath string    `json:"originalPath"`
+	DeletedBy    string    `json:"deletedBy,omitempty"`
+	DeletedAt    time.Time `json:"deletedAt"`
+	Type         string    `json:"type,omitempty"`
+}
+
+// Returns the original location of the item, in the form of <repository>/<path>.
+func (item *Item) OriginalLocation() string {
+	return path.Join(item.OriginalRepo, item.OriginalPath)
+}
+
+// ParseTimestamp parses the values accepted by the --before option.
+func ParseTimestamp(timestamp string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.ParseInLocation(layout, timestamp, time.Local); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, errorutils.CheckErrorf("'%s' is not a valid timestamp. Supported formats are 2006-01-02, 2006-01-02T15:04:05 and RFC3339 (2006-01-02T15:04:05Z07:00)", timestamp)
+}
+
+// FindItems returns the items in the trash repository whose original location matches the pattern.
+// If 'before' is not zero, only items deleted before that time are returned.
+func FindItems(servicesManager artifactory.ArtifactoryServicesManager, trashRepo, pattern string, before time.Time) ([]Item, error) {
+	query := fmt.Sprintf(`items.find({"repo":%q,"@%s":{"$match":"*"},"type":"any"}).include("repo","path","name","type","property")`, trashRepo, originalRepoProp)
+	stream, err := servicesManager.Aql(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := stream.Close(); closeErr != nil {
+			log.Debug(closeErr.Error())
+		}
+	}()
+	body, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	result := new(servicesutils.AqlSearchResult)
+	if err = json.Unmarshal(body, result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return filterItems(result.Results, pattern, before)
+}
+
+func filterItems(results []servicesutils.ResultItem, pattern string, before time.Time) (items []Item, err error) {
+	pattern = strings.TrimPrefix(pattern, "/")
+	for _, result := range results {
+		item := toItem(result)
+		if item.OriginalRepo == "" {
+			continue
+		}
+		var matched bool
+		if matched, err = stringutils.MatchWildcardPattern(pattern, item.OriginalLocation()); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if !matched {
+			continue
+		}
+		if !before.IsZero() && !item.DeletedAt.IsZero() && !item.DeletedAt.Before(before) {
+			continue
+		}
+		items = append(items, item)
+	}
+	return
+}
+
+func toItem(result servicesutils.ResultItem) Item {
+	item := Item{TrashPath: result.GetItemRelativePath(), Type: result.Type}
+	for _, prop := range result.Properties {
+		switch prop.Key {
+		case originalRepoProp:
+			item.OriginalRepo = prop.Value
+		case originalPathProp:
+			item.OriginalPath = strings.TrimPrefix(prop.Value, "/")
+		case deletedByProp:
+			item.DeletedBy = prop.Value
+		case deletedAtProp:
+			item.DeletedAt = parseDeletionTime(prop.Value)
+		}
+	}
+	return item
+}
+
+// Artifactory stores the deletion time as epoch milliseconds.
+func parseDeletionTime(value string) time.Time {
+	if millis, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(millis)
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed
+	}
+	return time.Time{}
+}
+
+type configDescriptor struct {
+	TrashcanConfig struct {
+		Enabled bool `xml:"enabled"`
+	} `xml:"trashcanConfig"`
+}
+
+// IsServerTrashCanEnabled checks whether deleted items are moved to the server's trash can.
+func IsServerTrashCanEnabled(servicesManager artifactory.ArtifactoryServicesManager) (bool, error) {
+	descriptor, err := servicesManager.GetConfigDescriptor()
+	if err != nil {
+		return false, err
+	}
+	config := new(configDescriptor)
+	if err = xml.Unmarshal([]byte(descriptor), config); err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	return config.TrashcanConfig.Enabled, nil
+}
+
+func moveItem(servicesManager artifactory.ArtifactoryServicesManager, sourcePath, targetPath string, dryRun bool) error {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	moveUrl, err := clientutils.BuildUrl(serviceDetails.GetUrl(), path.Join("api", "move", sourcePath), map[string]string{
+		"to":  "/" + targetPath,
+		"dry": strconv.Itoa(clientutils.Bool2Int(dryRun)),
+	})
+	if err != nil {
+		return err
+	}
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendPost(moveUrl, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+func setItemProps(servicesManager artifactory.ArtifactoryServicesManager, itemPath string, props *servicesutils.Properties) error {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	propsUrl, err := clientutils.BuildUrl(serviceDetails.GetUrl(), path.Join("api", "storage", itemPath), make(map[string]string))
+	if err != nil {
+		return err
+	}
+	propsUrl += "?properties=" + props.ToEncodedString(true) + "&recursive=0"
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendPut(propsUrl, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
+}
+
+func deleteItemProps(servicesManager artifactory.ArtifactoryServicesManager, itemPath string, keys ...string) error {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	propsUrl, err := clientutils.BuildUrl(serviceDetails.GetUrl(), path.Join("api", "storage", itemPath), map[string]string{
+		"properties": strings.Join(keys, ","),
+		"recursive":  "0",
+	})
+	if err != nil {
+		return err
+	}
+	httpDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendDelete(propsUrl, nil, &httpDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
+}
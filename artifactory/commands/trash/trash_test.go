@@ -0,0 +1,81 @@
+package trash
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	servicesutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterItems(t *testing.T) {
+	deletedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	results := []servicesutils.ResultItem{
+		createTrashResultItem("repo1/a", "file1.txt", deletedAt),
+		createTrashResultItem("repo1/b", "file2.txt", deletedAt.Add(48*time.Hour)),
+		createTrashResultItem("repo2/a", "file3.txt", deletedAt),
+		// Items without the original repository property are not restorable.
+		{Repo: DefaultTrashRepo, Path: "repo1/a", Name: "inner.txt"},
+	}
+
+	testRuns := []struct {
+		name          string
+		pattern       string
+		before        time.Time
+		expectedPaths []string
+	}{
+		{"all", "*", time.Time{}, []string{"repo1/a/file1.txt", "repo1/b/file2.txt", "repo2/a/file3.txt"}},
+		{"byRepo", "repo1/*", time.Time{}, []string{"repo1/a/file1.txt", "repo1/b/file2.txt"}},
+		{"byPath", "/repo1/a/*", time.Time{}, []string{"repo1/a/file1.txt"}},
+		{"before", "repo1/*", deletedAt.Add(time.Hour), []string{"repo1/a/file1.txt"}},
+		{"noMatch", "repo3/*", time.Time{}, nil},
+	}
+	for _, test := range testRuns {
+		t.Run(test.name, func(t *testing.T) {
+			items, err := filterItems(results, test.pattern, test.before)
+			assert.NoError(t, err)
+			var actualPaths []string
+			for _, item := range items {
+				actualPaths = append(actualPaths, item.OriginalLocation())
+			}
+			assert.Equal(t, test.expectedPaths, actualPaths)
+		})
+	}
+}
+
+func TestToItem(t *testing.T) {
+	deletedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	item := toItem(createTrashResultItem("repo1/a", "file1.txt", deletedAt))
+	assert.Equal(t, DefaultTrashRepo+"/repo1/a/file1.txt", item.TrashPath)
+	assert.Equal(t, "repo1", item.OriginalRepo)
+	assert.Equal(t, "a/file1.txt", item.OriginalPath)
+	assert.Equal(t, "admin", item.DeletedBy)
+	assert.True(t, deletedAt.Equal(item.DeletedAt))
+}
+
+func TestParseTimestamp(t *testing.T) {
+	for _, timestamp := range []string{"2024-03-01", "2024-03-01T12:00:00", "2024-03-01T12:00:00Z"} {
+		parsed, err := ParseTimestamp(timestamp)
+		assert.NoError(t, err, timestamp)
+		assert.Equal(t, 2024, parsed.Year())
+	}
+	_, err := ParseTimestamp("01/03/2024")
+	assert.Error(t, err)
+}
+
+func createTrashResultItem(path, name string, deletedAt time.Time) servicesutils.ResultItem {
+	repo := path[:len("repo1")]
+	return servicesutils.ResultItem{
+		Repo: DefaultTrashRepo,
+		Path: path,
+		Name: name,
+		Type: "file",
+		Properties: []servicesutils.Property{
+			{Key: originalRepoProp, Value: repo},
+			{Key: originalPathProp, Value: path[len(repo)+1:] + "/" + name},
+			{Key: deletedAtProp, Value: strconv.FormatInt(deletedAt.UnixMilli(), 10)},
+			{Key: deletedByProp, Value: "admin"},
+		},
+	}
+}
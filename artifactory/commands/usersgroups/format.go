@@ -0,0 +1,25 @@
+package usersgroups
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Csv prints the results as comma-separated values, with a header row.
+const Csv format.OutputFormat = "csv"
+
+// GetOutputFormat returns the output format matching the value of the --format option. The default is a table.
+func GetOutputFormat(value string) (format.OutputFormat, error) {
+	switch strings.ToLower(value) {
+	case "", string(format.Table):
+		return format.Table, nil
+	case string(format.Json):
+		return format.Json, nil
+	case string(Csv):
+		return Csv, nil
+	default:
+		return "", errorutils.CheckErrorf("only the following output formats are supported: %s, %s, %s", format.Table, format.Json, Csv)
+	}
+}
@@ -0,0 +1,190 @@
+package usersgroups
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/artifactory/commands/accessconfig"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/jszwec/csvutil"
+)
+
+// GroupsListCommand lists the groups and their members.
+type GroupsListCommand struct {
+	serverDetails *config.ServerDetails
+	groups        []services.Group
+}
+
+func NewGroupsListCommand() *GroupsListCommand {
+	return &GroupsListCommand{}
+}
+
+func (glc *GroupsListCommand) SetServerDetails(serverDetails *config.ServerDetails) *GroupsListCommand {
+	glc.serverDetails = serverDetails
+	return glc
+}
+
+func (glc *GroupsListCommand) Groups() []services.Group {
+	return glc.groups
+}
+
+func (glc *GroupsListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return glc.serverDetails, nil
+}
+
+func (glc *GroupsListCommand) CommandName() string {
+	return "rt_groups_list"
+}
+
+func (glc *GroupsListCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(glc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	groupNames, err := servicesManager.GetAllGroups()
+	if err != nil {
+		return err
+	}
+	glc.groups = []services.Group{}
+	if groupNames == nil {
+		return nil
+	}
+	sort.Strings(*groupNames)
+	for _, groupName := range *groupNames {
+		group, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: groupName}, IncludeUsers: true})
+		if err != nil {
+			return err
+		}
+		if group != nil {
+			sort.Strings(group.UsersNames)
+			glc.groups = append(glc.groups, *group)
+		}
+	}
+	return nil
+}
+
+type groupRow struct {
+	Name            string `col-name:"Name" csv:"name"`
+	Description     string `col-name:"Description" csv:"description"`
+	Realm           string `col-name:"Realm" csv:"realm"`
+	AutoJoin        string `col-name:"Auto Join" csv:"autoJoin"`
+	AdminPrivileges string `col-name:"Admin Privileges" csv:"adminPrivileges"`
+	// Comma-separated list of the members.
+	Users string `col-name:"Users" csv:"users"`
+}
+
+// PrintGroups prints the groups as a table, JSON or CSV.
+func PrintGroups(groups []services.Group, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		if groups == nil {
+			groups = []services.Group{}
+		}
+		content, err := json.Marshal(groups)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(clientutils.IndentJson(content))
+		return nil
+	}
+	rows := []groupRow{}
+	for _, group := range groups {
+		rows = append(rows, groupRow{
+			Name:            group.Name,
+			Description:     group.Description,
+			Realm:           group.Realm,
+			AutoJoin:        formatBool(group.AutoJoin),
+			AdminPrivileges: formatBool(group.AdminPrivileges),
+			Users:           strings.Join(group.UsersNames, ","),
+		})
+	}
+	if outputFormat != Csv {
+		return coreutils.PrintTable(rows, "Groups", "No groups were found", false)
+	}
+	if len(rows) == 0 {
+		header, err := csvutil.Header(groupRow{}, "csv")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(strings.Join(header, ","))
+		return nil
+	}
+	content, err := csvutil.Marshal(rows)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(strings.TrimSuffix(string(content), "\n"))
+	return nil
+}
+
+// GroupRemoveUsersCommand removes users from a group.
+type GroupRemoveUsersCommand struct {
+	serverDetails *config.ServerDetails
+	name          string
+	users         []string
+}
+
+func NewGroupRemoveUsersCommand() *GroupRemoveUsersCommand {
+	return &GroupRemoveUsersCommand{}
+}
+
+func (gruc *GroupRemoveUsersCommand) SetServerDetails(serverDetails *config.ServerDetails) *GroupRemoveUsersCommand {
+	gruc.serverDetails = serverDetails
+	return gruc
+}
+
+func (gruc *GroupRemoveUsersCommand) SetName(name string) *GroupRemoveUsersCommand {
+	gruc.name = name
+	return gruc
+}
+
+func (gruc *GroupRemoveUsersCommand) SetUsers(users []string) *GroupRemoveUsersCommand {
+	gruc.users = users
+	return gruc
+}
+
+func (gruc *GroupRemoveUsersCommand) ServerDetails() (*config.ServerDetails, error) {
+	return gruc.serverDetails, nil
+}
+
+func (gruc *GroupRemoveUsersCommand) CommandName() string {
+	return "rt_group_remove_users"
+}
+
+func (gruc *GroupRemoveUsersCommand) Run() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(gruc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	group, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: gruc.name}, IncludeUsers: true})
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return errorutils.CheckErrorf("the group '%s' does not exist", gruc.name)
+	}
+	members := make(map[string]bool, len(group.UsersNames))
+	for _, member := range group.UsersNames {
+		members[member] = true
+	}
+	var toRemove []string
+	for _, user := range gruc.users {
+		if members[user] {
+			toRemove = append(toRemove, user)
+		} else {
+			log.Warn("The user '" + user + "' is not a member of the group '" + gruc.name + "'.")
+		}
+	}
+	if err = accessconfig.RemoveGroupMembers(servicesManager, gruc.name, toRemove); err != nil {
+		return err
+	}
+	log.Info("Removed", len(toRemove), "users from the group", gruc.name)
+	return nil
+}
@@ -0,0 +1,230 @@
+package usersgroups
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/jszwec/csvutil"
+)
+
+// UsersListCommand lists the users, or the members of a single group.
+type UsersListCommand struct {
+	serverDetails *config.ServerDetails
+	// If set, only the members of this group are listed.
+	group string
+	users []services.User
+}
+
+func NewUsersListCommand() *UsersListCommand {
+	return &UsersListCommand{}
+}
+
+func (ulc *UsersListCommand) SetServerDetails(serverDetails *config.ServerDetails) *UsersListCommand {
+	ulc.serverDetails = serverDetails
+	return ulc
+}
+
+func (ulc *UsersListCommand) SetGroup(group string) *UsersListCommand {
+	ulc.group = group
+	return ulc
+}
+
+func (ulc *UsersListCommand) Users() []services.User {
+	return ulc.users
+}
+
+func (ulc *UsersListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ulc.serverDetails, nil
+}
+
+func (ulc *UsersListCommand) CommandName() string {
+	return "rt_users_list"
+}
+
+func (ulc *UsersListCommand) Run() (err error) {
+	servicesManager, err := credentialhelper.CreateServiceManager(ulc.serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	ulc.users, err = GetUsers(servicesManager, ulc.group)
+	return
+}
+
+// GetUsers returns the details of the users, sorted by their names. If group is set, only its members are returned.
+func GetUsers(servicesManager artifactory.ArtifactoryServicesManager, group string) ([]services.User, error) {
+	var userNames []string
+	if group == "" {
+		users, err := servicesManager.GetAllUsers()
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			userNames = append(userNames, user.Name)
+		}
+	} else {
+		groupDetails, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: group}, IncludeUsers: true})
+		if err != nil {
+			return nil, err
+		}
+		if groupDetails == nil {
+			return nil, errorutils.CheckErrorf("the group '%s' does not exist", group)
+		}
+		userNames = groupDetails.UsersNames
+	}
+	sort.Strings(userNames)
+
+	users := []services.User{}
+	for _, userName := range userNames {
+		// The users list only includes the names and realms, so the details of every user are fetched separately.
+		user, err := servicesManager.GetUser(services.UserParams{UserDetails: services.User{Name: userName}})
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			// Never print a password, even if it was returned.
+			user.Password = ""
+			users = append(users, *user)
+		}
+	}
+	return users, nil
+}
+
+// userCsvRow is a row of the users CSV. Its header matches the one read by 'jf rt users-create' and 'jf rt users-delete'.
+// The groups of the users are not included, since the CSV can't hold lists, and the passwords are never returned by Artifactory.
+type userCsvRow struct {
+	Name                     string `csv:"username"`
+	Email                    string `csv:"email"`
+	Admin                    *bool  `csv:"admin,omitempty"`
+	ProfileUpdatable         *bool  `csv:"profileUpdatable,omitempty"`
+	DisableUIAccess          *bool  `csv:"disableUIAccess,omitempty"`
+	InternalPasswordDisabled *bool  `csv:"internalPasswordDisabled,omitempty"`
+	Realm                    string `csv:"realm,omitempty"`
+	LastLoggedIn             string `csv:"lastLoggedIn,omitempty"`
+}
+
+// UsersToCsv returns the users as CSV, in the format read by 'jf rt users-create --csv' and 'jf rt users-delete --csv'.
+func UsersToCsv(users []services.User) ([]byte, error) {
+	rows := make([]userCsvRow, 0, len(users))
+	for _, user := range users {
+		rows = append(rows, userCsvRow{
+			Name:                     user.Name,
+			Email:                    user.Email,
+			Admin:                    user.Admin,
+			ProfileUpdatable:         user.ProfileUpdatable,
+			DisableUIAccess:          user.DisableUIAccess,
+			InternalPasswordDisabled: user.InternalPasswordDisabled,
+			Realm:                    user.Realm,
+			LastLoggedIn:             user.LastLoggedIn,
+		})
+	}
+	if len(rows) == 0 {
+		// With no rows, csvutil writes nothing, so the header is written explicitly.
+		header, err := csvutil.Header(userCsvRow{}, "csv")
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		return []byte(strings.Join(header, ",") + "\n"), nil
+	}
+	content, err := csvutil.Marshal(rows)
+	return content, errorutils.CheckError(err)
+}
+
+type userRow struct {
+	Name   string `col-name:"Name"`
+	Email  string `col-name:"Email"`
+	Admin  string `col-name:"Admin"`
+	Realm  string `col-name:"Realm"`
+	Groups string `col-name:"Groups"`
+}
+
+// PrintUsers prints the users as a table, JSON or CSV.
+func PrintUsers(users []services.User, outputFormat format.OutputFormat) error {
+	switch outputFormat {
+	case format.Json:
+		if users == nil {
+			users = []services.User{}
+		}
+		content, err := json.Marshal(users)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(clientutils.IndentJson(content))
+		return nil
+	case Csv:
+		content, err := UsersToCsv(users)
+		if err != nil {
+			return err
+		}
+		log.Output(strings.TrimSuffix(string(content), "\n"))
+		return nil
+	}
+	var rows []userRow
+	for _, user := range users {
+		row := userRow{Name: user.Name, Email: user.Email, Admin: formatBool(user.Admin), Realm: user.Realm}
+		if user.Groups != nil {
+			row.Groups = strings.Join(*user.Groups, ",")
+		}
+		rows = append(rows, row)
+	}
+	return coreutils.PrintTable(rows, "Users", "No users were found", false)
+}
+
+// UsersExportCommand writes the users, or the members of a single group, to a CSV file.
+type UsersExportCommand struct {
+	UsersListCommand
+	csvPath string
+}
+
+func NewUsersExportCommand() *UsersExportCommand {
+	return &UsersExportCommand{}
+}
+
+func (uec *UsersExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *UsersExportCommand {
+	uec.serverDetails = serverDetails
+	return uec
+}
+
+func (uec *UsersExportCommand) SetGroup(group string) *UsersExportCommand {
+	uec.group = group
+	return uec
+}
+
+func (uec *UsersExportCommand) SetCsvPath(csvPath string) *UsersExportCommand {
+	uec.csvPath = csvPath
+	return uec
+}
+
+func (uec *UsersExportCommand) CommandName() string {
+	return "rt_users_export"
+}
+
+func (uec *UsersExportCommand) Run() error {
+	if err := uec.UsersListCommand.Run(); err != nil {
+		return err
+	}
+	content, err := UsersToCsv(uec.users)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(uec.csvPath, content, 0644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Info("Exported", len(uec.users), "users to", uec.csvPath)
+	return nil
+}
+
+func formatBool(value *bool) string {
+	return strconv.FormatBool(value != nil && *value)
+}
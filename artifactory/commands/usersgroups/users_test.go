@@ -0,0 +1,45 @@
+package usersgroups
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jszwec/csvutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersToCsv(t *testing.T) {
+	trueValue := true
+	groups := []string{"developers"}
+	users := []services.User{
+		{Name: "alice", Email: "alice@acme.io", Admin: &trueValue, Realm: "internal", Groups: &groups},
+		{Name: "bob", Email: "bob@acme.io", Realm: "ldap"},
+	}
+	content, err := UsersToCsv(users)
+	assert.NoError(t, err)
+
+	// The CSV should be readable by 'jf rt users-create' and 'jf rt users-delete'.
+	var parsed []services.User
+	assert.NoError(t, csvutil.Unmarshal(content, &parsed))
+	assert.Len(t, parsed, 2)
+	assert.Equal(t, "alice", parsed[0].Name)
+	assert.Equal(t, "alice@acme.io", parsed[0].Email)
+	assert.True(t, *parsed[0].Admin)
+	assert.Equal(t, "bob", parsed[1].Name)
+	assert.Equal(t, "ldap", parsed[1].Realm)
+
+	content, err = UsersToCsv(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "username,email,admin,profileUpdatable,disableUIAccess,internalPasswordDisabled,realm,lastLoggedIn\n", string(content))
+}
+
+func TestGetOutputFormat(t *testing.T) {
+	for value, expected := range map[string]format.OutputFormat{"": format.Table, "table": format.Table, "JSON": format.Json, "csv": Csv} {
+		outputFormat, err := GetOutputFormat(value)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, outputFormat)
+	}
+	_, err := GetOutputFormat("xml")
+	assert.Error(t, err)
+}
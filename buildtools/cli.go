@@ -56,6 +56,7 @@ import (
 	yarndocs "github.com/jfrog/jfrog-cli/docs/buildtools/yarn"
 	"github.com/jfrog/jfrog-cli/docs/buildtools/yarnconfig"
 	"github.com/jfrog/jfrog-cli/docs/common"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
 	"github.com/jfrog/jfrog-cli/utils/cliutils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -416,6 +417,7 @@ func MvnCmd(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	filteredMavenArgs, threads, err := extractThreadsFlag(filteredMavenArgs)
 	if err != nil {
 		return err
@@ -472,6 +474,7 @@ func GradleCmd(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	filteredGradleArgs, threads, err := extractThreadsFlag(filteredGradleArgs)
 	if err != nil {
 		return err
@@ -549,6 +552,7 @@ func NugetCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 
 	nugetCmd := dotnet.NewNugetCommand()
 	nugetCmd.SetServerDetails(rtDetails).SetRepoName(targetRepo).SetBuildConfiguration(buildConfiguration).
@@ -590,6 +594,7 @@ func DotnetCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 
 	// Run command.
 	dotnetCmd := dotnet.NewDotnetCoreCliCommand()
@@ -659,6 +664,7 @@ func GoPublishCmd(c *cli.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	defer buildindex.RecordCollected(buildConfiguration)
 	version := c.Args().Get(0)
 	printDeploymentView, detailedSummary := log.IsStdErrTerminal(), c.Bool("detailed-summary")
 	goPublishCmd := golang.NewGoPublishCommand()
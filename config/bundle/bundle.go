@@ -0,0 +1,211 @@
+package bundle
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	bundleVersion = 1
+	// The value encrypted in the bundle, to verify the passphrase even if the servers have no secrets.
+	checkValue = "jfrog-cli-config-bundle"
+	// The minimal length of the passphrase used to export a bundle.
+	MinPassphraseLength = 8
+)
+
+// Parameters of the scrypt key derivation function, which derives the encryption key from the passphrase.
+type kdfParams struct {
+	Salt string `json:"salt"`
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+}
+
+var defaultKdfParams = kdfParams{N: 32768, R: 8, P: 1}
+
+// Bundle is a file containing the configurations of several servers. The secrets of the servers are encrypted with a key derived from a passphrase.
+type Bundle struct {
+	Version int       `json:"version"`
+	Kdf     kdfParams `json:"kdf"`
+	Check   string    `json:"check"`
+	Servers []*Server `json:"servers"`
+}
+
+// Server is the configuration of a single server in the bundle.
+type Server struct {
+	*config.ServerDetails
+	// The credential helper or the OIDC configuration of the server, which provide its credentials instead of the config.
+	CredentialHelper string                       `json:"credentialHelper,omitempty"`
+	Oidc             *credentialhelper.OidcConfig `json:"oidc,omitempty"`
+}
+
+type secretHandler func(secret string) (string, error)
+
+// Encrypts or decrypts all the secrets of the server. Should match the secrets encrypted in the JFrog CLI configuration file.
+func handleSecrets(details *config.ServerDetails, handler secretHandler) (err error) {
+	if details.Password, err = handler(details.Password); err != nil {
+		return
+	}
+	if details.AccessToken, err = handler(details.AccessToken); err != nil {
+		return
+	}
+	if details.SshPassphrase, err = handler(details.SshPassphrase); err != nil {
+		return
+	}
+	if details.RefreshToken, err = handler(details.RefreshToken); err != nil {
+		return
+	}
+	details.ArtifactoryRefreshToken, err = handler(details.ArtifactoryRefreshToken)
+	return
+}
+
+// NewBundle creates a bundle of the servers, encrypting their secrets with the passphrase.
+// The servers are not modified.
+func NewBundle(servers []*config.ServerDetails, passphrase string) (*Bundle, error) {
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	bundle := &Bundle{Version: bundleVersion, Kdf: defaultKdfParams}
+	bundle.Kdf.Salt = base64.StdEncoding.EncodeToString(salt)
+	key, err := bundle.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.Check, err = encrypt(checkValue, key); err != nil {
+		return nil, err
+	}
+	for _, server := range servers {
+		details := *server
+		if err = handleSecrets(&details, func(secret string) (string, error) { return encrypt(secret, key) }); err != nil {
+			return nil, err
+		}
+		bundle.Servers = append(bundle.Servers, &Server{ServerDetails: &details})
+	}
+	return bundle, nil
+}
+
+// Decrypt decrypts the secrets of the servers in the bundle.
+func (b *Bundle) Decrypt(passphrase string) error {
+	key, err := b.deriveKey(passphrase)
+	if err != nil {
+		return err
+	}
+	if check, err := decrypt(b.Check, key); err != nil || check != checkValue {
+		return errorutils.CheckErrorf("wrong passphrase, or the bundle is corrupted")
+	}
+	for _, server := range b.Servers {
+		if err = handleSecrets(server.ServerDetails, func(secret string) (string, error) { return decrypt(secret, key) }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Bundle) deriveKey(passphrase string) ([]byte, error) {
+	salt, err := base64.StdEncoding.DecodeString(b.Kdf.Salt)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	key, err := scrypt.Key([]byte(passphrase), salt, b.Kdf.N, b.Kdf.R, b.Kdf.P, 32)
+	return key, errorutils.CheckError(err)
+}
+
+func (b *Bundle) validate() error {
+	if b.Version != bundleVersion {
+		return errorutils.CheckErrorf("unsupported bundle version %d", b.Version)
+	}
+	ids := map[string]bool{}
+	for _, server := range b.Servers {
+		if server.ServerDetails == nil || server.ServerId == "" {
+			return errorutils.CheckErrorf("the bundle contains a server without an ID")
+		}
+		if ids[server.ServerId] {
+			return errorutils.CheckErrorf("the server '%s' is defined more than once", server.ServerId)
+		}
+		ids[server.ServerId] = true
+	}
+	return nil
+}
+
+// Write writes the bundle to the file. The file is readable by the current user only.
+func (b *Bundle) Write(filePath string) error {
+	content, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(filePath, content, 0600))
+}
+
+// Read reads a bundle file. The secrets of the servers remain encrypted until Decrypt is called.
+func Read(filePath string) (*Bundle, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	bundle := new(Bundle)
+	if err = json.Unmarshal(content, bundle); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing the bundle %s: %s", filePath, err.Error())
+	}
+	if err = bundle.validate(); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func encrypt(secret string, key []byte) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	gcm, err := newGcm(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+func decrypt(encryptedSecret string, key []byte) (string, error) {
+	if encryptedSecret == "" {
+		return "", nil
+	}
+	cipherText, err := base64.StdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	gcm, err := newGcm(key)
+	if err != nil {
+		return "", err
+	}
+	if len(cipherText) < gcm.NonceSize() {
+		return "", errorutils.CheckErrorf("unexpected cipher text size")
+	}
+	nonce, cipherText := cipherText[:gcm.NonceSize()], cipherText[gcm.NonceSize():]
+	secret, err := gcm.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return "", errorutils.CheckErrorf("failed decrypting a secret: %s", err.Error())
+	}
+	return string(secret), nil
+}
+
+func newGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	return gcm, errorutils.CheckError(err)
+}
@@ -0,0 +1,133 @@
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// A cheaper key derivation keeps the tests fast.
+	defaultKdfParams.N = 1024
+}
+
+func getTestServers() []*config.ServerDetails {
+	return []*config.ServerDetails{
+		{ServerId: "prod", Url: "https://prod.jfrog.io/", ArtifactoryUrl: "https://prod.jfrog.io/artifactory/", AccessToken: "prod-token", IsDefault: true},
+		{ServerId: "dev", Url: "https://dev.jfrog.io/", User: "admin", Password: "dev-password"},
+	}
+}
+
+func TestBundleEncryption(t *testing.T) {
+	bundlePath := filepath.Join(t.TempDir(), "bundle.jfc")
+	servers := getTestServers()
+	bundle, err := NewBundle(servers, "passphrase")
+	assert.NoError(t, err)
+	bundle.Servers[1].CredentialHelper = "/usr/bin/helper"
+	assert.NoError(t, bundle.Write(bundlePath))
+	// The servers are not modified.
+	assert.Equal(t, "prod-token", servers[0].AccessToken)
+
+	content, err := os.ReadFile(bundlePath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), "prod-token")
+	assert.NotContains(t, string(content), "dev-password")
+	assert.Contains(t, string(content), "https://prod.jfrog.io/")
+
+	bundle, err = Read(bundlePath)
+	assert.NoError(t, err)
+	assert.ErrorContains(t, bundle.Decrypt("wrong passphrase"), "wrong passphrase")
+	assert.NoError(t, bundle.Decrypt("passphrase"))
+	assert.Equal(t, "prod-token", bundle.Servers[0].AccessToken)
+	assert.True(t, bundle.Servers[0].IsDefault)
+	assert.Equal(t, "dev-password", bundle.Servers[1].Password)
+	assert.Equal(t, "/usr/bin/helper", bundle.Servers[1].CredentialHelper)
+}
+
+func TestCreatePlan(t *testing.T) {
+	bundle, err := NewBundle(getTestServers(), "passphrase")
+	assert.NoError(t, err)
+	assert.NoError(t, bundle.Decrypt("passphrase"))
+
+	plan, err := CreatePlan(bundle, nil, Fail, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []Change{
+		{Action: Add, ServerId: "prod", TargetId: "prod", Default: true, server: bundle.Servers[0]},
+		{Action: Add, ServerId: "dev", TargetId: "dev", server: bundle.Servers[1]},
+	}, plan.Changes)
+
+	current := []*config.ServerDetails{
+		{ServerId: "prod", Url: "https://prod.jfrog.io/", ArtifactoryUrl: "https://prod.jfrog.io/artifactory/", AccessToken: "prod-token"},
+		{ServerId: "dev", Url: "https://other.jfrog.io/", IsDefault: true},
+	}
+	_, err = CreatePlan(bundle, current, Fail, nil)
+	assert.ErrorContains(t, err, "already configured with different details: dev")
+
+	plan, err = CreatePlan(bundle, current, Overwrite, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []Action{Unchanged, Replace}, getActions(plan))
+	assert.Contains(t, plan.String(), "Plan: 0 to add, 1 to overwrite, 0 to skip, 1 unchanged.")
+
+	plan, err = CreatePlan(bundle, current, SkipExisting, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []Action{Unchanged, Skip}, getActions(plan))
+	assert.True(t, plan.IsEmpty())
+
+	plan, err = CreatePlan(bundle, current, Fail, map[string]string{"dev": "dev-acme"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Action{Unchanged, Add}, getActions(plan))
+	assert.Contains(t, plan.String(), "+ add       dev -> dev-acme")
+
+	_, err = CreatePlan(bundle, current, Fail, map[string]string{"dev": "prod"})
+	assert.ErrorContains(t, err, "more than one server")
+	_, err = CreatePlan(bundle, current, Fail, map[string]string{"qa": "qa2"})
+	assert.ErrorContains(t, err, "not in the bundle")
+}
+
+func TestExportImport(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	bundlePath := filepath.Join(t.TempDir(), "bundle.jfc")
+	assert.NoError(t, config.SaveServersConf(getTestServers()))
+	assert.NoError(t, credentialhelper.SetCredentialHelper("dev", "/usr/bin/helper"))
+	assert.NoError(t, credentialhelper.SetOidcConfig("prod", &credentialhelper.OidcConfig{ProviderName: "github", TokenEnv: "ID_TOKEN"}))
+	assert.ErrorContains(t, NewExportCommand().SetFilePath(bundlePath).SetPassphrase("short").Run(), "at least")
+	assert.NoError(t, NewExportCommand().SetFilePath(bundlePath).SetPassphrase("passphrase").Run())
+
+	// Import into a new configuration, where the default server of the bundle becomes the default.
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	assert.NoError(t, config.SaveServersConf([]*config.ServerDetails{{ServerId: "local", Url: "https://local.jfrog.io/", IsDefault: true}}))
+	importCommand := NewImportCommand().SetFilePath(bundlePath).SetPassphrase("passphrase").SetQuiet(true).SetRenames(map[string]string{"prod": "acme"})
+	assert.NoError(t, importCommand.Run())
+	servers, err := config.GetAllServersConfigs()
+	assert.NoError(t, err)
+	if assert.Len(t, servers, 3) {
+		assert.Equal(t, "local", servers[0].ServerId)
+		assert.True(t, servers[0].IsDefault)
+		assert.Equal(t, "acme", servers[1].ServerId)
+		assert.Equal(t, "prod-token", servers[1].AccessToken)
+		assert.False(t, servers[1].IsDefault)
+		assert.Equal(t, "dev", servers[2].ServerId)
+	}
+	helper, err := credentialhelper.GetCredentialHelper("dev")
+	assert.NoError(t, err)
+	assert.Equal(t, "/usr/bin/helper", helper)
+	oidcConfig, err := credentialhelper.GetOidcConfig("acme")
+	assert.NoError(t, err)
+	assert.Equal(t, &credentialhelper.OidcConfig{ProviderName: "github", TokenEnv: "ID_TOKEN"}, oidcConfig)
+
+	// Importing again changes nothing.
+	assert.NoError(t, importCommand.Run())
+	assert.True(t, importCommand.Plan().IsEmpty())
+}
+
+func getActions(plan *Plan) (actions []Action) {
+	for _, change := range plan.Changes {
+		actions = append(actions, change.Action)
+	}
+	return
+}
@@ -0,0 +1,91 @@
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ExportCommand writes the configurations of the servers to an encrypted bundle file.
+type ExportCommand struct {
+	// The IDs of the servers to export. If empty, all the servers are exported.
+	serverIds  []string
+	filePath   string
+	passphrase string
+}
+
+func NewExportCommand() *ExportCommand {
+	return &ExportCommand{}
+}
+
+func (ec *ExportCommand) SetServerIds(serverIds []string) *ExportCommand {
+	ec.serverIds = serverIds
+	return ec
+}
+
+func (ec *ExportCommand) SetFilePath(filePath string) *ExportCommand {
+	ec.filePath = filePath
+	return ec
+}
+
+func (ec *ExportCommand) SetPassphrase(passphrase string) *ExportCommand {
+	ec.passphrase = passphrase
+	return ec
+}
+
+func (ec *ExportCommand) Run() error {
+	if len(ec.passphrase) < MinPassphraseLength {
+		return errorutils.CheckErrorf("the passphrase should be at least %d characters long", MinPassphraseLength)
+	}
+	servers, err := ec.getServers()
+	if err != nil {
+		return err
+	}
+	// If the configuration is encrypted, the servers are exported only after its master key is entered, the same way as by 'jf c export'.
+	// The check is made by exporting a config token, which is dropped.
+	if _, err = config.Export(servers[0]); err != nil {
+		return err
+	}
+	bundle, err := NewBundle(servers, ec.passphrase)
+	if err != nil {
+		return err
+	}
+	for _, server := range bundle.Servers {
+		if server.CredentialHelper, err = credentialhelper.GetCredentialHelper(server.ServerId); err != nil {
+			return err
+		}
+		if server.Oidc, err = credentialhelper.GetOidcConfig(server.ServerId); err != nil {
+			return err
+		}
+	}
+	if err = bundle.Write(ec.filePath); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Exported %d servers to %s", len(servers), ec.filePath))
+	return nil
+}
+
+func (ec *ExportCommand) getServers() ([]*config.ServerDetails, error) {
+	allServers, err := config.GetAllServersConfigs()
+	if err != nil {
+		return nil, err
+	}
+	if len(allServers) == 0 {
+		return nil, errorutils.CheckErrorf("cannot export config, because it is empty. Run 'jf c add' and then export again")
+	}
+	if len(ec.serverIds) == 0 {
+		return allServers, nil
+	}
+	var servers []*config.ServerDetails
+	for _, serverId := range ec.serverIds {
+		server, err := config.GetSpecificConfig(serverId, false, false)
+		if err != nil {
+			return nil, err
+		}
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
@@ -0,0 +1,312 @@
+package bundle
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/lock"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ConflictPolicy determines what is done with servers of the bundle whose IDs are already configured with different details.
+type ConflictPolicy string
+
+const (
+	// The import fails.
+	Fail ConflictPolicy = "fail"
+	// The configured servers are replaced.
+	Overwrite ConflictPolicy = "overwrite"
+	// The servers of the bundle are not imported.
+	SkipExisting ConflictPolicy = "skip-existing"
+)
+
+type Action string
+
+const (
+	Add       Action = "add"
+	Replace   Action = "overwrite"
+	Skip      Action = "skip"
+	Unchanged Action = "unchanged"
+)
+
+// Change is the import of a single server of the bundle.
+type Change struct {
+	Action Action `json:"action"`
+	// The ID of the server in the bundle.
+	ServerId string `json:"serverId"`
+	// The ID of the imported server. Differs from ServerId if the server is renamed.
+	TargetId string `json:"targetId"`
+	// True if the server becomes the default server.
+	Default bool `json:"default,omitempty"`
+
+	server *Server
+}
+
+// Plan lists what the import of a bundle changes in the configuration.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+func (p *Plan) count(action Action) (count int) {
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return
+}
+
+func (p *Plan) IsEmpty() bool {
+	return p.count(Add)+p.count(Replace) == 0
+}
+
+// String returns a human-readable description of the plan.
+func (p *Plan) String() string {
+	if p.IsEmpty() {
+		return "No changes. All the servers in the bundle are already configured."
+	}
+	lines := []string{fmt.Sprintf("Plan: %d to add, %d to overwrite, %d to skip, %d unchanged.",
+		p.count(Add), p.count(Replace), p.count(Skip), p.count(Unchanged)), ""}
+	symbols := map[Action]string{Add: "+", Replace: "~", Skip: " ", Unchanged: "="}
+	for _, change := range p.Changes {
+		line := fmt.Sprintf("%s %-9s %s", symbols[change.Action], change.Action, change.ServerId)
+		if change.TargetId != change.ServerId {
+			line += " -> " + change.TargetId
+		}
+		if change.Action == Skip {
+			line += " (already exists)"
+		}
+		if change.Default {
+			line += " (default)"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ImportCommand imports the servers of an encrypted bundle file into the configuration.
+type ImportCommand struct {
+	filePath       string
+	passphrase     string
+	conflictPolicy ConflictPolicy
+	// Maps IDs of servers in the bundle to the IDs they are imported as.
+	renames map[string]string
+	// If true, the plan is printed but not applied.
+	dryRun bool
+	quiet  bool
+	plan   *Plan
+}
+
+func NewImportCommand() *ImportCommand {
+	return &ImportCommand{conflictPolicy: Fail}
+}
+
+func (ic *ImportCommand) SetFilePath(filePath string) *ImportCommand {
+	ic.filePath = filePath
+	return ic
+}
+
+func (ic *ImportCommand) SetPassphrase(passphrase string) *ImportCommand {
+	ic.passphrase = passphrase
+	return ic
+}
+
+func (ic *ImportCommand) SetConflictPolicy(conflictPolicy ConflictPolicy) *ImportCommand {
+	ic.conflictPolicy = conflictPolicy
+	return ic
+}
+
+func (ic *ImportCommand) SetRenames(renames map[string]string) *ImportCommand {
+	ic.renames = renames
+	return ic
+}
+
+func (ic *ImportCommand) SetDryRun(dryRun bool) *ImportCommand {
+	ic.dryRun = dryRun
+	return ic
+}
+
+func (ic *ImportCommand) SetQuiet(quiet bool) *ImportCommand {
+	ic.quiet = quiet
+	return ic
+}
+
+func (ic *ImportCommand) Plan() *Plan {
+	return ic.plan
+}
+
+func (ic *ImportCommand) Run() error {
+	bundle, err := Read(ic.filePath)
+	if err != nil {
+		return err
+	}
+	if err = bundle.Decrypt(ic.passphrase); err != nil {
+		return err
+	}
+	current, err := config.GetAllServersConfigs()
+	if err != nil {
+		return err
+	}
+	if ic.plan, err = CreatePlan(bundle, current, ic.conflictPolicy, ic.renames); err != nil {
+		return err
+	}
+	log.Output(ic.plan.String())
+	if ic.plan.IsEmpty() || ic.dryRun {
+		return nil
+	}
+	if !ic.quiet && !coreutils.AskYesNo("Import the servers?", false) {
+		return nil
+	}
+	return applyPlan(ic.plan)
+}
+
+// CreatePlan compares the servers of the bundle with the configured servers, and returns the changes required to import them.
+// The default server is kept. If no server is configured, the default server of the bundle becomes the default.
+func CreatePlan(bundle *Bundle, current []*config.ServerDetails, conflictPolicy ConflictPolicy, renames map[string]string) (*Plan, error) {
+	for serverId := range renames {
+		if !bundle.hasServer(serverId) {
+			return nil, errorutils.CheckErrorf("cannot rename the server '%s', since it is not in the bundle", serverId)
+		}
+	}
+	currentServers := make(map[string]*config.ServerDetails, len(current))
+	for _, server := range current {
+		currentServers[server.ServerId] = server
+	}
+	plan := &Plan{}
+	targetIds := map[string]bool{}
+	var conflicts []string
+	for _, server := range bundle.Servers {
+		change := Change{Action: Add, ServerId: server.ServerId, TargetId: server.ServerId, server: server}
+		if newId, exists := renames[server.ServerId]; exists {
+			change.TargetId = newId
+		}
+		if targetIds[change.TargetId] {
+			return nil, errorutils.CheckErrorf("more than one server of the bundle is imported as '%s'", change.TargetId)
+		}
+		targetIds[change.TargetId] = true
+		if currentServer, exists := currentServers[change.TargetId]; exists {
+			switch {
+			case isSameServer(currentServer, server.ServerDetails):
+				change.Action = Unchanged
+			case conflictPolicy == Overwrite:
+				change.Action = Replace
+			case conflictPolicy == SkipExisting:
+				change.Action = Skip
+			default:
+				conflicts = append(conflicts, change.TargetId)
+			}
+		}
+		change.Default = len(current) == 0 && server.IsDefault
+		plan.Changes = append(plan.Changes, change)
+	}
+	if len(conflicts) > 0 {
+		return nil, errorutils.CheckErrorf("the following servers are already configured with different details: %s. "+
+			"Use --overwrite to replace them, --skip-existing to keep them, or --rename to import them with other IDs", strings.Join(conflicts, ", "))
+	}
+	return plan, nil
+}
+
+func (b *Bundle) hasServer(serverId string) bool {
+	for _, server := range b.Servers {
+		if server.ServerId == serverId {
+			return true
+		}
+	}
+	return false
+}
+
+// Compares the details of the servers, ignoring their IDs and default markers.
+func isSameServer(current, imported *config.ServerDetails) bool {
+	currentCopy, importedCopy := *current, *imported
+	currentCopy.ServerId, importedCopy.ServerId = "", ""
+	currentCopy.IsDefault, importedCopy.IsDefault = false, false
+	return reflect.DeepEqual(currentCopy, importedCopy)
+}
+
+func applyPlan(plan *Plan) error {
+	if err := saveServers(plan); err != nil {
+		return err
+	}
+	for _, change := range plan.Changes {
+		if change.Action != Add && change.Action != Replace {
+			continue
+		}
+		if err := saveCredentialsSource(change.TargetId, change.server); err != nil {
+			return err
+		}
+	}
+	log.Info(fmt.Sprintf("Imported %d servers successfully.", plan.count(Add)+plan.count(Replace)))
+	return nil
+}
+
+// Saves the credential helper or the OIDC configuration of the imported server, replacing the ones of the server it overwrites.
+func saveCredentialsSource(serverId string, server *Server) error {
+	switch {
+	case server.CredentialHelper != "":
+		return credentialhelper.SetCredentialHelper(serverId, server.CredentialHelper)
+	case server.Oidc != nil:
+		return credentialhelper.SetOidcConfig(serverId, server.Oidc)
+	default:
+		return credentialhelper.RemoveServer(serverId)
+	}
+}
+
+// Saves the servers under the config lock, since the configuration may be changed by other processes while the plan is confirmed.
+func saveServers(plan *Plan) (err error) {
+	lockDirPath, err := coreutils.GetJfrogConfigLockDir()
+	if err != nil {
+		return
+	}
+	unlockFunc, err := lock.CreateLock(lockDirPath)
+	// Defer the lockFile.Unlock() function before throwing a possible error to avoid deadlock situations.
+	defer func() {
+		e := unlockFunc()
+		if err == nil {
+			err = e
+		}
+	}()
+	if err != nil {
+		return
+	}
+	servers, err := config.GetAllServersConfigs()
+	if err != nil {
+		return
+	}
+	hasDefault := false
+	for _, server := range servers {
+		hasDefault = hasDefault || server.IsDefault
+	}
+	for _, change := range plan.Changes {
+		if change.Action != Add && change.Action != Replace {
+			continue
+		}
+		details := *change.server.ServerDetails
+		details.ServerId = change.TargetId
+		details.IsDefault = false
+		index := -1
+		for i, server := range servers {
+			if server.ServerId == change.TargetId {
+				index = i
+				details.IsDefault = server.IsDefault
+			}
+		}
+		if change.Default && !hasDefault {
+			details.IsDefault = true
+			hasDefault = true
+		}
+		if index == -1 {
+			servers = append(servers, &details)
+		} else {
+			servers[index] = &details
+		}
+	}
+	if !hasDefault && len(servers) > 0 {
+		servers[0].IsDefault = true
+	}
+	return config.SaveServersConf(servers)
+}
@@ -6,21 +6,29 @@ import (
 
 	"github.com/jfrog/jfrog-client-go/auth/cert"
 
+	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	corecommon "github.com/jfrog/jfrog-cli-core/v2/docs/common"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
+	"github.com/jfrog/jfrog-cli/config/bundle"
 	"github.com/jfrog/jfrog-cli/docs/config/add"
+	"github.com/jfrog/jfrog-cli/docs/config/current"
 	"github.com/jfrog/jfrog-cli/docs/config/edit"
 	"github.com/jfrog/jfrog-cli/docs/config/remove"
 	"github.com/jfrog/jfrog-cli/docs/config/use"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 	"github.com/urfave/cli"
 
 	"github.com/jfrog/jfrog-cli/docs/config/exportcmd"
 	"github.com/jfrog/jfrog-cli/docs/config/importcmd"
 	"github.com/jfrog/jfrog-cli/docs/config/show"
 	"github.com/jfrog/jfrog-cli/utils/cliutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-cli/utils/servercontext"
 )
 
 func GetCommands() []cli.Command {
@@ -62,6 +70,7 @@ func GetCommands() []cli.Command {
 			Name:         "import",
 			Aliases:      []string{"im"},
 			Usage:        importcmd.GetDescription(),
+			Flags:        cliutils.GetCommandFlags(cliutils.ImportConfig),
 			HelpName:     corecommon.CreateUsage("c import", importcmd.GetDescription(), importcmd.Usage),
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       importCmd,
@@ -70,6 +79,7 @@ func GetCommands() []cli.Command {
 			Name:         "export",
 			Aliases:      []string{"ex"},
 			Usage:        exportcmd.GetDescription(),
+			Flags:        cliutils.GetCommandFlags(cliutils.ExportConfig),
 			HelpName:     corecommon.CreateUsage("c export", exportcmd.GetDescription(), exportcmd.Usage),
 			BashComplete: corecommon.CreateBashCompletionFunc(commands.GetAllServerIds()...),
 			Action:       exportCmd,
@@ -81,6 +91,14 @@ func GetCommands() []cli.Command {
 			BashComplete: corecommon.CreateBashCompletionFunc(commands.GetAllServerIds()...),
 			Action:       useCmd,
 		},
+		{
+			Name:         "current",
+			Usage:        current.GetDescription(),
+			Flags:        cliutils.GetCommandFlags(cliutils.CurrentConfig),
+			HelpName:     corecommon.CreateUsage("c current", current.GetDescription(), current.Usage),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       currentCmd,
+		},
 	})
 }
 
@@ -134,9 +152,71 @@ func addOrEdit(c *cli.Context, operation configOperation) error {
 	if err != nil {
 		return err
 	}
+	credentialHelper := c.String(cliutils.CredentialHelper)
+	oidcConfig := getOidcConfig(c)
+	for _, option := range []string{cliutils.OidcTokenEnv, cliutils.OidcAudience} {
+		if oidcConfig == nil && c.String(option) != "" {
+			return errorutils.CheckErrorf("the --%s option can only be used along with the --%s option", option, cliutils.OidcProvider)
+		}
+	}
+	if c.String(cliutils.OidcTokenEnv) != "" && c.String(cliutils.OidcAudience) != "" {
+		return errorutils.CheckErrorf("the --%s option can't be used along with the --%s option, since the ID token isn't requested from GitHub Actions", cliutils.OidcAudience, cliutils.OidcTokenEnv)
+	}
+	if credentialHelper != "" && oidcConfig != nil {
+		return errorutils.CheckErrorf("the --%s and --%s options can't be used together", cliutils.CredentialHelper, cliutils.OidcProvider)
+	}
+	if credentialHelper != "" {
+		if err = validateExternalCredentialsFlags(cliutils.CredentialHelper, serverId, configCommandConfiguration); err != nil {
+			return err
+		}
+		// The credentials are provided by the helper, so they are not requested.
+		configCommandConfiguration.Interactive = false
+	}
+	if oidcConfig != nil {
+		if err = validateExternalCredentialsFlags(cliutils.OidcProvider, serverId, configCommandConfiguration); err != nil {
+			return err
+		}
+		// The token exchange endpoint is part of the JFrog Platform.
+		if configCommandConfiguration.ServerDetails.Url == "" {
+			return errorutils.CheckErrorf("the --url option is mandatory when the --%s option is used", cliutils.OidcProvider)
+		}
+		configCommandConfiguration.Interactive = false
+	}
 	configCmd := commands.NewConfigCommand(commands.AddOrEdit, serverId).SetDetails(configCommandConfiguration.ServerDetails).SetInteractive(configCommandConfiguration.Interactive).
 		SetEncPassword(configCommandConfiguration.EncPassword).SetUseBasicAuthOnly(configCommandConfiguration.BasicAuthOnly)
-	return configCmd.Run()
+	if err = configCmd.Run(); err != nil {
+		return err
+	}
+	switch {
+	case credentialHelper != "":
+		return credentialhelper.SetCredentialHelper(serverId, credentialHelper)
+	case oidcConfig != nil:
+		return credentialhelper.SetOidcConfig(serverId, oidcConfig)
+	}
+	return nil
+}
+
+// Returns the OIDC configuration set by the --oidc-provider, --oidc-token-env and --oidc-audience options, or nil if OIDC is not used.
+func getOidcConfig(c *cli.Context) *credentialhelper.OidcConfig {
+	if c.String(cliutils.OidcProvider) == "" {
+		return nil
+	}
+	return &credentialhelper.OidcConfig{ProviderName: c.String(cliutils.OidcProvider), TokenEnv: c.String(cliutils.OidcTokenEnv), Audience: c.String(cliutils.OidcAudience)}
+}
+
+// Validates the flags of a server whose credentials are provided by a credential helper or by an OIDC token exchange, rather than stored in the config.
+func validateExternalCredentialsFlags(option, serverId string, configCommandConfiguration *commands.ConfigCommandConfiguration) error {
+	if serverId == "" {
+		return errorutils.CheckErrorf("a server ID must be provided when the --%s option is used", option)
+	}
+	serverDetails := configCommandConfiguration.ServerDetails
+	if serverDetails.Password != "" || serverDetails.AccessToken != "" {
+		return errorutils.CheckErrorf("the --%s option can't be used along with a password or an access token, since the credentials are not stored in the config", option)
+	}
+	if serverDetails.Url == "" && serverDetails.ArtifactoryUrl == "" {
+		return errorutils.CheckErrorf("the --url option is mandatory when the --%s option is used", option)
+	}
+	return nil
 }
 
 func showCmd(c *cli.Context) error {
@@ -158,7 +238,14 @@ func deleteCmd(c *cli.Context) error {
 
 	// Clear all configurations
 	if c.NArg() == 0 {
-		return commands.NewConfigCommand(commands.Clear, "").SetInteractive(!quiet).Run()
+		if err := commands.NewConfigCommand(commands.Clear, "").SetInteractive(!quiet).Run(); err != nil {
+			return err
+		}
+		// The configurations are not cleared if the user didn't confirm.
+		if serverIds := commands.GetAllServerIds(); len(serverIds) > 0 {
+			return nil
+		}
+		return credentialhelper.RemoveAllCredentialHelpers()
 	}
 
 	// Delete single configuration
@@ -166,14 +253,66 @@ func deleteCmd(c *cli.Context) error {
 	if !quiet && !coreutils.AskYesNo("Are you sure you want to delete \""+serverId+"\" configuration?", false) {
 		return nil
 	}
-	return commands.NewConfigCommand(commands.Delete, serverId).Run()
+	if err := commands.NewConfigCommand(commands.Delete, serverId).Run(); err != nil {
+		return err
+	}
+	return credentialhelper.RemoveServer(serverId)
 }
 
 func importCmd(c *cli.Context) error {
 	if c.NArg() != 1 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
 	}
-	return commands.Import(c.Args()[0])
+	isBundle, err := fileutils.IsFileExists(c.Args()[0], false)
+	if err != nil {
+		return err
+	}
+	if !isBundle {
+		return commands.Import(c.Args()[0])
+	}
+	return importBundle(c)
+}
+
+func importBundle(c *cli.Context) error {
+	conflictPolicy := bundle.Fail
+	switch {
+	case c.Bool(cliutils.Overwrite) && c.Bool(cliutils.SkipExisting):
+		return errorutils.CheckErrorf("the --overwrite and --skip-existing options can't be used together")
+	case c.Bool(cliutils.Overwrite):
+		conflictPolicy = bundle.Overwrite
+	case c.Bool(cliutils.SkipExisting):
+		conflictPolicy = bundle.SkipExisting
+	}
+	renames, err := parseRenames(c.String(cliutils.Rename))
+	if err != nil {
+		return err
+	}
+	passphrase, err := getPassphrase(c.Bool(cliutils.PassphraseStdin), false)
+	if err != nil {
+		return err
+	}
+	return bundle.NewImportCommand().SetFilePath(c.Args()[0]).SetPassphrase(passphrase).SetConflictPolicy(conflictPolicy).
+		SetRenames(renames).SetDryRun(c.Bool(cliutils.ImportDryRun)).SetQuiet(cliutils.GetQuietValue(c)).Run()
+}
+
+// Parses the value of the --rename option, in the form of "bundleId1=newId1;bundleId2=newId2".
+func parseRenames(value string) (map[string]string, error) {
+	renames := map[string]string{}
+	if value == "" {
+		return renames, nil
+	}
+	for _, rename := range strings.Split(value, ";") {
+		serverId, newId, found := strings.Cut(rename, "=")
+		serverId, newId = strings.TrimSpace(serverId), strings.TrimSpace(newId)
+		if !found || serverId == "" {
+			return nil, errorutils.CheckErrorf("invalid --rename value '%s'. The value should be in the form of \"bundleId1=newId1;bundleId2=newId2\"", value)
+		}
+		if err := ValidateServerId(newId); err != nil {
+			return nil, err
+		}
+		renames[serverId] = newId
+	}
+	return renames, nil
 }
 
 func exportCmd(c *cli.Context) error {
@@ -185,7 +324,62 @@ func exportCmd(c *cli.Context) error {
 	if c.NArg() == 1 {
 		serverId = c.Args()[0]
 	}
-	return commands.Export(serverId)
+	outputPath := c.String(cliutils.ExportOutput)
+	if c.Bool(cliutils.ExportAll) {
+		if serverId != "" {
+			return errorutils.CheckErrorf("a server ID can't be provided along with the --all option")
+		}
+		if outputPath == "" {
+			return errorutils.CheckErrorf("the --output option is mandatory when the --all option is used")
+		}
+	}
+	if outputPath == "" {
+		return commands.Export(serverId)
+	}
+	var serverIds []string
+	if serverId != "" {
+		serverIds = []string{serverId}
+	} else if !c.Bool(cliutils.ExportAll) {
+		defaultServer, err := coreConfig.GetDefaultServerConf()
+		if err != nil {
+			return err
+		}
+		if defaultServer == nil {
+			return errorutils.CheckErrorf("cannot export config, because it is empty. Run 'jf c add' and then export again")
+		}
+		serverIds = []string{defaultServer.ServerId}
+	}
+	passphrase, err := getPassphrase(c.Bool(cliutils.PassphraseStdin), true)
+	if err != nil {
+		return err
+	}
+	return bundle.NewExportCommand().SetServerIds(serverIds).SetFilePath(outputPath).SetPassphrase(passphrase).Run()
+}
+
+// Reads the passphrase of a bundle from stdin or from the console. A new passphrase is requested twice, to avoid typos.
+func getPassphrase(fromStdin, isNew bool) (string, error) {
+	if fromStdin {
+		passphrase, err := commonCliUtils.HandleSecretInput("passphrase", "", "passphrase-stdin", true)
+		if err != nil {
+			return "", err
+		}
+		if passphrase == "" {
+			return "", errorutils.CheckErrorf("no passphrase was provided via stdin")
+		}
+		return passphrase, nil
+	}
+	passphrase, err := ioutils.ScanPasswordFromConsole("Bundle passphrase: ")
+	if err != nil || !isNew {
+		return passphrase, err
+	}
+	confirmation, err := ioutils.ScanPasswordFromConsole("Repeat the passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if confirmation != passphrase {
+		return "", errorutils.CheckErrorf("the passphrases don't match")
+	}
+	return passphrase, nil
 }
 
 func useCmd(c *cli.Context) error {
@@ -196,6 +390,24 @@ func useCmd(c *cli.Context) error {
 	return commands.NewConfigCommand(commands.Use, serverId).Run()
 }
 
+// Prints the server used by the commands, and the reason it was chosen.
+func currentCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	resolution, err := servercontext.Resolve(c.String("server-id"))
+	if err != nil {
+		return err
+	}
+	log.Output("Server ID: " + resolution.ServerDetails.ServerId)
+	log.Output("URL:       " + resolution.ServerDetails.Url)
+	if resolution.Project != "" {
+		log.Output("Project:   " + resolution.Project)
+	}
+	log.Output("Chosen by: " + resolution.Reason)
+	return nil
+}
+
 func CreateConfigCommandConfiguration(c *cli.Context) (configCommandConfiguration *commands.ConfigCommandConfiguration, err error) {
 	configCommandConfiguration = new(commands.ConfigCommandConfiguration)
 	configCommandConfiguration.ServerDetails, err = cliutils.CreateServerDetailsFromFlags(c)
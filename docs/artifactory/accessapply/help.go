@@ -0,0 +1,17 @@
+package accessapply
+
+var Usage = []string{"rt access-apply [command options] <file path>"}
+
+func GetDescription() string {
+	return "Create, update and delete users, groups and permission targets in Artifactory to match a YAML file."
+}
+
+func GetArguments() string {
+	return `	file path
+		Path to a YAML file in the format created by the 'rt access-export' command, with 'users', 'groups' and 'permissions' lists.
+		The command compares the file with Artifactory, prints the plan of the required changes and applies it after confirmation.
+		Fields of users and groups that don't appear in the file keep their current value. Groups with a 'members' list get exactly these members.
+		Permission targets are replaced as a whole.
+		Passwords are never read from the file itself. New users get the password from the environment variable set in their 'passwordEnv' field,
+		or from the file set in their 'passwordFile' field.`
+}
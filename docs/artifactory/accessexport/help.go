@@ -0,0 +1,13 @@
+package accessexport
+
+var Usage = []string{"rt access-export [command options] <file path>"}
+
+func GetDescription() string {
+	return "Export the users, groups with their members and permission targets in Artifactory to a YAML file."
+}
+
+func GetArguments() string {
+	return `	file path
+		Path to the YAML file to create. Users of external realms, such as LDAP and SAML, are not exported.
+		Passwords are not exported. To create users, add a passwordEnv or passwordFile field to them, referencing an environment variable or a file holding the password.`
+}
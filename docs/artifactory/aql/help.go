@@ -0,0 +1,20 @@
+package aql
+
+import "github.com/jfrog/jfrog-cli/docs/common"
+
+var Usage = []string{"rt aql [command options] <query file path>",
+	"rt aql [command options] -"}
+
+const EnvVar string = common.JfrogCliFailNoOp
+
+func GetDescription() string {
+	return "Run an Artifactory Query Language (AQL) query."
+}
+
+func GetArguments() string {
+	return `	query file path
+		Path to a file containing the AQL query, or '-' to read the query from the standard input.
+		The query may use any AQL domain (items, builds, entries, archives, releases...) and the .include(), .sort(), .offset() and .limit() modifiers.
+		The results are fetched page by page, so there's no need to add .offset() and .limit() to large queries.
+		Unsorted items, builds and entries queries are sorted by their repo, path and name, or by their name and number, so that the pages don't overlap.`
+}
@@ -1,9 +1,9 @@
 package buildcollectenv
 
-var Usage = []string{"rt bce <build name> <build number>"}
+var Usage = []string{"rt bce [command options] <build name> <build number>"}
 
 func GetDescription() string {
-	return "Collect environment variables. Environment variables can be excluded using the build-publish command."
+	return "Collect environment variables, and optionally the versions of the tools found on the PATH and the details of the host. Environment variables can be excluded using the build-publish command."
 }
 
 func GetArguments() string {
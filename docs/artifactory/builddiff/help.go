@@ -0,0 +1,22 @@
+package builddiff
+
+var Usage = []string{"rt bdiff [command options] <build name> <build number> <other build number>",
+	"rt bdiff [command options] <build name> <build number> <other build name> <other build number>"}
+
+func GetDescription() string {
+	return "Compare two published builds. The differences of the artifacts and dependencies of each module, the VCS revisions, the environment variables and the issues are reported."
+}
+
+func GetArguments() string {
+	return `	build name
+		The name of the first build.
+
+	build number
+		The number of the first build.
+
+	other build name
+		The name of the second build. If omitted, the second build has the same name as the first one.
+
+	other build number
+		The number of the second build.`
+}
@@ -1,9 +1,10 @@
 package builddiscard
 
-var Usage = []string{"rt bdi [command options] <build name>"}
+var Usage = []string{"rt bdi [command options] <build name>",
+	"rt bdi --build-name-pattern=<pattern> [command options]"}
 
 func GetDescription() string {
-	return "Discard builds by setting retention parameters."
+	return "Discard builds by setting retention parameters. Add --dry-run to preview the builds which would be discarded. The preview is an estimate, since the retention parameters are applied by Artifactory when the builds are discarded. With --build-name-pattern, the preview is shown and confirmed before the builds are discarded, unless --quiet is set."
 }
 
 func GetArguments() string {
@@ -0,0 +1,15 @@
+package buildexport
+
+var Usage = []string{"rt bexp [command options] <build name> <build number>"}
+
+func GetDescription() string {
+	return "Export the build-info of a build, which was collected locally and wasn't published yet, to a tar.gz archive. Run 'rt bimp' on another machine to import the archive, so that the modules which were built on several machines are published as a single build-info."
+}
+
+func GetArguments() string {
+	return `	build name
+		Build name.
+
+	build number
+		Build number.`
+}
@@ -0,0 +1,12 @@
+package buildimport
+
+var Usage = []string{"rt bimp [command options] <archive path>"}
+
+func GetDescription() string {
+	return "Import an archive, which was created by 'rt bexp', into the build-info of the build which was collected locally. The imported modules are published along with the local modules by 'rt bp'. The import fails if the archive has modules which were already collected locally, unless the --merge-duplicates option is set."
+}
+
+func GetArguments() string {
+	return `	archive path
+		Path of the tar.gz archive, which was created by 'rt bexp'.`
+}
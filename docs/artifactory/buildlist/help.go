@@ -0,0 +1,7 @@
+package buildlist
+
+var Usage = []string{"rt bls [command options]"}
+
+func GetDescription() string {
+	return "List the published builds, with their start times and promotion statuses. With the --local option, list the builds whose build-info was collected locally and wasn't published yet. Builds collected by commands which don't record their names, such as 'jf npm', are listed by their local directories, unless a name pattern or a project is set."
+}
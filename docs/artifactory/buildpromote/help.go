@@ -3,7 +3,7 @@ package buildpromote
 var Usage = []string{"rt bpr [command options] <build name> <build number> <target repository>"}
 
 func GetDescription() string {
-	return "This command is used to promote build in Artifactory."
+	return "This command is used to promote build in Artifactory. With the --gates option, the build is promoted only if it passes the quality gates defined in the gates file, such as required properties, statuses or a minimum age. Add --dry-run to only evaluate the gates."
 }
 
 func GetArguments() string {
@@ -0,0 +1,13 @@
+package buildpromoteset
+
+var Usage = []string{"rt bps [command options] <set file>"}
+
+func GetDescription() string {
+	return "Promote a set of builds together. All the builds are validated before any of them is promoted, and if the promotion of a build fails, the builds already promoted are rolled back. The statuses of the rolled back promotions can't be removed, so a 'rolled-back' status is recorded for each rolled back build after them."
+}
+
+func GetArguments() string {
+	return `	set file
+		Path to a YAML file with the builds to promote. Each build has a name, a number, and optionally a project and promotion options, which override the options set at the top level of the file.
+		The options are targetRepo, sourceRepo, status, comment, includeDependencies and properties.`
+}
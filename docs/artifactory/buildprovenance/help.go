@@ -0,0 +1,15 @@
+package buildprovenance
+
+var Usage = []string{"rt bprov [command options] <build name> <build number>"}
+
+func GetDescription() string {
+	return "Generate a SLSA provenance of the artifacts of a build, sign it as a DSSE envelope, upload it to Artifactory and add it to the artifacts of the build. Run 'rt bprov verify' to verify the provenance of a published build."
+}
+
+func GetArguments() string {
+	return `	build name
+		Build name.
+
+	build number
+		Build number.`
+}
@@ -0,0 +1,15 @@
+package buildprovenanceverify
+
+var Usage = []string{"rt bprov verify [command options] <build name> <build number>"}
+
+func GetDescription() string {
+	return "Verify the provenance of a published build. The provenance must be signed by the public key, and attest exactly the artifacts of the build."
+}
+
+func GetArguments() string {
+	return `	build name
+		Build name.
+
+	build number
+		Build number.`
+}
@@ -0,0 +1,7 @@
+package buildqueue
+
+var Usage = []string{"rt bq <command> [command options]"}
+
+func GetDescription() string {
+	return "Manage the builds which were queued by 'rt bp --queue-on-failure', because their build-info failed to be published."
+}
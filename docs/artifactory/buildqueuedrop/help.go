@@ -0,0 +1,16 @@
+package buildqueuedrop
+
+var Usage = []string{"rt bq drop [command options] <build name> <build number>",
+	"rt bq drop --all"}
+
+func GetDescription() string {
+	return "Remove queued builds from the queue, without publishing them."
+}
+
+func GetArguments() string {
+	return `	build name
+		Name of the queued build to drop.
+
+	build number
+		Number of the queued build to drop.`
+}
@@ -0,0 +1,16 @@
+package buildqueueflush
+
+var Usage = []string{"rt bq flush [command options]",
+	"rt bq flush [command options] <build name> <build number>"}
+
+func GetDescription() string {
+	return "Publish the queued builds to Artifactory, and remove them from the queue once they're published. A build is retried with a wait time which is doubled after each retry. A build which is already published with the same start time is removed from the queue without being published again, and a build which is published with a different build-info is kept in the queue."
+}
+
+func GetArguments() string {
+	return `	build name
+		[Optional] Name of a queued build to publish. If omitted, all the queued builds are published.
+
+	build number
+		[Optional] Number of the queued build to publish.`
+}
@@ -0,0 +1,7 @@
+package buildqueuelist
+
+var Usage = []string{"rt bq list"}
+
+func GetDescription() string {
+	return "List the queued builds, with the number of attempts to publish them and the last error."
+}
@@ -0,0 +1,15 @@
+package buildsbom
+
+var Usage = []string{"rt bsbom [command options] <build name> <build number>"}
+
+func GetDescription() string {
+	return "Generate a CycloneDX or SPDX SBOM from the build-info of a build, with the package URLs of its modules and dependencies. Optionally, upload the SBOM to Artifactory as an artifact of the build."
+}
+
+func GetArguments() string {
+	return `	build name
+		Build name.
+
+	build number
+		Build number.`
+}
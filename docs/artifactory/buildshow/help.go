@@ -0,0 +1,15 @@
+package buildshow
+
+var Usage = []string{"rt bsh [command options] <build name> <build number>"}
+
+func GetDescription() string {
+	return "Show the build-info of a published build. With the --local option, show the build-info collected locally, as it would be published by 'jf rt build-publish'."
+}
+
+func GetArguments() string {
+	return `	build name
+		Build name.
+
+	build number
+		Build number.`
+}
@@ -0,0 +1,17 @@
+package groupremoveusers
+
+var Usage = []string{"rt grmu <group name> <users list>"}
+
+func GetDescription() string {
+	return "Remove a list of users from a group."
+}
+
+func GetArguments() string {
+	return `	group name
+		The name of the group.
+
+	users list
+		Specifies the usernames to remove from the specified group.
+		The list should be comma-separated.
+	`
+}
@@ -0,0 +1,7 @@
+package groupslist
+
+var Usage = []string{"rt gl"}
+
+func GetDescription() string {
+	return "List the groups and their members."
+}
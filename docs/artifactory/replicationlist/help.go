@@ -0,0 +1,7 @@
+package replicationlist
+
+var Usage = []string{"rt rpll"}
+
+func GetDescription() string {
+	return "List the replications and the status of their last run. The command fails if any of the replications failed."
+}
@@ -0,0 +1,12 @@
+package replicationrun
+
+var Usage = []string{"rt rplr <repository key>", "rt rplr <repository key> --wait"}
+
+func GetDescription() string {
+	return "Run the replications of a repository now."
+}
+
+func GetArguments() string {
+	return `	repository key
+		The source repository of a push replication, or the remote repository of a pull replication.`
+}
@@ -0,0 +1,12 @@
+package replicationstatus
+
+var Usage = []string{"rt rpls <repository key>"}
+
+func GetDescription() string {
+	return "Show the replication status of a repository, including the last completed run and the time passed since. The command fails if the replication failed."
+}
+
+func GetArguments() string {
+	return `	repository key
+		The source repository of the replication.`
+}
@@ -0,0 +1,15 @@
+package repoapply
+
+var Usage = []string{"rt rap [command options] <templates dir>"}
+
+func GetDescription() string {
+	return "Create, update and delete repositories in Artifactory to match a directory of repository templates."
+}
+
+func GetArguments() string {
+	return `	templates dir
+		Path to a directory containing repository templates (*.json files), such as the ones created by the 'rt repo-export' command.
+		The command compares the templates with the existing repositories, prints the plan of the required changes and applies it after confirmation.
+		Repositories are created and updated before the virtual repositories that include them, and deleted after them.
+		Fields that don't appear in a template keep their current value.`
+}
@@ -0,0 +1,13 @@
+package repoexport
+
+var Usage = []string{"rt rex [command options] <export dir>"}
+
+func GetDescription() string {
+	return "Export the configuration of the local, remote, virtual and federated repositories in Artifactory to a directory of repository templates."
+}
+
+func GetArguments() string {
+	return `	export dir
+		Path to the directory to which the templates are written, one <repository key>.json file per repository.
+		Passwords are not exported. To apply them, add them to the templates using template variables, such as "password": "${password}".`
+}
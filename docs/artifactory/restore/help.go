@@ -0,0 +1,17 @@
+package restore
+
+import "github.com/jfrog/jfrog-cli/docs/common"
+
+var Usage = []string{"rt restore [command options] <restore pattern>"}
+
+const EnvVar string = common.JfrogCliFailNoOp
+
+func GetDescription() string {
+	return "Restore items deleted with 'jf rt delete --to-trash' to their original location."
+}
+
+func GetArguments() string {
+	return `	restore pattern
+		Specifies the original location of the items to restore, in the following format: <repository name>/<repository path>.
+		You can use wildcards to specify multiple items.`
+}
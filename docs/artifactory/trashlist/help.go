@@ -0,0 +1,13 @@
+package trashlist
+
+var Usage = []string{"rt trash-list [command options] [pattern]"}
+
+func GetDescription() string {
+	return "List the deleted items that can be restored with 'jf rt restore'."
+}
+
+func GetArguments() string {
+	return `	pattern
+		[Optional] Specifies the original location of the items to list, in the following format: <repository name>/<repository path>.
+		You can use wildcards to specify multiple items. If not specified, all restorable items are listed.`
+}
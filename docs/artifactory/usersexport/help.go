@@ -0,0 +1,7 @@
+package usersexport
+
+var Usage = []string{"rt uex --csv=<users details file path>", "rt uex --csv=<users details file path> --group=<group name>"}
+
+func GetDescription() string {
+	return "Export the users' details to a CSV file, which can be used as the input of the users-create and users-delete commands. Passwords are not exported."
+}
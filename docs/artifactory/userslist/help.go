@@ -0,0 +1,7 @@
+package userslist
+
+var Usage = []string{"rt ul", "rt ul --group=<group name>"}
+
+func GetDescription() string {
+	return "List the users. The CSV output can be used as the input of the users-create and users-delete commands."
+}
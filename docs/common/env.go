@@ -40,6 +40,11 @@ const (
 	JfrogCliServerID = `	JFROG_CLI_SERVER_ID
 		Server ID configured using the config command.`
 
+	JfrogCliContext = `	JFROG_CLI_CONTEXT
+		Path to a context file, or to a directory containing a .jfrog/context.yaml file.
+		The context file sets the server ID and project key used by the commands,
+		instead of the .jfrog/context.yaml file found in the working directory or in one of its parents.`
+
 	Ci = `	CI
 		[Default: false]
 		If true, disables interactive prompts and progress bar.`
@@ -105,6 +110,15 @@ var (
 		Environment variables match those patterns will be excluded.
 		This environment variable is used by the "` + coreutils.GetCliExecutableName() + ` rt build-publish" command,
 		in case the --env-exclude command option is not sent.`
+
+	JfrogCliProvenanceSigningKey = `	JFROG_CLI_PROVENANCE_SIGNING_KEY
+		Path to a PEM file of an ed25519 or ECDSA private key, used to sign the SLSA provenance of builds.
+		The "` + coreutils.GetCliExecutableName() + ` rt build-publish --provenance" and "` + coreutils.GetCliExecutableName() + ` rt build-provenance" commands use the value of this environment variable,
+		unless the --signing-key command option is sent.`
+
+	JfrogCliContainerImage = `	JFROG_CLI_CONTAINER_IMAGE
+		The image of the container the CLI runs in, such as "acme/builder@sha256:<digest>". It can't be detected from inside the container.
+		The "` + coreutils.GetCliExecutableName() + ` rt build-collect-env --host" command adds it and its digest to the build-info.`
 )
 
 func GetGlobalEnvVars() string {
@@ -117,6 +131,7 @@ func GetGlobalEnvVars() string {
 		JfrogCliBuildNumber,
 		JfrogCliBuildProject,
 		JfrogCliServerID,
+		JfrogCliContext,
 		Ci,
 		JfrogCliPluginsServer,
 		JfrogCliPluginsRepo,
@@ -0,0 +1,7 @@
+package current
+
+var Usage = []string{"config current"}
+
+func GetDescription() string {
+	return "Show the server used by the commands, and the reason it was chosen: the --server-id option, the JFROG_CLI_SERVER_ID environment variable, the .jfrog/context.yaml file of the working directory or of JFROG_CLI_CONTEXT, or the default server."
+}
@@ -2,8 +2,9 @@ package exportcmd
 
 import "github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 
-var Usage = []string{"config export [server ID]"}
+var Usage = []string{"config export [server ID]", "config export [server ID] --output=<bundle path>", "config export --all --output=<bundle path>"}
 
 func GetDescription() string {
-	return `Creates a server configuration token. The generated Config Token can be imported by the "` + coreutils.GetCliExecutableName() + ` config import <Config Token>" command.`
+	return `Creates a server configuration token. The generated Config Token can be imported by the "` + coreutils.GetCliExecutableName() + ` config import <Config Token>" command.
+With --output, writes the server, or all the servers with --all, to a bundle file instead. The secrets in the bundle are encrypted with a passphrase. If the configuration is encrypted, its master key is required in both cases.`
 }
@@ -2,8 +2,9 @@ package importcmd
 
 import "github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 
-var Usage = []string{"config import <Config Token>"}
+var Usage = []string{"config import <Config Token>", "config import <bundle path>"}
 
 func GetDescription() string {
-	return `Imports a server configuration from a Config Token. A Config Token is generated by the "` + coreutils.GetCliExecutableName() + ` config export <Server ID>" command.`
+	return `Imports a server configuration from a Config Token, or the servers of a bundle file. A Config Token is generated by the "` + coreutils.GetCliExecutableName() + ` config export <Server ID>" command,
+and a bundle file by the "` + coreutils.GetCliExecutableName() + ` config export --all --output=<bundle path>" command. The changes are listed before the servers of a bundle are imported.`
 }
@@ -0,0 +1,17 @@
+package api
+
+var Usage = []string{"api [command options] <service> <curl command>"}
+
+func GetDescription() string {
+	return "Execute a cUrl command against the REST API of a JFrog service, using the configured server details."
+}
+
+func GetArguments() string {
+	return `	service
+		The JFrog service whose REST API is called. The supported services are: platform, artifactory, xray, distribution, access, lifecycle, pipelines and mission-control.
+		The service URL is taken from the server configuration. If not configured, it is derived from the platform URL.
+
+	curl command
+		cUrl command to run. The URL argument should only include the REST API path, relative to the service URL (e.g. 'api/v1/system/version').
+		The credentials of the configured server are added to the command, and must not be included in it.`
+}
@@ -0,0 +1,9 @@
+package doctor
+
+var Usage = []string{"doctor [command options]"}
+
+func GetDescription() string {
+	return `Diagnose the JFrog CLI environment and the connectivity to the configured JFrog Platform servers.
+		Checks the proxy settings, the home and temp directories, the CLI and plugins versions, and for each configured service URL of each server, or only of the server set by --server-id,
+		the DNS resolution, reachability and latency, TLS certificate, clock skew and credentials.`
+}
@@ -0,0 +1,12 @@
+package logout
+
+var Usage = []string{"logout [server ID]", "logout --all"}
+
+func GetDescription() string {
+	return "Revoke the access and refresh tokens stored for a server and remove the server from the configuration. Without a server ID, logs out of the default server."
+}
+
+func GetArguments() string {
+	return `	server ID
+		The ID of the server to log out of. Not required if '--all' is set.`
+}
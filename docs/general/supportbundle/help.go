@@ -0,0 +1,9 @@
+package supportbundle
+
+var Usage = []string{"support-bundle [command options]"}
+
+func GetDescription() string {
+	return `Collect the information required for troubleshooting into a single zip archive, to be attached to support tickets.
+		The archive includes the CLI and plugins versions, the OS and architecture, the servers configuration, the relevant environment variables,
+		the recent CLI logs, the local build-info directory listing and the 'jf doctor' results. Passwords and tokens are masked in all the collected files.`
+}
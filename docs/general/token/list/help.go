@@ -0,0 +1,7 @@
+package list
+
+var Usage = []string{"access-token list", "access-token list --username=<username>"}
+
+func GetDescription() string {
+	return "List the access tokens. Administrators can list the tokens of all users."
+}
@@ -0,0 +1,12 @@
+package revoke
+
+var Usage = []string{"access-token revoke <token id>", "access-token revoke --token-file=<path>"}
+
+func GetDescription() string {
+	return "Revoke an access token."
+}
+
+func GetArguments() string {
+	return `	token id
+		The ID of the access token to revoke, as shown by 'jf access-token list'. Not required if '--token-file' is set.`
+}
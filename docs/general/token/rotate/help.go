@@ -0,0 +1,8 @@
+package rotate
+
+var Usage = []string{"access-token rotate --server-id=<server id>"}
+
+func GetDescription() string {
+	return `Replace the access token of a configured server.
+		A new token is created with the same scope and expiry as the current one, and saved in the server configuration. The current token is then revoked.`
+}
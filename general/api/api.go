@@ -0,0 +1,439 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The JFrog services whose REST APIs can be called.
+const (
+	Platform       = "platform"
+	Artifactory    = "artifactory"
+	Xray           = "xray"
+	Distribution   = "distribution"
+	Access         = "access"
+	Lifecycle      = "lifecycle"
+	Pipelines      = "pipelines"
+	MissionControl = "mission-control"
+)
+
+var Services = []string{Platform, Artifactory, Xray, Distribution, Access, Lifecycle, Pipelines, MissionControl}
+
+// The maximum number of pages fetched by a paginated call.
+const maxPages = 1000
+
+// The names of the array fields holding the results of paginated APIs, in order of precedence.
+var pageItemsFields = []string{"results", "data", "items", "tokens", "rows"}
+
+// ApiCommand runs a cUrl command against the REST API of a JFrog service, using the configured server details.
+// The credentials are passed to cUrl through a temporary config file, so that they never appear in the process arguments or in the logs.
+type ApiCommand struct {
+	serverDetails *config.ServerDetails
+	service       string
+	arguments     []string
+	paginate      bool
+	raw           bool
+}
+
+func NewApiCommand() *ApiCommand {
+	return &ApiCommand{}
+}
+
+func (ac *ApiCommand) SetServerDetails(serverDetails *config.ServerDetails) *ApiCommand {
+	ac.serverDetails = serverDetails
+	return ac
+}
+
+func (ac *ApiCommand) SetService(service string) *ApiCommand {
+	ac.service = service
+	return ac
+}
+
+// The cUrl arguments. The URL argument should only include the path of the REST API, relative to the service URL.
+func (ac *ApiCommand) SetArguments(arguments []string) *ApiCommand {
+	ac.arguments = arguments
+	return ac
+}
+
+// If set, the 'offset' or 'page_num' query parameter is incremented until all pages are fetched, and the pages are merged.
+func (ac *ApiCommand) SetPaginate(paginate bool) *ApiCommand {
+	ac.paginate = paginate
+	return ac
+}
+
+// If set, JSON responses are printed as is instead of being indented.
+func (ac *ApiCommand) SetRaw(raw bool) *ApiCommand {
+	ac.raw = raw
+	return ac
+}
+
+func (ac *ApiCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ac.serverDetails, nil
+}
+
+func (ac *ApiCommand) CommandName() string {
+	return "api"
+}
+
+func (ac *ApiCommand) Run() (err error) {
+	serviceUrl, err := GetServiceUrl(ac.serverDetails, ac.service)
+	if err != nil {
+		return
+	}
+	if isCredentialsFlagExists(ac.arguments) {
+		return errorutils.CheckErrorf("the cUrl command must not include credentials flags (-u, --user, --cert, --key or --config). The credentials of the configured server are used")
+	}
+	uriIndex := findUriIndex(ac.arguments)
+	if uriIndex == -1 {
+		return errorutils.CheckErrorf("could not find the REST API path in the cUrl command")
+	}
+	uri := ac.arguments[uriIndex]
+	if strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://") {
+		return errorutils.CheckErrorf("the cUrl command must not include a full URL, but only the REST API path (e.g. 'api/v1/system/version')")
+	}
+	requestUrl := serviceUrl + strings.TrimPrefix(uri, "/")
+
+	configPath, err := writeCredentialsConfig(ac.serverDetails)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if e := os.Remove(configPath); err == nil {
+			err = errorutils.CheckError(e)
+		}
+	}()
+
+	execPath, err := exec.LookPath("curl")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	curl := &curlRunner{execPath: execPath, arguments: ac.arguments, uriIndex: uriIndex, configPath: configPath}
+	if ac.paginate {
+		return ac.runPaginated(curl, requestUrl)
+	}
+	if ac.raw {
+		return curl.run(requestUrl, nil)
+	}
+	output := new(bytes.Buffer)
+	err = curl.run(requestUrl, output)
+	ac.print(output.Bytes())
+	return
+}
+
+func (ac *ApiCommand) print(output []byte) {
+	if !ac.raw && json.Valid(output) {
+		log.Output(clientutils.IndentJson(output))
+		return
+	}
+	if _, err := os.Stdout.Write(output); err != nil {
+		log.Error(err)
+	}
+}
+
+func (ac *ApiCommand) runPaginated(curl *curlRunner, requestUrl string) error {
+	pages, err := newPager(requestUrl)
+	if err != nil {
+		return err
+	}
+	firstPage, allItems, err := fetchPages(pages, func(pageUrl string, output *bytes.Buffer) error {
+		err := curl.run(pageUrl, output)
+		if err != nil {
+			ac.print(output.Bytes())
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	merged := new(bytes.Buffer)
+	encoder := json.NewEncoder(merged)
+	// Keep characters such as '&' in URLs as they were returned.
+	encoder.SetEscapeHTML(false)
+	if err = encoder.Encode(mergePages(firstPage, allItems)); err != nil {
+		return errorutils.CheckError(err)
+	}
+	ac.print(bytes.TrimSpace(merged.Bytes()))
+	return nil
+}
+
+// Fetches the pages until a page isn't full. Returns the first page and the items of all the pages.
+// Since an API which ignores the paging parameters returns the same full page every time, the pages are also
+// fetched until a page repeats the previous one, and up to maxPages pages.
+func fetchPages(pages *pager, fetch func(pageUrl string, output *bytes.Buffer) error) (firstPage interface{}, allItems []interface{}, err error) {
+	var previousItems []interface{}
+	for pageNum := 1; ; pageNum++ {
+		output := new(bytes.Buffer)
+		if err = fetch(pages.currentUrl(), output); err != nil {
+			return
+		}
+		var page interface{}
+		var items []interface{}
+		if page, items, err = parsePage(output.Bytes()); err != nil {
+			return
+		}
+		if firstPage == nil {
+			firstPage = page
+		}
+		if len(items) > 0 && reflect.DeepEqual(items, previousItems) {
+			log.Warn("The API returned the same page twice, so it may not support the paging parameters. Stopped fetching pages.")
+			return
+		}
+		allItems = append(allItems, items...)
+		if len(items) < pages.size {
+			return
+		}
+		if pageNum >= maxPages {
+			log.Warn(fmt.Sprintf("Stopped fetching pages after %d pages.", maxPages))
+			return
+		}
+		previousItems = items
+		pages.next()
+	}
+}
+
+// GetServiceUrl returns the base URL of the service, ending with a slash.
+// If the URL of the service is not configured, it is derived from the platform URL.
+func GetServiceUrl(serverDetails *config.ServerDetails, service string) (string, error) {
+	var serviceUrl, platformSuffix string
+	switch service {
+	case Platform:
+	case Artifactory:
+		serviceUrl, platformSuffix = serverDetails.ArtifactoryUrl, "artifactory/"
+	case Xray:
+		serviceUrl, platformSuffix = serverDetails.XrayUrl, "xray/"
+	case Distribution:
+		serviceUrl, platformSuffix = serverDetails.DistributionUrl, "distribution/"
+	case Access:
+		serviceUrl, platformSuffix = serverDetails.AccessUrl, "access/"
+	case Lifecycle:
+		serviceUrl, platformSuffix = serverDetails.LifecycleUrl, "lifecycle/"
+	case Pipelines:
+		serviceUrl, platformSuffix = serverDetails.PipelinesUrl, "pipelines/"
+	case MissionControl:
+		serviceUrl, platformSuffix = serverDetails.MissionControlUrl, "mc/"
+	default:
+		return "", errorutils.CheckErrorf("unknown service '%s'. The supported services are: %s", service, coreutils.ListToText(Services))
+	}
+	if serviceUrl == "" {
+		if serverDetails.Url == "" {
+			return "", errorutils.CheckErrorf("the %s URL is not configured for server '%s'. Use the 'jf c edit' command to set it", service, serverDetails.ServerId)
+		}
+		serviceUrl = clientutils.AddTrailingSlashIfNeeded(serverDetails.Url) + platformSuffix
+	}
+	return clientutils.AddTrailingSlashIfNeeded(serviceUrl), nil
+}
+
+// Writes the credentials of the server to a temporary cUrl config file, readable by the current user only.
+func writeCredentialsConfig(serverDetails *config.ServerDetails) (configPath string, err error) {
+	configFile, err := fileutils.CreateTempFile()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if e := configFile.Close(); err == nil {
+			err = errorutils.CheckError(e)
+		}
+	}()
+	configPath = configFile.Name()
+	_, err = configFile.WriteString(createCredentialsConfig(serverDetails))
+	err = errorutils.CheckError(err)
+	return
+}
+
+func createCredentialsConfig(serverDetails *config.ServerDetails) string {
+	var lines []string
+	if serverDetails.ClientCertPath != "" {
+		lines = append(lines, "cert = "+quoteConfigValue(serverDetails.ClientCertPath), "key = "+quoteConfigValue(serverDetails.ClientCertKeyPath))
+	}
+	switch {
+	case serverDetails.AccessToken != "":
+		lines = append(lines, "header = "+quoteConfigValue("Authorization: Bearer "+serverDetails.AccessToken))
+	case serverDetails.User != "":
+		lines = append(lines, "user = "+quoteConfigValue(serverDetails.User+":"+serverDetails.Password))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Quotes a value of a cUrl config file. Within double quotes, cUrl treats backslash as an escape character.
+func quoteConfigValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+type curlRunner struct {
+	execPath   string
+	arguments  []string
+	uriIndex   int
+	configPath string
+}
+
+// Runs cUrl with the provided URL. If output is nil, the response is written to the standard output.
+func (cr *curlRunner) run(requestUrl string, output *bytes.Buffer) error {
+	arguments := append([]string(nil), cr.arguments...)
+	arguments[cr.uriIndex] = requestUrl
+	if output != nil {
+		// The progress meter is printed when the output is not a terminal, so silence it while keeping the errors.
+		arguments = append(arguments, "--silent", "--show-error")
+	}
+	arguments = append(arguments, "--config", cr.configPath)
+	log.Debug(fmt.Sprintf("Executing curl command: 'curl %s'", strings.Join(arguments, " ")))
+	cmd := exec.Command(cr.execPath, arguments...)
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	if output != nil {
+		cmd.Stdout = output
+	} else {
+		cmd.Stdout = os.Stdout
+	}
+	return errorutils.CheckError(cmd.Run())
+}
+
+// Returns the index of the URL argument. A command flag is prefixed by '-' or '--'.
+// Flags without values, such as -v, are expected to be unified with other flags or placed after the URL.
+func findUriIndex(arguments []string) int {
+	skipThisArg := false
+	for index, arg := range arguments {
+		if skipThisArg {
+			skipThisArg = false
+			continue
+		}
+		if strings.HasPrefix(arg, "-") {
+			// A short flag may contain its value, such as -XPOST.
+			skipThisArg = strings.HasPrefix(arg, "--") || len(arg) == 2
+			continue
+		}
+		return index
+	}
+	return -1
+}
+
+func isCredentialsFlagExists(arguments []string) bool {
+	for _, arg := range arguments {
+		if strings.HasPrefix(arg, "-u") || arg == "--user" || arg == "--cert" || arg == "--key" || arg == "--config" || arg == "-K" {
+			return true
+		}
+	}
+	return false
+}
+
+// pager sets the pagination query parameters of the request URL.
+// APIs paginated by 'offset' and 'limit' and APIs paginated by 'page_num' and 'num_of_rows' are supported.
+type pager struct {
+	requestUrl *url.URL
+	pageParam  string
+	page       int
+	// The number of items in a full page.
+	size int
+	// The value added to the page parameter when moving to the next page.
+	step int
+}
+
+func newPager(requestUrl string) (*pager, error) {
+	parsedUrl, err := url.Parse(requestUrl)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	query := parsedUrl.Query()
+	p := &pager{requestUrl: parsedUrl}
+	switch {
+	case query.Has("limit"):
+		p.pageParam, p.step = "offset", -1
+		p.size, err = strconv.Atoi(query.Get("limit"))
+	case query.Has("num_of_rows"):
+		p.pageParam, p.step = "page_num", 1
+		p.size, err = strconv.Atoi(query.Get("num_of_rows"))
+	default:
+		return nil, errorutils.CheckErrorf("pagination requires the page size to be set in the REST API path, using the 'limit' or 'num_of_rows' query parameter")
+	}
+	if err != nil || p.size <= 0 {
+		return nil, errorutils.CheckErrorf("the page size set in the REST API path must be a positive number")
+	}
+	if p.step == -1 {
+		// Offset based pages move by the page size.
+		p.step = p.size
+	}
+	if query.Has(p.pageParam) {
+		if p.page, err = strconv.Atoi(query.Get(p.pageParam)); err != nil {
+			return nil, errorutils.CheckErrorf("the '%s' query parameter must be a number", p.pageParam)
+		}
+	} else if p.pageParam == "page_num" {
+		p.page = 1
+	}
+	return p, nil
+}
+
+func (p *pager) currentUrl() string {
+	query := p.requestUrl.Query()
+	query.Set(p.pageParam, strconv.Itoa(p.page))
+	pageUrl := *p.requestUrl
+	pageUrl.RawQuery = query.Encode()
+	return pageUrl.String()
+}
+
+func (p *pager) next() {
+	p.page += p.step
+}
+
+// Returns the decoded page and its items.
+// A page is either an array of items or an object with an array field holding the items.
+func parsePage(content []byte) (page interface{}, items []interface{}, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err = decoder.Decode(&page); err != nil {
+		return nil, nil, errorutils.CheckErrorf("pagination is supported for JSON responses only: %s", err.Error())
+	}
+	switch typedPage := page.(type) {
+	case []interface{}:
+		return page, typedPage, nil
+	case map[string]interface{}:
+		if field := getItemsField(typedPage); field != "" {
+			return page, typedPage[field].([]interface{}), nil
+		}
+	}
+	return nil, nil, errorutils.CheckErrorf("pagination is not supported for this REST API, since its response does not include an array of results")
+}
+
+// Returns the name of the array field holding the items of the page, or an empty string if not found.
+func getItemsField(page map[string]interface{}) string {
+	for _, field := range pageItemsFields {
+		if _, ok := page[field].([]interface{}); ok {
+			return field
+		}
+	}
+	// Fallback to the only array field of the page, if exists.
+	itemsField := ""
+	for field, value := range page {
+		if _, ok := value.([]interface{}); ok {
+			if itemsField != "" {
+				return ""
+			}
+			itemsField = field
+		}
+	}
+	return itemsField
+}
+
+// Returns the first page, with its items replaced by the items of all pages.
+func mergePages(firstPage interface{}, allItems []interface{}) interface{} {
+	if allItems == nil {
+		allItems = []interface{}{}
+	}
+	if object, ok := firstPage.(map[string]interface{}); ok {
+		object[getItemsField(object)] = allItems
+		return object
+	}
+	return allItems
+}
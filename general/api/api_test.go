@@ -0,0 +1,148 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetServiceUrl(t *testing.T) {
+	serverDetails := &config.ServerDetails{
+		Url:            "https://acme.jfrog.io",
+		ArtifactoryUrl: "https://rt.acme.io/artifactory/",
+		XrayUrl:        "https://xray.acme.io",
+	}
+	testRuns := []struct {
+		service     string
+		expectedUrl string
+	}{
+		{Platform, "https://acme.jfrog.io/"},
+		{Artifactory, "https://rt.acme.io/artifactory/"},
+		{Xray, "https://xray.acme.io/"},
+		{Distribution, "https://acme.jfrog.io/distribution/"},
+		{Access, "https://acme.jfrog.io/access/"},
+		{Lifecycle, "https://acme.jfrog.io/lifecycle/"},
+		{Pipelines, "https://acme.jfrog.io/pipelines/"},
+		{MissionControl, "https://acme.jfrog.io/mc/"},
+	}
+	for _, test := range testRuns {
+		t.Run(test.service, func(t *testing.T) {
+			serviceUrl, err := GetServiceUrl(serverDetails, test.service)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedUrl, serviceUrl)
+		})
+	}
+
+	_, err := GetServiceUrl(serverDetails, "unknown")
+	assert.Error(t, err)
+	_, err = GetServiceUrl(&config.ServerDetails{ArtifactoryUrl: "https://rt.acme.io/artifactory/"}, Xray)
+	assert.Error(t, err)
+}
+
+func TestCreateCredentialsConfig(t *testing.T) {
+	assert.Equal(t, "header = \"Authorization: Bearer my-token\"\n",
+		createCredentialsConfig(&config.ServerDetails{User: "admin", AccessToken: "my-token"}))
+	assert.Equal(t, "user = \"admin:pa\\\\ss\\\"word\"\n",
+		createCredentialsConfig(&config.ServerDetails{User: "admin", Password: `pa\ss"word`}))
+	assert.Equal(t, "cert = \"/certs/client.pem\"\nkey = \"/certs/client.key\"\n",
+		createCredentialsConfig(&config.ServerDetails{ClientCertPath: "/certs/client.pem", ClientCertKeyPath: "/certs/client.key"}))
+}
+
+func TestFindUriIndex(t *testing.T) {
+	assert.Equal(t, 0, findUriIndex([]string{"api/v1/system/version"}))
+	assert.Equal(t, 2, findUriIndex([]string{"-X", "POST", "api/v1/tokens", "-d", "{}"}))
+	assert.Equal(t, 1, findUriIndex([]string{"-XPOST", "api/v1/tokens", "-v"}))
+	assert.Equal(t, 2, findUriIndex([]string{"--header", "Content-Type: application/json", "api/v1/tokens"}))
+	assert.Equal(t, -1, findUriIndex([]string{"-XGET"}))
+}
+
+func TestIsCredentialsFlagExists(t *testing.T) {
+	assert.True(t, isCredentialsFlagExists([]string{"-uadmin:password", "api/v1/tokens"}))
+	assert.True(t, isCredentialsFlagExists([]string{"api/v1/tokens", "--config", "my-config"}))
+	assert.False(t, isCredentialsFlagExists([]string{"-XPOST", "api/v1/tokens", "--upload-file", "file.json"}))
+}
+
+func TestPager(t *testing.T) {
+	pages, err := newPager("https://acme.jfrog.io/access/api/v1/tokens?limit=2")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://acme.jfrog.io/access/api/v1/tokens?limit=2&offset=0", pages.currentUrl())
+	pages.next()
+	assert.Equal(t, "https://acme.jfrog.io/access/api/v1/tokens?limit=2&offset=2", pages.currentUrl())
+
+	pages, err = newPager("https://acme.jfrog.io/xray/api/v1/violations?num_of_rows=10")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://acme.jfrog.io/xray/api/v1/violations?num_of_rows=10&page_num=1", pages.currentUrl())
+	pages.next()
+	assert.Equal(t, "https://acme.jfrog.io/xray/api/v1/violations?num_of_rows=10&page_num=2", pages.currentUrl())
+
+	_, err = newPager("https://acme.jfrog.io/access/api/v1/tokens")
+	assert.Error(t, err)
+	_, err = newPager("https://acme.jfrog.io/access/api/v1/tokens?limit=0")
+	assert.Error(t, err)
+}
+
+func TestParseAndMergePages(t *testing.T) {
+	firstPage, firstItems, err := parsePage([]byte(`{"total":3,"tokens":[{"id":1},{"id":2}]}`))
+	assert.NoError(t, err)
+	assert.Len(t, firstItems, 2)
+	_, lastItems, err := parsePage([]byte(`{"total":3,"tokens":[{"id":3}]}`))
+	assert.NoError(t, err)
+	merged, err := json.Marshal(mergePages(firstPage, append(firstItems, lastItems...)))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total":3,"tokens":[{"id":1},{"id":2},{"id":3}]}`, string(merged))
+
+	arrayPage, arrayItems, err := parsePage([]byte(`["a","b"]`))
+	assert.NoError(t, err)
+	merged, err = json.Marshal(mergePages(arrayPage, arrayItems))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["a","b"]`, string(merged))
+
+	_, _, err = parsePage([]byte(`{"first":[],"second":[]}`))
+	assert.Error(t, err)
+	_, _, err = parsePage([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestFetchPages(t *testing.T) {
+	pages, err := newPager("https://acme.jfrog.io/access/api/v1/tokens?limit=2")
+	assert.NoError(t, err)
+	var fetchedUrls []string
+	_, items, err := fetchPages(pages, func(pageUrl string, output *bytes.Buffer) error {
+		fetchedUrls = append(fetchedUrls, pageUrl)
+		output.WriteString(fmt.Sprintf(`{"tokens":[{"id":"%d-1"},{"id":"%d-2"}]}`, len(fetchedUrls), len(fetchedUrls)))
+		if len(fetchedUrls) == 3 {
+			output.Reset()
+			output.WriteString(`{"tokens":[{"id":"3-1"}]}`)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, fetchedUrls, 3)
+	assert.Len(t, items, 5)
+
+	// An API which ignores the paging parameters returns the same full page every time.
+	fetches := 0
+	_, items, err = fetchPages(pages, func(pageUrl string, output *bytes.Buffer) error {
+		fetches++
+		output.WriteString(`{"tokens":[{"id":1},{"id":2}]}`)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+	assert.Len(t, items, 2)
+
+	// An API which always returns a new full page.
+	fetches = 0
+	_, items, err = fetchPages(pages, func(pageUrl string, output *bytes.Buffer) error {
+		fetches++
+		output.WriteString(fmt.Sprintf(`{"tokens":[{"id":%d},{"id":0}]}`, fetches))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, maxPages, fetches)
+	assert.Len(t, items, 2*maxPages)
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/cliutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/urfave/cli"
+)
+
+func ApiCmd(c *cli.Context) error {
+	if show, err := cliutils.ShowCmdHelpIfNeeded(c, c.Args()); show || err != nil {
+		return err
+	}
+	// Flag parsing is skipped for this command, so that the cUrl arguments are passed as is.
+	// The JFrog CLI flags are therefore extracted from the arguments.
+	args, serverId, err := coreutils.ExtractServerIdFromCommand(cliutils.ExtractCommand(c))
+	if err != nil {
+		return err
+	}
+	args, paginate, err := extractBoolFlag(args, "--paginate")
+	if err != nil {
+		return err
+	}
+	args, raw, err := extractBoolFlag(args, "--raw")
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	serverDetails, err := coreConfig.GetSpecificConfig(serverId, true, true)
+	if err != nil {
+		return err
+	}
+	if err = credentialhelper.ApplyCredentials(serverDetails); err != nil {
+		return err
+	}
+	apiCmd := NewApiCommand().SetServerDetails(serverDetails).SetService(args[0]).SetArguments(args[1:]).SetPaginate(paginate).SetRaw(raw)
+	return commands.Exec(apiCmd)
+}
+
+func extractBoolFlag(args []string, flagName string) (cleanArgs []string, value bool, err error) {
+	cleanArgs = append([]string(nil), args...)
+	flagIndex, value, err := coreutils.FindBooleanFlag(flagName, cleanArgs)
+	if err != nil {
+		return
+	}
+	// Since boolean flag might appear as --flag or --flag=value, the value index is the same as the flag index.
+	coreutils.RemoveFlagFromCommand(&cleanArgs, flagIndex, flagIndex)
+	return
+}
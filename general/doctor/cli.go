@@ -0,0 +1,41 @@
+package doctor
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/cliutils"
+	"github.com/urfave/cli"
+)
+
+func DoctorCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
+	if err != nil {
+		return err
+	}
+	doctorCmd := NewDoctorCommand().SetCliVersion(cliutils.GetVersion())
+	// The refreshable tokens are kept, to check whether expired tokens are refreshed automatically.
+	if serverId := c.String("server-id"); serverId != "" {
+		serverDetails, err := coreConfig.GetSpecificConfig(serverId, false, false)
+		if err != nil {
+			return err
+		}
+		doctorCmd.SetServerDetails(serverDetails)
+	} else {
+		// Without a server ID, the service URLs of all the configured servers are checked.
+		serversDetails, err := coreConfig.GetAllServersConfigs()
+		if err != nil {
+			return err
+		}
+		doctorCmd.SetServersDetails(serversDetails)
+	}
+	if err = commands.Exec(doctorCmd); err != nil {
+		return err
+	}
+	if err = PrintResults(doctorCmd.Results(), outputFormat); err != nil {
+		return err
+	}
+	return GetFailuresError(doctorCmd.Results())
+}
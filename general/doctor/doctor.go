@@ -0,0 +1,151 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type Status string
+
+const (
+	Pass Status = "pass"
+	Warn Status = "warn"
+	Fail Status = "fail"
+	// The check could not run, for example since a previous check failed.
+	Skip Status = "skip"
+)
+
+// CheckResult is the result of a single diagnostic check.
+type CheckResult struct {
+	// The checked subject, such as 'environment' or the name of a service.
+	Subject string `json:"subject"`
+	Check   string `json:"check"`
+	Status  Status `json:"status"`
+	Details string `json:"details,omitempty"`
+	// A remediation hint, for checks that did not pass.
+	Hint string `json:"hint,omitempty"`
+}
+
+// DoctorCommand diagnoses the environment of the CLI and the connectivity to the configured JFrog services.
+type DoctorCommand struct {
+	// The servers to check. If empty, only the environment is checked.
+	serversDetails []*config.ServerDetails
+	// The version of the running CLI.
+	cliVersion string
+	results    []CheckResult
+}
+
+func NewDoctorCommand() *DoctorCommand {
+	return &DoctorCommand{}
+}
+
+func (dc *DoctorCommand) SetServerDetails(serverDetails *config.ServerDetails) *DoctorCommand {
+	dc.serversDetails = nil
+	if serverDetails != nil && !serverDetails.IsEmpty() {
+		dc.serversDetails = []*config.ServerDetails{serverDetails}
+	}
+	return dc
+}
+
+// SetServersDetails sets the servers to check, such as all the configured servers.
+func (dc *DoctorCommand) SetServersDetails(serversDetails []*config.ServerDetails) *DoctorCommand {
+	dc.serversDetails = serversDetails
+	return dc
+}
+
+func (dc *DoctorCommand) SetCliVersion(cliVersion string) *DoctorCommand {
+	dc.cliVersion = cliVersion
+	return dc
+}
+
+func (dc *DoctorCommand) Results() []CheckResult {
+	return dc.results
+}
+
+func (dc *DoctorCommand) ServerDetails() (*config.ServerDetails, error) {
+	if len(dc.serversDetails) == 0 {
+		return nil, nil
+	}
+	return dc.serversDetails[0], nil
+}
+
+func (dc *DoctorCommand) CommandName() string {
+	return "doctor"
+}
+
+func (dc *DoctorCommand) Run() error {
+	dc.add(checkProxyEnv()...)
+	dc.add(checkHomeDir()...)
+	dc.add(checkTempDir())
+	dc.add(checkCliVersion(dc.cliVersion))
+	dc.add(checkPlugins()...)
+	if len(dc.serversDetails) == 0 {
+		dc.add(CheckResult{Subject: environmentSubject, Check: "Server configuration", Status: Warn,
+			Details: "no server is configured", Hint: "Run 'jf c add' to configure the JFrog Platform details"})
+		return nil
+	}
+	for _, serverDetails := range dc.serversDetails {
+		dc.add(checkServer(serverDetails)...)
+	}
+	return nil
+}
+
+func (dc *DoctorCommand) add(results ...CheckResult) {
+	dc.results = append(dc.results, results...)
+}
+
+// Returns an error if any of the checks failed.
+func GetFailuresError(results []CheckResult) error {
+	failures := 0
+	for _, result := range results {
+		if result.Status == Fail {
+			failures++
+		}
+	}
+	if failures == 0 {
+		return nil
+	}
+	return errorutils.CheckErrorf("%d of %d checks failed", failures, len(results))
+}
+
+// PrintResults prints the results as a checklist grouped by subject, or as JSON.
+func PrintResults(results []CheckResult, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		if results == nil {
+			results = []CheckResult{}
+		}
+		content, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+	var lines []string
+	subject := ""
+	for _, result := range results {
+		if result.Subject != subject {
+			if subject != "" {
+				lines = append(lines, "")
+			}
+			subject = result.Subject
+			lines = append(lines, subject)
+		}
+		line := fmt.Sprintf("  [%s] %s", strings.ToUpper(string(result.Status)), result.Check)
+		if result.Details != "" {
+			line += ": " + result.Details
+		}
+		lines = append(lines, line)
+		if result.Hint != "" {
+			lines = append(lines, "         Hint: "+result.Hint)
+		}
+	}
+	log.Output(strings.Join(lines, "\n"))
+	return nil
+}
@@ -0,0 +1,385 @@
+package doctor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/auth/cert"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+)
+
+const (
+	// Certificates and tokens expiring within this period are reported.
+	expiryWarningPeriod = 30 * 24 * time.Hour
+	slowLatency         = 2 * time.Second
+	clockSkewWarning    = time.Minute
+	// Beyond this skew, time sensitive credentials such as tokens and signed URLs may be rejected.
+	clockSkewFailure = 5 * time.Minute
+	requestTimeout   = 30 * time.Second
+)
+
+type service struct {
+	name string
+	url  string
+	// An unauthenticated endpoint, used for checking the reachability of the service.
+	pingPath string
+	// An authenticated endpoint returning the version of the service, used for checking the credentials.
+	versionPath string
+}
+
+func getServices(serverDetails *config.ServerDetails) []service {
+	return []service{
+		{name: "Platform", url: serverDetails.Url},
+		{name: "Artifactory", url: serverDetails.ArtifactoryUrl, pingPath: "api/system/ping", versionPath: "api/system/version"},
+		{name: "Xray", url: serverDetails.XrayUrl, pingPath: "api/v1/system/ping", versionPath: "api/v1/system/version"},
+		{name: "Distribution", url: serverDetails.DistributionUrl, pingPath: "api/v1/system/ping", versionPath: "api/v1/system/info"},
+		{name: "Mission Control", url: serverDetails.MissionControlUrl, pingPath: "api/v1/system/ping"},
+		{name: "Pipelines", url: serverDetails.PipelinesUrl},
+		{name: "Access", url: serverDetails.AccessUrl, pingPath: "api/v1/system/ping"},
+	}
+}
+
+func checkServer(serverDetails *config.ServerDetails) (results []CheckResult) {
+	subject := fmt.Sprintf("Server '%s'", serverDetails.ServerId)
+	// The credentials returned by the credential helper or the OIDC token exchange of the server are checked like the stored ones.
+	if err := credentialhelper.ApplyCredentials(serverDetails); err != nil {
+		results = append(results, CheckResult{Subject: subject, Check: "Credential helper", Status: Fail, Details: err.Error(),
+			Hint: "Verify the credential helper or the OIDC provider of the server with 'jf c show " + serverDetails.ServerId + "'"})
+	}
+	if serverDetails.ClientCertPath != "" {
+		results = append(results, checkClientCert(subject, serverDetails))
+	}
+	if serverDetails.AccessToken != "" {
+		results = append(results, checkAccessToken(subject, serverDetails))
+	}
+	client, err := newHttpClient(serverDetails)
+	if err != nil {
+		return append(results, CheckResult{Subject: subject, Check: "HTTP client", Status: Fail, Details: err.Error(),
+			Hint: "Verify the certificates in the JFrog CLI security directory are valid PEM files"})
+	}
+	for _, svc := range getServices(serverDetails) {
+		if svc.url != "" {
+			results = append(results, checkService(client, serverDetails, svc)...)
+		}
+	}
+	return
+}
+
+func newHttpClient(serverDetails *config.ServerDetails) (*http.Client, error) {
+	certsDir, err := coreutils.GetJfrogCertsDir()
+	if err != nil {
+		return nil, err
+	}
+	transport, err := cert.GetTransportWithLoadedCert(certsDir, serverDetails.InsecureTls, &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		TLSHandshakeTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if serverDetails.ClientCertPath != "" {
+		// A failure to load the client certificate is reported by the client certificate check.
+		if certificate, err := cert.LoadCertificate(serverDetails.ClientCertPath, serverDetails.ClientCertKeyPath); err == nil {
+			transport.TLSClientConfig.Certificates = []tls.Certificate{certificate}
+		}
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   requestTimeout,
+		// Redirects are reported rather than followed, since they usually indicate a wrong URL.
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}, nil
+}
+
+func checkClientCert(subject string, serverDetails *config.ServerDetails) CheckResult {
+	result := CheckResult{Subject: subject, Check: "Client certificate"}
+	certificate, err := cert.LoadCertificate(serverDetails.ClientCertPath, serverDetails.ClientCertKeyPath)
+	if err != nil {
+		result.Status, result.Details = Fail, err.Error()
+		result.Hint = "Verify the paths of the client certificate and key with 'jf c show " + serverDetails.ServerId + "'"
+		return result
+	}
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		result.Status, result.Details = Fail, err.Error()
+		return result
+	}
+	result.Status, result.Details = checkExpiry(fmt.Sprintf("%s, subject '%s'", serverDetails.ClientCertPath, leaf.Subject.CommonName), leaf.NotAfter)
+	if result.Status != Pass {
+		result.Hint = "Renew the client certificate"
+	}
+	return result
+}
+
+type tokenPayload struct {
+	Subject        string `json:"sub,omitempty"`
+	Scope          string `json:"scp,omitempty"`
+	ExpirationTime int64  `json:"exp,omitempty"`
+}
+
+func checkAccessToken(subject string, serverDetails *config.ServerDetails) CheckResult {
+	result := CheckResult{Subject: subject, Check: "Access token"}
+	payload, err := parseAccessToken(serverDetails.AccessToken)
+	if err != nil {
+		// Reference tokens are not JWTs, so their details can't be inspected locally.
+		result.Status, result.Details = Skip, "the token details are not available, since it is not a JWT (it may be a reference token)"
+		return result
+	}
+	details := fmt.Sprintf("subject '%s', scopes '%s'", payload.Subject, payload.Scope)
+	if payload.ExpirationTime == 0 {
+		result.Status, result.Details = Pass, details+", never expires"
+		return result
+	}
+	result.Status, result.Details = checkExpiry(details, time.Unix(payload.ExpirationTime, 0))
+	if result.Status != Pass {
+		if serverDetails.ArtifactoryRefreshToken != "" || serverDetails.RefreshToken != "" {
+			// Tokens created by 'jf c add' are refreshed automatically by the next command.
+			result.Status = Pass
+			result.Details += " (refreshed automatically)"
+			return result
+		}
+		result.Hint = "Create a new access token and update the server configuration with 'jf c edit " + serverDetails.ServerId + "'"
+	}
+	return result
+}
+
+// Decodes the payload of a JWT access token, without verifying its signature.
+func parseAccessToken(token string) (*tokenPayload, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("the token is not a JWT")
+	}
+	content, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, err
+	}
+	payload := new(tokenPayload)
+	return payload, json.Unmarshal(content, payload)
+}
+
+// Returns Fail if already expired, or Warn if about to expire.
+func checkExpiry(details string, expiry time.Time) (Status, string) {
+	timeLeft := time.Until(expiry)
+	switch {
+	case timeLeft <= 0:
+		return Fail, fmt.Sprintf("%s, expired on %s", details, expiry.Format(time.RFC3339))
+	case timeLeft < expiryWarningPeriod:
+		return Warn, fmt.Sprintf("%s, expires in %d days (%s)", details, int(timeLeft.Hours()/24), expiry.Format(time.RFC3339))
+	default:
+		return Pass, fmt.Sprintf("%s, expires on %s", details, expiry.Format(time.RFC3339))
+	}
+}
+
+func checkService(client *http.Client, serverDetails *config.ServerDetails, svc service) (results []CheckResult) {
+	subject := fmt.Sprintf("%s (%s)", svc.name, svc.url)
+	serviceUrl, err := url.Parse(svc.url)
+	if err != nil || serviceUrl.Host == "" {
+		return []CheckResult{{Subject: subject, Check: "URL", Status: Fail, Details: "invalid URL",
+			Hint: "Fix the URL with 'jf c edit " + serverDetails.ServerId + "'"}}
+	}
+
+	dnsResult, proceed := checkDns(subject, serviceUrl)
+	results = append(results, dnsResult)
+	if !proceed {
+		return
+	}
+
+	pingUrl := clientutils.AddTrailingSlashIfNeeded(svc.url) + svc.pingPath
+	start := time.Now()
+	resp, err := sendGet(client, pingUrl, nil)
+	latency := time.Since(start)
+	if err != nil {
+		results = append(results, CheckResult{Subject: subject, Check: "Reachability", Status: Fail, Details: err.Error(), Hint: getConnectionHint(err)})
+		if isTlsError(err) {
+			results = append(results, CheckResult{Subject: subject, Check: "TLS", Status: Fail, Details: err.Error(),
+				Hint: "If the server uses a self-signed certificate, place its CA certificate in the JFrog CLI security 'certs' directory"})
+		}
+		return
+	}
+	results = append(results, checkReachability(subject, resp, latency), checkTls(subject, serverDetails, resp), checkClockSkew(subject, resp))
+	if svc.versionPath != "" {
+		results = append(results, checkCredentials(client, serverDetails, subject, clientutils.AddTrailingSlashIfNeeded(svc.url)+svc.versionPath))
+	}
+	return
+}
+
+func checkDns(subject string, serviceUrl *url.URL) (result CheckResult, proceed bool) {
+	result = CheckResult{Subject: subject, Check: "DNS"}
+	addresses, err := net.LookupHost(serviceUrl.Hostname())
+	if err == nil {
+		result.Status, result.Details = Pass, strings.Join(addresses, ", ")
+		return result, true
+	}
+	// When using a proxy, the host name is resolved by the proxy.
+	if proxyUrl, _ := http.ProxyFromEnvironment(&http.Request{URL: serviceUrl}); proxyUrl != nil {
+		result.Status, result.Details = Warn, fmt.Sprintf("%s, the host is expected to be resolved by the proxy %s", err.Error(), proxyUrl.Redacted())
+		return result, true
+	}
+	result.Status, result.Details = Fail, err.Error()
+	result.Hint = "Verify the host name is correct and the DNS server is reachable. If a proxy is required, set the HTTPS_PROXY environment variable"
+	return result, false
+}
+
+func checkReachability(subject string, resp *http.Response, latency time.Duration) CheckResult {
+	result := CheckResult{Subject: subject, Check: "Reachability", Status: Pass,
+		Details: fmt.Sprintf("HTTP %d in %dms", resp.StatusCode, latency.Milliseconds())}
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		result.Status, result.Hint = Fail, "The service is unhealthy. Check its status with the server administrator"
+	case resp.StatusCode >= http.StatusMultipleChoices && resp.StatusCode < http.StatusBadRequest:
+		result.Status, result.Hint = Warn, "The service redirects to "+resp.Header.Get("Location")+". Verify the configured URL is correct"
+	case latency > slowLatency:
+		result.Status, result.Hint = Warn, "The latency is high. Check the network and proxy between this machine and the server"
+	}
+	return result
+}
+
+func checkTls(subject string, serverDetails *config.ServerDetails, resp *http.Response) CheckResult {
+	result := CheckResult{Subject: subject, Check: "TLS"}
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		result.Status, result.Details = Warn, "the connection is not encrypted"
+		result.Hint = "Use an HTTPS URL to protect the credentials sent to the server"
+		return result
+	}
+	leaf := resp.TLS.PeerCertificates[0]
+	details := fmt.Sprintf("%s, certificate of '%s' issued by '%s'", tls.VersionName(resp.TLS.Version), leaf.Subject.CommonName, leaf.Issuer.CommonName)
+	result.Status, result.Details = checkExpiry(details, leaf.NotAfter)
+	if result.Status != Pass {
+		result.Hint = "Ask the server administrator to renew the server certificate"
+	}
+	if serverDetails.InsecureTls && result.Status == Pass {
+		result.Status, result.Hint = Warn, "The certificate is not verified, since insecure TLS is used"
+	}
+	return result
+}
+
+func checkClockSkew(subject string, resp *http.Response) CheckResult {
+	result := CheckResult{Subject: subject, Check: "Clock skew"}
+	serverTime, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		result.Status, result.Details = Skip, "the server did not return a valid Date header"
+		return result
+	}
+	skew := time.Since(serverTime).Round(time.Second)
+	result.Details = fmt.Sprintf("the local clock is %s ahead of the server", skew)
+	if skew < 0 {
+		result.Details = fmt.Sprintf("the local clock is %s behind the server", -skew)
+		skew = -skew
+	}
+	switch {
+	case skew > clockSkewFailure:
+		result.Status = Fail
+	case skew > clockSkewWarning:
+		result.Status = Warn
+	default:
+		result.Status = Pass
+		return result
+	}
+	result.Hint = "Synchronize the system clock, for example using NTP. Tokens and signed URLs are rejected when the clocks are not in sync"
+	return result
+}
+
+func checkCredentials(client *http.Client, serverDetails *config.ServerDetails, subject, versionUrl string) CheckResult {
+	result := CheckResult{Subject: subject, Check: "Credentials"}
+	resp, err := sendGet(client, versionUrl, serverDetails)
+	if err != nil {
+		result.Status, result.Details = Fail, err.Error()
+		return result
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		result.Status, result.Details = Pass, "authenticated"
+		if serverVersion := getVersion(resp.Body); serverVersion != "" {
+			result.Details += ", server version " + serverVersion
+		}
+	case http.StatusUnauthorized:
+		result.Status, result.Details = Fail, "the credentials were rejected (HTTP 401)"
+		result.Hint = "Update the credentials with 'jf c edit " + serverDetails.ServerId + "' or run 'jf login'"
+	case http.StatusForbidden:
+		result.Status, result.Details = Warn, "authenticated, but not permitted to read the server version (HTTP 403)"
+		result.Hint = "Verify the scopes of the token or the permissions of the user"
+	default:
+		result.Status, result.Details = Warn, fmt.Sprintf("unexpected response: HTTP %d", resp.StatusCode)
+	}
+	return result
+}
+
+// Sends a GET request. If serverDetails is not nil, its credentials are used.
+func sendGet(client *http.Client, requestUrl string, serverDetails *config.ServerDetails) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", coreutils.GetCliUserAgent())
+	if serverDetails != nil {
+		if serverDetails.AccessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+serverDetails.AccessToken)
+		} else if serverDetails.User != "" {
+			req.SetBasicAuth(serverDetails.User, serverDetails.Password)
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if serverDetails == nil {
+		// Only the headers are used.
+		_ = resp.Body.Close()
+	}
+	return resp, nil
+}
+
+// Returns the value of the 'version' field of a system version response.
+func getVersion(body io.Reader) string {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+	var response struct {
+		Version     string `json:"version"`
+		XrayVersion string `json:"xray_version"`
+	}
+	if json.Unmarshal(content, &response) != nil {
+		return ""
+	}
+	if response.Version != "" {
+		return response.Version
+	}
+	return response.XrayVersion
+}
+
+func isTlsError(err error) bool {
+	var certInvalidError x509.CertificateInvalidError
+	var unknownAuthorityError x509.UnknownAuthorityError
+	var hostnameError x509.HostnameError
+	var recordHeaderError tls.RecordHeaderError
+	return errors.As(err, &certInvalidError) || errors.As(err, &unknownAuthorityError) || errors.As(err, &hostnameError) || errors.As(err, &recordHeaderError)
+}
+
+func getConnectionHint(err error) string {
+	var netError net.Error
+	switch {
+	case isTlsError(err):
+		return "The TLS handshake failed. See the TLS check below"
+	case errors.As(err, &netError) && netError.Timeout():
+		return "The request timed out. Check the firewall and proxy settings"
+	default:
+		return "Check the URL, the firewall and the proxy settings"
+	}
+}
@@ -0,0 +1,40 @@
+package logout
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/cliutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/urfave/cli"
+)
+
+func LogoutCmd(c *cli.Context) error {
+	if c.NArg() > 1 || (c.Bool("all") && c.NArg() > 0) {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	var serverIds []string
+	switch {
+	case c.Bool("all"):
+		serverIds = commands.GetAllServerIds()
+		if len(serverIds) == 0 {
+			return errorutils.CheckErrorf("no server is configured")
+		}
+		if !cliutils.GetQuietValue(c) && !coreutils.AskYesNo("Are you sure you want to log out of all the servers?", false) {
+			return nil
+		}
+	case c.NArg() == 1:
+		serverIds = []string{c.Args()[0]}
+	default:
+		// If no server ID was given, log out of the default server.
+		defaultServer, err := coreConfig.GetDefaultServerConf()
+		if err != nil {
+			return err
+		}
+		if defaultServer == nil {
+			return errorutils.CheckErrorf("no server is configured")
+		}
+		serverIds = []string{defaultServer.ServerId}
+	}
+	return NewLogoutCommand().SetServerIds(serverIds).Run()
+}
@@ -0,0 +1,78 @@
+package logout
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/general/token"
+	pluginsutils "github.com/jfrog/jfrog-cli/plugins/commands/utils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-cli/utils/servercontext"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// LogoutCommand revokes the access tokens stored for servers, and removes the servers from the configuration.
+// A failure to revoke a token is reported, but doesn't prevent the local cleanup.
+type LogoutCommand struct {
+	serverIds []string
+}
+
+func NewLogoutCommand() *LogoutCommand {
+	return &LogoutCommand{}
+}
+
+func (lc *LogoutCommand) SetServerIds(serverIds []string) *LogoutCommand {
+	lc.serverIds = serverIds
+	return lc
+}
+
+func (lc *LogoutCommand) Run() error {
+	var servers []*config.ServerDetails
+	for _, serverId := range lc.serverIds {
+		serverDetails, err := config.GetSpecificConfig(serverId, false, false)
+		if err != nil {
+			return err
+		}
+		servers = append(servers, serverDetails)
+	}
+	var failures []string
+	for _, serverDetails := range servers {
+		if err := token.RevokeServerToken(serverDetails); err != nil {
+			log.Error(fmt.Sprintf("Failed revoking the access token of '%s': %s", serverDetails.ServerId, err.Error()))
+			failures = append(failures, serverDetails.ServerId)
+		}
+		if err := commands.NewConfigCommand(commands.Delete, serverDetails.ServerId).Run(); err != nil {
+			return err
+		}
+		if err := credentialhelper.RemoveServer(serverDetails.ServerId); err != nil {
+			return err
+		}
+		warnAboutReferences(serverDetails.ServerId)
+		log.Info(fmt.Sprintf("Logged out of '%s'.", serverDetails.ServerId))
+	}
+	credentialhelper.Invalidate()
+	if len(failures) > 0 {
+		return errorutils.CheckErrorf("the configuration was removed, but the access tokens of the following servers could not be revoked: %s. "+
+			"Revoke them in the JFrog Platform", strings.Join(failures, ", "))
+	}
+	return nil
+}
+
+// Warns about settings which still refer to the removed server, and which are not managed by the configuration.
+func warnAboutReferences(serverId string) {
+	if os.Getenv(pluginsutils.PluginsServerEnv) == serverId {
+		log.Warn(fmt.Sprintf("The %s environment variable still refers to '%s'. Unset it to install and publish plugins.", pluginsutils.PluginsServerEnv, serverId))
+	}
+	context, err := servercontext.Load()
+	if err != nil {
+		log.Debug(err.Error())
+		return
+	}
+	if context != nil && context.ServerId == serverId {
+		log.Warn(fmt.Sprintf("The context file %s still refers to '%s'.", context.Path(), serverId))
+	}
+}
@@ -0,0 +1,53 @@
+package logout
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+func createToken(payload string) string {
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".c2lnbmF0dXJl"
+}
+
+func TestLogout(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	var revoked []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/access/api/v1/tokens/token-a" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		revoked = append(revoked, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	assert.NoError(t, config.SaveServersConf([]*config.ServerDetails{
+		{ServerId: "a", Url: server.URL + "/", AccessToken: createToken(`{"sub":"jfac@01h/users/alice","jti":"token-a"}`), RefreshToken: "refresh-a", IsDefault: true},
+		{ServerId: "b", Url: server.URL + "/", AccessToken: createToken(`{"sub":"jfac@01h/users/bob","jti":"token-b"}`)},
+		{ServerId: "c", Url: server.URL + "/", User: "carol", Password: "password"},
+	}))
+	assert.NoError(t, credentialhelper.SetCredentialHelper("c", "/usr/bin/helper"))
+
+	// The server which has no token is removed without revoking anything.
+	assert.NoError(t, NewLogoutCommand().SetServerIds([]string{"a", "c"}).Run())
+	assert.Len(t, revoked, 1)
+	helper, err := credentialhelper.GetCredentialHelper("c")
+	assert.NoError(t, err)
+	assert.Empty(t, helper)
+
+	// A revocation failure is reported, but the server is still removed.
+	assert.ErrorContains(t, NewLogoutCommand().SetServerIds([]string{"b"}).Run(), "could not be revoked: b")
+	servers, err := config.GetAllServersConfigs()
+	assert.NoError(t, err)
+	assert.Empty(t, servers)
+
+	assert.Error(t, NewLogoutCommand().SetServerIds([]string{"missing"}).Run())
+}
@@ -0,0 +1,30 @@
+package supportbundle
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/utils/cliutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/urfave/cli"
+)
+
+func SupportBundleCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	// The refreshable tokens are kept, to check whether expired tokens are refreshed automatically.
+	serverDetails, err := coreConfig.GetSpecificConfig(c.String("server-id"), true, false)
+	if err != nil {
+		return err
+	}
+	supportBundleCmd := NewSupportBundleCommand().
+		SetServerDetails(serverDetails).
+		SetCliVersion(cliutils.GetVersion()).
+		SetOutputPath(c.String("output")).
+		SetUploadTarget(c.String("upload"))
+	if err = commands.Exec(supportBundleCmd); err != nil {
+		return err
+	}
+	log.Output(supportBundleCmd.OutputPath())
+	return nil
+}
@@ -0,0 +1,384 @@
+package supportbundle
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/general/doctor"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The maximal number of recent log files to include in the bundle.
+	maxLogFiles = 5
+	// Log files larger than this size are truncated, keeping their end.
+	maxLogFileSize = 10 * 1024 * 1024
+)
+
+type versionsInfo struct {
+	CliVersion string       `json:"cliVersion"`
+	GoVersion  string       `json:"goVersion"`
+	Os         string       `json:"os"`
+	Arch       string       `json:"arch"`
+	Plugins    []pluginInfo `json:"plugins"`
+	CreatedAt  time.Time    `json:"createdAt"`
+}
+
+type pluginInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// SupportBundleCommand collects the information required for troubleshooting into a single zip archive.
+// Passwords and tokens are masked in all the collected files.
+type SupportBundleCommand struct {
+	// The server to diagnose the connectivity to, and to upload the bundle to.
+	serverDetails *config.ServerDetails
+	cliVersion    string
+	outputPath    string
+	// If not empty, the bundle is uploaded to this path in Artifactory, in the form of repo/path.
+	uploadTarget string
+	// The secrets of all the configured servers, removed from the collected logs.
+	secrets []string
+}
+
+func NewSupportBundleCommand() *SupportBundleCommand {
+	return &SupportBundleCommand{}
+}
+
+func (sbc *SupportBundleCommand) SetServerDetails(serverDetails *config.ServerDetails) *SupportBundleCommand {
+	sbc.serverDetails = serverDetails
+	return sbc
+}
+
+func (sbc *SupportBundleCommand) SetCliVersion(cliVersion string) *SupportBundleCommand {
+	sbc.cliVersion = cliVersion
+	return sbc
+}
+
+func (sbc *SupportBundleCommand) SetOutputPath(outputPath string) *SupportBundleCommand {
+	sbc.outputPath = outputPath
+	return sbc
+}
+
+func (sbc *SupportBundleCommand) SetUploadTarget(uploadTarget string) *SupportBundleCommand {
+	sbc.uploadTarget = uploadTarget
+	return sbc
+}
+
+func (sbc *SupportBundleCommand) OutputPath() string {
+	return sbc.outputPath
+}
+
+func (sbc *SupportBundleCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sbc.serverDetails, nil
+}
+
+func (sbc *SupportBundleCommand) CommandName() string {
+	return "support_bundle"
+}
+
+// Returns the default name of the bundle file, which includes the current time.
+func GetDefaultOutputPath() string {
+	return "jfrog-support-bundle-" + time.Now().Format("20060102-150405") + ".zip"
+}
+
+func (sbc *SupportBundleCommand) Run() (err error) {
+	if sbc.outputPath == "" {
+		sbc.outputPath = GetDefaultOutputPath()
+	}
+	if sbc.uploadTarget != "" && (sbc.serverDetails == nil || sbc.serverDetails.GetArtifactoryUrl() == "") {
+		return errorutils.CheckErrorf("uploading the support bundle requires a configured Artifactory URL")
+	}
+	serversDetails, err := config.GetAllServersConfigs()
+	if err != nil {
+		return err
+	}
+	sbc.secrets = getConfiguredSecrets(serversDetails)
+	if sbc.serverDetails != nil {
+		sbc.secrets = append(sbc.secrets, getConfiguredSecrets([]*config.ServerDetails{sbc.serverDetails})...)
+	}
+
+	log.Info("Creating the support bundle at", sbc.outputPath+"...")
+	if err = sbc.createBundle(serversDetails); err != nil {
+		return err
+	}
+	log.Info("The support bundle was created successfully.")
+	if sbc.uploadTarget == "" {
+		return nil
+	}
+	return sbc.upload()
+}
+
+func (sbc *SupportBundleCommand) createBundle(serversDetails []*config.ServerDetails) (err error) {
+	file, err := os.Create(sbc.outputPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	archive := zip.NewWriter(file)
+	defer func() {
+		if closeErr := archive.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+
+	// A failure to collect a single entry should not fail the entire bundle, so it's recorded in the bundle instead.
+	entries := []struct {
+		name    string
+		collect func() ([]byte, error)
+	}{
+		{"versions.json", sbc.collectVersions},
+		{"config.json", func() ([]byte, error) { return toJson(maskServersConfig(serversDetails)) }},
+		{"env.txt", collectEnvVars},
+		{"build-info-dir.txt", collectBuildInfoDirListing},
+		{"doctor.json", sbc.collectDoctorResults},
+	}
+	for _, entry := range entries {
+		log.Debug("Collecting", entry.name+"...")
+		content, collectErr := entry.collect()
+		if collectErr != nil {
+			log.Warn(fmt.Sprintf("Couldn't collect %s: %s", entry.name, collectErr.Error()))
+			content = []byte("Failed collecting " + entry.name + ": " + collectErr.Error() + "\n")
+		}
+		if err = addEntry(archive, entry.name, []byte(redactText(string(content), sbc.secrets))); err != nil {
+			return err
+		}
+	}
+	return sbc.addLogs(archive)
+}
+
+func (sbc *SupportBundleCommand) collectVersions() ([]byte, error) {
+	return toJson(versionsInfo{
+		CliVersion: sbc.cliVersion,
+		GoVersion:  runtime.Version(),
+		Os:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Plugins:    getPluginsVersions(),
+		CreatedAt:  time.Now(),
+	})
+}
+
+// Returns the versions of the installed plugins, by running each plugin with the '--version' option.
+func getPluginsVersions() []pluginInfo {
+	plugins := []pluginInfo{}
+	pluginsDir, err := coreutils.GetJfrogPluginsDir()
+	if err != nil {
+		return plugins
+	}
+	entries, err := os.ReadDir(pluginsDir)
+	if err != nil {
+		// No plugins are installed.
+		return plugins
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		plugin := pluginInfo{Name: entry.Name()}
+		execPath := filepath.Join(pluginsDir, entry.Name(), coreutils.PluginsExecDirName, entry.Name())
+		if runtime.GOOS == "windows" {
+			execPath += ".exe"
+		}
+		output, err := exec.Command(execPath, "--version").Output()
+		if err != nil {
+			plugin.Error = err.Error()
+		} else {
+			plugin.Version = strings.TrimSpace(string(output))
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins
+}
+
+func collectEnvVars() ([]byte, error) {
+	return []byte(strings.Join(getEnvVars(), "\n") + "\n"), nil
+}
+
+// Lists the files of the local build-info directory, which holds the build-info collected by the CLI before it's published.
+func collectBuildInfoDirListing() ([]byte, error) {
+	buildsDir := filepath.Join(coreutils.GetCliPersistentTempDirPath(), build.BuildTempPath)
+	lines := []string{buildsDir}
+	err := filepath.WalkDir(buildsDir, func(walkedPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && walkedPath == buildsDir {
+				lines = append(lines, "(does not exist)")
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if walkedPath == buildsDir {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(buildsDir, walkedPath)
+		if err != nil {
+			return err
+		}
+		size := "-"
+		if !entry.IsDir() {
+			size = fmt.Sprint(info.Size())
+		}
+		lines = append(lines, fmt.Sprintf("%s\t%s\t%s\t%s", info.Mode(), info.ModTime().Format(time.RFC3339), size, filepath.ToSlash(relativePath)))
+		return nil
+	})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
+
+func (sbc *SupportBundleCommand) collectDoctorResults() ([]byte, error) {
+	doctorCmd := doctor.NewDoctorCommand().SetServerDetails(sbc.serverDetails).SetCliVersion(sbc.cliVersion)
+	if err := doctorCmd.Run(); err != nil {
+		return nil, err
+	}
+	results := doctorCmd.Results()
+	if results == nil {
+		results = []doctor.CheckResult{}
+	}
+	return toJson(results)
+}
+
+// Adds the most recent CLI log files to the bundle.
+func (sbc *SupportBundleCommand) addLogs(archive *zip.Writer) error {
+	logFiles, err := getRecentLogFiles(maxLogFiles)
+	if err != nil {
+		log.Warn("Couldn't collect the CLI logs: " + err.Error())
+		return nil
+	}
+	for _, logFile := range logFiles {
+		content, err := readTail(logFile, maxLogFileSize)
+		if err != nil {
+			log.Warn(fmt.Sprintf("Couldn't read the log file %s: %s", logFile, err.Error()))
+			continue
+		}
+		if err = addEntry(archive, path.Join("logs", filepath.Base(logFile)), []byte(redactText(string(content), sbc.secrets))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the paths of the most recent log files in the logs directory of the JFrog CLI home, newest first.
+func getRecentLogFiles(limit int) ([]string, error) {
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	logsDir := filepath.Join(homeDir, coreutils.JfrogLogsDirName)
+	entries, err := os.ReadDir(logsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	type logFile struct {
+		path    string
+		modTime time.Time
+	}
+	var logFiles []logFile
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		logFiles = append(logFiles, logFile{filepath.Join(logsDir, entry.Name()), info.ModTime()})
+	}
+	sort.Slice(logFiles, func(i, j int) bool {
+		return logFiles[i].modTime.After(logFiles[j].modTime)
+	})
+	var paths []string
+	for i := 0; i < len(logFiles) && i < limit; i++ {
+		paths = append(paths, logFiles[i].path)
+	}
+	return paths, nil
+}
+
+// Reads the last maxSize bytes of the file.
+func readTail(filePath string, maxSize int64) (content []byte, err error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if info.Size() > maxSize {
+		if _, err = file.Seek(-maxSize, io.SeekEnd); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	}
+	content, err = io.ReadAll(file)
+	return content, errorutils.CheckError(err)
+}
+
+func (sbc *SupportBundleCommand) upload() error {
+	servicesManager, err := credentialhelper.CreateServiceManager(sbc.serverDetails, 3, 0, false)
+	if err != nil {
+		return err
+	}
+	uploadParams := services.NewUploadParams()
+	uploadParams.Pattern = sbc.outputPath
+	uploadParams.Target = sbc.uploadTarget
+	uploadParams.Flat = true
+	log.Info("Uploading the support bundle to", sbc.uploadTarget+"...")
+	uploaded, failed, err := servicesManager.UploadFiles(uploadParams)
+	if err != nil {
+		return err
+	}
+	if failed > 0 || uploaded == 0 {
+		return errorutils.CheckErrorf("failed uploading the support bundle to %s", sbc.uploadTarget)
+	}
+	log.Info("The support bundle was uploaded successfully.")
+	return nil
+}
+
+func addEntry(archive *zip.Writer, name string, content []byte) error {
+	writer, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err = writer.Write(content)
+	return errorutils.CheckError(err)
+}
+
+func toJson(value interface{}) ([]byte, error) {
+	content, err := json.MarshalIndent(value, "", "  ")
+	return content, errorutils.CheckError(err)
+}
@@ -5,8 +5,13 @@ import (
 	"fmt"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	corecommon "github.com/jfrog/jfrog-cli-core/v2/docs/common"
 	generic "github.com/jfrog/jfrog-cli-core/v2/general/token"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli/docs/common"
+	tokenlist "github.com/jfrog/jfrog-cli/docs/general/token/list"
+	tokenrevoke "github.com/jfrog/jfrog-cli/docs/general/token/revoke"
+	tokenrotate "github.com/jfrog/jfrog-cli/docs/general/token/rotate"
 	"github.com/jfrog/jfrog-cli/utils/accesstoken"
 	"github.com/jfrog/jfrog-cli/utils/cliutils"
 	clientUtils "github.com/jfrog/jfrog-client-go/utils"
@@ -16,6 +21,40 @@ import (
 	"strconv"
 )
 
+func GetCommands() []cli.Command {
+	return cliutils.GetSortedCommands(cli.CommandsByName{
+		{
+			Name:         "list",
+			Aliases:      []string{"ls"},
+			Flags:        cliutils.GetCommandFlags(cliutils.AccessTokenList),
+			Usage:        tokenlist.GetDescription(),
+			HelpName:     corecommon.CreateUsage("access-token list", tokenlist.GetDescription(), tokenlist.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       accessTokenListCmd,
+		},
+		{
+			Name:         "revoke",
+			Flags:        cliutils.GetCommandFlags(cliutils.AccessTokenRevoke),
+			Usage:        tokenrevoke.GetDescription(),
+			HelpName:     corecommon.CreateUsage("access-token revoke", tokenrevoke.GetDescription(), tokenrevoke.Usage),
+			UsageText:    tokenrevoke.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       accessTokenRevokeCmd,
+		},
+		{
+			Name:         "rotate",
+			Flags:        cliutils.GetCommandFlags(cliutils.AccessTokenRotate),
+			Usage:        tokenrotate.GetDescription(),
+			HelpName:     corecommon.CreateUsage("access-token rotate", tokenrotate.GetDescription(), tokenrotate.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Action:       accessTokenRotateCmd,
+		},
+	})
+}
+
 func AccessTokenCreateCmd(c *cli.Context) error {
 	if c.NArg() > 1 {
 		return cliutils.WrongNumberOfArgumentsHandler(c)
@@ -67,6 +106,56 @@ func AccessTokenCreateCmd(c *cli.Context) error {
 	return nil
 }
 
+func accessTokenListCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	outputFormat, err := cliutils.GetTableOrJsonOutputFormat(c)
+	if err != nil {
+		return err
+	}
+	serverDetails, err := createPlatformDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	accessTokenListCmd := NewAccessTokenListCommand()
+	accessTokenListCmd.SetServerDetails(serverDetails).SetUsername(c.String("username"))
+	if err = commands.Exec(accessTokenListCmd); err != nil {
+		return err
+	}
+	return PrintTokens(accessTokenListCmd.Tokens(), outputFormat)
+}
+
+func accessTokenRevokeCmd(c *cli.Context) error {
+	tokenFile := c.String("token-file")
+	if (c.NArg() == 0) == (tokenFile == "") {
+		return cliutils.PrintHelpAndReturnError("either a token ID or the '--token-file' option should be provided", c)
+	}
+	if c.NArg() > 1 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	serverDetails, err := createPlatformDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	accessTokenRevokeCmd := NewAccessTokenRevokeCommand()
+	accessTokenRevokeCmd.SetServerDetails(serverDetails).SetTokenId(c.Args().Get(0)).SetTokenFile(tokenFile)
+	return commands.Exec(accessTokenRevokeCmd)
+}
+
+func accessTokenRotateCmd(c *cli.Context) error {
+	if c.NArg() != 0 {
+		return cliutils.WrongNumberOfArgumentsHandler(c)
+	}
+	serverDetails, err := createPlatformDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	accessTokenRotateCmd := NewAccessTokenRotateCommand()
+	accessTokenRotateCmd.SetServerDetails(serverDetails)
+	return commands.Exec(accessTokenRotateCmd)
+}
+
 func createPlatformDetailsByFlags(c *cli.Context) (*coreConfig.ServerDetails, error) {
 	platformDetails, err := cliutils.CreateServerDetailsWithConfigOffer(c, true, commonCliUtils.Platform)
 	if err != nil {
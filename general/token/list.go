@@ -0,0 +1,108 @@
+package token
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// AccessTokenListCommand lists the access tokens.
+type AccessTokenListCommand struct {
+	serverDetails *config.ServerDetails
+	// If set, only the tokens of this user are listed.
+	username string
+	tokens   []TokenInfo
+}
+
+func NewAccessTokenListCommand() *AccessTokenListCommand {
+	return &AccessTokenListCommand{}
+}
+
+func (atl *AccessTokenListCommand) SetServerDetails(serverDetails *config.ServerDetails) *AccessTokenListCommand {
+	atl.serverDetails = serverDetails
+	return atl
+}
+
+func (atl *AccessTokenListCommand) SetUsername(username string) *AccessTokenListCommand {
+	atl.username = username
+	return atl
+}
+
+func (atl *AccessTokenListCommand) Tokens() []TokenInfo {
+	return atl.tokens
+}
+
+func (atl *AccessTokenListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return atl.serverDetails, nil
+}
+
+func (atl *AccessTokenListCommand) CommandName() string {
+	return "access_token_list"
+}
+
+func (atl *AccessTokenListCommand) Run() error {
+	tokens, err := getTokens(atl.serverDetails)
+	if err != nil {
+		return err
+	}
+	atl.tokens = filterTokens(tokens, atl.username)
+	return nil
+}
+
+func filterTokens(tokens []TokenInfo, username string) []TokenInfo {
+	filtered := []TokenInfo{}
+	for _, token := range tokens {
+		if username == "" || token.Username() == username {
+			filtered = append(filtered, token)
+		}
+	}
+	return filtered
+}
+
+type tokenRow struct {
+	TokenId     string `col-name:"Token ID"`
+	Subject     string `col-name:"Subject"`
+	Description string `col-name:"Description"`
+	Scope       string `col-name:"Scope"`
+	IssuedAt    string `col-name:"Issued At"`
+	Expiry      string `col-name:"Expiry"`
+	LastUsed    string `col-name:"Last Used"`
+}
+
+// PrintTokens prints the access tokens as a table or as JSON.
+func PrintTokens(tokens []TokenInfo, outputFormat format.OutputFormat) error {
+	if outputFormat == format.Json {
+		if tokens == nil {
+			tokens = []TokenInfo{}
+		}
+		content, err := json.Marshal(tokens)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(clientutils.IndentJson(content))
+		return nil
+	}
+	var rows []tokenRow
+	for _, token := range tokens {
+		row := tokenRow{TokenId: token.TokenId, Subject: token.Subject, Description: token.Description, Scope: token.Scope,
+			IssuedAt: formatTimestamp(token.IssuedAt), Expiry: formatTimestamp(token.Expiry), LastUsed: formatTimestamp(token.LastUsed)}
+		if token.Expiry == 0 {
+			row.Expiry = "never"
+		}
+		rows = append(rows, row)
+	}
+	return coreutils.PrintTable(rows, "Access Tokens", "No access tokens were found", false)
+}
+
+func formatTimestamp(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
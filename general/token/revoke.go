@@ -0,0 +1,129 @@
+package token
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// AccessTokenRevokeCommand revokes an access token, identified by its ID or by the token itself.
+type AccessTokenRevokeCommand struct {
+	serverDetails *config.ServerDetails
+	tokenId       string
+	// Path to a file holding the token to revoke.
+	tokenFile string
+}
+
+func NewAccessTokenRevokeCommand() *AccessTokenRevokeCommand {
+	return &AccessTokenRevokeCommand{}
+}
+
+func (atr *AccessTokenRevokeCommand) SetServerDetails(serverDetails *config.ServerDetails) *AccessTokenRevokeCommand {
+	atr.serverDetails = serverDetails
+	return atr
+}
+
+func (atr *AccessTokenRevokeCommand) SetTokenId(tokenId string) *AccessTokenRevokeCommand {
+	atr.tokenId = tokenId
+	return atr
+}
+
+func (atr *AccessTokenRevokeCommand) SetTokenFile(tokenFile string) *AccessTokenRevokeCommand {
+	atr.tokenFile = tokenFile
+	return atr
+}
+
+func (atr *AccessTokenRevokeCommand) ServerDetails() (*config.ServerDetails, error) {
+	return atr.serverDetails, nil
+}
+
+func (atr *AccessTokenRevokeCommand) CommandName() string {
+	return "access_token_revoke"
+}
+
+func (atr *AccessTokenRevokeCommand) Run() error {
+	tokenId := atr.tokenId
+	if atr.tokenFile != "" {
+		content, err := os.ReadFile(atr.tokenFile)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		claims, err := parseTokenClaims(strings.TrimSpace(string(content)))
+		if err != nil {
+			return err
+		}
+		tokenId = claims.TokenId
+	}
+	if tokenId == "" {
+		return errorutils.CheckErrorf("the ID of the token to revoke is missing")
+	}
+	if err := revokeToken(atr.serverDetails, tokenId); err != nil {
+		return err
+	}
+	log.Info("The access token " + tokenId + " was revoked.")
+	return nil
+}
+
+// RevokeServerToken revokes the access token stored for the server. Revoking the token also revokes the refresh token which was issued with it.
+// If the token expired, it can't authenticate its revocation. It's therefore refreshed first if the server has a refresh token,
+// and the refreshed token is revoked, so that the refresh token doesn't stay valid.
+func RevokeServerToken(serverDetails *config.ServerDetails) error {
+	if serverDetails.AccessToken == "" {
+		log.Debug(fmt.Sprintf("No access token is stored for '%s'. There is nothing to revoke.", serverDetails.ServerId))
+		return nil
+	}
+	claims, err := parseTokenClaims(serverDetails.AccessToken)
+	if err != nil {
+		return errorutils.CheckErrorf("the access token can't be identified: %s", err.Error())
+	}
+	// The token is not refreshed by the client while it is being revoked.
+	revocationDetails := *serverDetails
+	revocationDetails.RefreshToken = ""
+	revocationDetails.ArtifactoryRefreshToken = ""
+	revocationDetails.ArtifactoryTokenRefreshInterval = coreutils.TokenRefreshDisabled
+	if claims.isExpired(time.Now()) {
+		if serverDetails.RefreshToken == "" {
+			log.Info(fmt.Sprintf("The access token of '%s' already expired.", serverDetails.ServerId))
+			return nil
+		}
+		log.Debug(fmt.Sprintf("The access token of '%s' expired. Refreshing it, to revoke its refresh token...", serverDetails.ServerId))
+		if revocationDetails.AccessToken, err = refreshToken(&revocationDetails, serverDetails.RefreshToken); err != nil {
+			return errorutils.CheckErrorf("the access token expired, and refreshing it failed: %s", err.Error())
+		}
+		if claims, err = parseTokenClaims(revocationDetails.AccessToken); err != nil {
+			return errorutils.CheckErrorf("the refreshed access token can't be identified: %s", err.Error())
+		}
+	}
+	if err = revokeToken(&revocationDetails, claims.TokenId); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("The access token of '%s' was revoked.", serverDetails.ServerId))
+	return nil
+}
+
+// Exchanges the access token of the server details and the refresh token for a new access token. Returns the new access token.
+func refreshToken(serverDetails *config.ServerDetails, refreshToken string) (string, error) {
+	servicesManager, err := rtUtils.CreateAccessServiceManager(serverDetails, false)
+	if err != nil {
+		return "", err
+	}
+	params := services.CreateTokenParams{}
+	params.AccessToken = serverDetails.AccessToken
+	params.RefreshToken = refreshToken
+	refreshed, err := servicesManager.RefreshAccessToken(params)
+	if err != nil {
+		return "", err
+	}
+	if refreshed.AccessToken == "" {
+		return "", errorutils.CheckErrorf("the response of the token refresh doesn't include a token")
+	}
+	return refreshed.AccessToken, nil
+}
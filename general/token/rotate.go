@@ -0,0 +1,175 @@
+package token
+
+import (
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/lock"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const rotatedTokenDescription = "Rotated by JFrog CLI"
+
+// AccessTokenRotateCommand replaces the access token of a configured server.
+// A new token is created with the same subject, scope, audience and lifetime as the current one. The server configuration is
+// then updated with the new token, and only after that the current token is revoked.
+type AccessTokenRotateCommand struct {
+	serverDetails *config.ServerDetails
+	// The ID of the revoked token.
+	revokedTokenId string
+}
+
+func NewAccessTokenRotateCommand() *AccessTokenRotateCommand {
+	return &AccessTokenRotateCommand{}
+}
+
+func (atr *AccessTokenRotateCommand) SetServerDetails(serverDetails *config.ServerDetails) *AccessTokenRotateCommand {
+	atr.serverDetails = serverDetails
+	return atr
+}
+
+func (atr *AccessTokenRotateCommand) RevokedTokenId() string {
+	return atr.revokedTokenId
+}
+
+func (atr *AccessTokenRotateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return atr.serverDetails, nil
+}
+
+func (atr *AccessTokenRotateCommand) CommandName() string {
+	return "access_token_rotate"
+}
+
+func (atr *AccessTokenRotateCommand) Run() error {
+	if atr.serverDetails.ServerId == "" {
+		return errorutils.CheckErrorf("rotating an access token requires a configured server. Use the --server-id option")
+	}
+	// The token of such a server isn't stored in the config, so it can't be replaced there.
+	hasProvider, err := credentialhelper.HasCredentialsProvider(atr.serverDetails.ServerId)
+	if err != nil {
+		return err
+	}
+	if hasProvider {
+		return errorutils.CheckErrorf("the credentials of the server '%s' are provided by a credential helper or an OIDC token exchange, and therefore its access token can't be rotated", atr.serverDetails.ServerId)
+	}
+	currentToken := atr.serverDetails.AccessToken
+	if currentToken == "" {
+		return errorutils.CheckErrorf("the server '%s' is not configured with an access token", atr.serverDetails.ServerId)
+	}
+	claims, err := parseTokenClaims(currentToken)
+	if err != nil {
+		return err
+	}
+	username := claims.username()
+	if username == "" {
+		return errorutils.CheckErrorf("the access token of the server '%s' wasn't issued for a user, and therefore can't be rotated", atr.serverDetails.ServerId)
+	}
+
+	newToken, err := atr.createToken(claims, username)
+	if err != nil {
+		return err
+	}
+	if err = updateServerToken(atr.serverDetails.ServerId, currentToken, newToken); err != nil {
+		// The new token wasn't saved, so it's revoked to avoid leaving an unused active token.
+		return atr.revokeUnsavedToken(newToken, err)
+	}
+	log.Info("The access token of the server '" + atr.serverDetails.ServerId + "' was replaced.")
+
+	// The old token is revoked using the new one, to make sure the new token works.
+	newServerDetails := *atr.serverDetails
+	newServerDetails.AccessToken = newToken.AccessToken
+	newServerDetails.RefreshToken = newToken.RefreshToken
+	if err = revokeToken(&newServerDetails, claims.TokenId); err != nil {
+		return errorutils.CheckErrorf("the new access token was saved, but revoking the old token %s failed. "+
+			"Revoke it using 'jf access-token revoke %s': %s", claims.TokenId, claims.TokenId, err.Error())
+	}
+	atr.revokedTokenId = claims.TokenId
+	log.Info("The old access token " + claims.TokenId + " was revoked.")
+	return nil
+}
+
+// Revokes the new token, which failed to be saved with saveErr, using the current token. Returns the error to report.
+func (atr *AccessTokenRotateCommand) revokeUnsavedToken(newToken auth.CreateTokenResponseData, saveErr error) error {
+	tokenId := newToken.TokenId
+	if tokenId == "" {
+		if claims, err := parseTokenClaims(newToken.AccessToken); err == nil {
+			tokenId = claims.TokenId
+		}
+	}
+	if tokenId == "" {
+		return errorutils.CheckErrorf("the new access token wasn't saved, and its ID is unknown, so it wasn't revoked: %s", saveErr.Error())
+	}
+	if err := revokeToken(atr.serverDetails, tokenId); err != nil {
+		return errorutils.CheckErrorf("the new access token %s wasn't saved: %s\nRevoking it failed as well, so revoke it using 'jf access-token revoke %s': %s",
+			tokenId, saveErr.Error(), tokenId, err.Error())
+	}
+	log.Info("The new access token " + tokenId + " wasn't saved, so it was revoked.")
+	return saveErr
+}
+
+func (atr *AccessTokenRotateCommand) createToken(claims *tokenClaims, username string) (auth.CreateTokenResponseData, error) {
+	servicesManager, err := rtUtils.CreateAccessServiceManager(atr.serverDetails, false)
+	if err != nil {
+		return auth.CreateTokenResponseData{}, err
+	}
+	lifetime := claims.lifetime()
+	refreshable := atr.serverDetails.RefreshToken != ""
+	includeReferenceToken := false
+	params := services.CreateTokenParams{}
+	params.Username = username
+	params.Scope = claims.Scope
+	params.ExpiresIn = &lifetime
+	params.Refreshable = &refreshable
+	params.Audience = claims.audience()
+	params.Description = rotatedTokenDescription
+	params.IncludeReferenceToken = &includeReferenceToken
+	newToken, err := servicesManager.CreateAccessToken(params)
+	if err != nil {
+		return auth.CreateTokenResponseData{}, err
+	}
+	if newToken.AccessToken == "" {
+		return auth.CreateTokenResponseData{}, errorutils.CheckErrorf("the response of the token creation doesn't include a token")
+	}
+	return newToken, nil
+}
+
+// Replaces the access token of the server in the configuration, while the configuration is locked against changes by other processes.
+// If the token was changed since it was read, for example by a token refresh, the configuration isn't changed.
+func updateServerToken(serverId, currentToken string, newToken auth.CreateTokenResponseData) (err error) {
+	lockDirPath, err := coreutils.GetJfrogConfigLockDir()
+	if err != nil {
+		return
+	}
+	unlockFunc, err := lock.CreateLock(lockDirPath)
+	// Defer the lockFile.Unlock() function before throwing a possible error to avoid deadlock situations.
+	defer func() {
+		e := unlockFunc()
+		if err == nil {
+			err = e
+		}
+	}()
+	if err != nil {
+		return
+	}
+	serversConfigs, err := config.GetAllServersConfigs()
+	if err != nil {
+		return
+	}
+	for _, serverConfig := range serversConfigs {
+		if serverConfig.ServerId != serverId {
+			continue
+		}
+		if serverConfig.AccessToken != currentToken {
+			return errorutils.CheckErrorf("the access token of the server '%s' was changed by another process during the rotation. "+
+				"The new token %s was not saved", serverId, newToken.TokenId)
+		}
+		serverConfig.AccessToken = newToken.AccessToken
+		serverConfig.RefreshToken = newToken.RefreshToken
+		return config.SaveServersConf(serversConfigs)
+	}
+	return errorutils.CheckErrorf("the server '%s' doesn't exist", serverId)
+}
@@ -0,0 +1,155 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const tokensApi = "api/v1/tokens"
+
+// TokenInfo describes an access token, as listed by JFrog Access. The token itself is never returned.
+type TokenInfo struct {
+	TokenId     string `json:"token_id"`
+	Subject     string `json:"subject"`
+	Description string `json:"description,omitempty"`
+	Scope       string `json:"scope,omitempty"`
+	// Unix timestamps, in seconds.
+	IssuedAt    int64 `json:"issued_at,omitempty"`
+	Expiry      int64 `json:"expiry,omitempty"`
+	LastUsed    int64 `json:"last_used,omitempty"`
+	Refreshable bool  `json:"refreshable"`
+}
+
+// Username returns the name of the user the token was issued for, or an empty string if the subject isn't a user.
+func (ti *TokenInfo) Username() string {
+	return getSubjectUsername(ti.Subject)
+}
+
+// Subjects of user tokens end with '/users/<username>'.
+func getSubjectUsername(subject string) string {
+	index := strings.LastIndex(subject, "/users/")
+	if index < 0 {
+		return ""
+	}
+	return subject[index+len("/users/"):]
+}
+
+// tokenClaims are the claims of an access token which are required to rotate or revoke it.
+type tokenClaims struct {
+	Subject   string `json:"sub"`
+	Scope     string `json:"scp"`
+	TokenId   string `json:"jti"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	// A string or an array of strings.
+	Audience json.RawMessage `json:"aud"`
+}
+
+// Reads the claims of a JWT access token. Reference tokens can't be read, since they hold no claims.
+func parseTokenClaims(token string) (*tokenClaims, error) {
+	tokenParts := strings.Split(strings.TrimSpace(token), ".")
+	if len(tokenParts) != 3 {
+		return nil, errorutils.CheckErrorf("the access token is not a JWT. Reference tokens are not supported")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tokenParts[1], "="))
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to decode the access token: %s", err.Error())
+	}
+	claims := new(tokenClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, errorutils.CheckErrorf("failed to read the claims of the access token: %s", err.Error())
+	}
+	if claims.TokenId == "" {
+		return nil, errorutils.CheckErrorf("the access token has no ID")
+	}
+	return claims, nil
+}
+
+func (tc *tokenClaims) username() string {
+	return getSubjectUsername(tc.Subject)
+}
+
+// Returns true if the token expired by the provided time. A token without an expiry never expires.
+func (tc *tokenClaims) isExpired(now time.Time) bool {
+	return tc.ExpiresAt != 0 && tc.ExpiresAt <= now.Unix()
+}
+
+// Returns the number of seconds the token is valid for, from the time it was issued. Zero means that it never expires.
+func (tc *tokenClaims) lifetime() uint {
+	if tc.ExpiresAt == 0 || tc.ExpiresAt <= tc.IssuedAt {
+		return 0
+	}
+	return uint(tc.ExpiresAt - tc.IssuedAt)
+}
+
+// Returns the audience as a space-separated list, as expected when creating a token.
+func (tc *tokenClaims) audience() string {
+	if len(tc.Audience) == 0 {
+		return ""
+	}
+	var audience string
+	if json.Unmarshal(tc.Audience, &audience) == nil {
+		return audience
+	}
+	var audiences []string
+	if json.Unmarshal(tc.Audience, &audiences) == nil {
+		return strings.Join(audiences, " ")
+	}
+	return ""
+}
+
+// Lists the access tokens visible to the authenticated user. Administrators see the tokens of all users.
+func getTokens(serverDetails *config.ServerDetails) ([]TokenInfo, error) {
+	body, err := sendAccessRequest(serverDetails, http.MethodGet, tokensApi)
+	if err != nil {
+		return nil, err
+	}
+	var response struct {
+		Tokens []TokenInfo `json:"tokens"`
+	}
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return response.Tokens, nil
+}
+
+func revokeToken(serverDetails *config.ServerDetails, tokenId string) error {
+	_, err := sendAccessRequest(serverDetails, http.MethodDelete, tokensApi+"/"+url.PathEscape(tokenId))
+	return err
+}
+
+func sendAccessRequest(serverDetails *config.ServerDetails, method, restApi string) ([]byte, error) {
+	servicesManager, err := rtUtils.CreateAccessServiceManager(serverDetails, false)
+	if err != nil {
+		return nil, err
+	}
+	accessDetails, err := serverDetails.CreateAccessAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	httpDetails := accessDetails.CreateHttpClientDetails()
+	requestUrl := accessDetails.GetUrl() + restApi
+	var resp *http.Response
+	var body []byte
+	switch method {
+	case http.MethodDelete:
+		resp, body, err = servicesManager.Client().SendDelete(requestUrl, nil, &httpDetails)
+	default:
+		resp, body, _, err = servicesManager.Client().SendGet(requestUrl, true, &httpDetails)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
@@ -0,0 +1,144 @@
+package token
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func createToken(payload string) string {
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".c2lnbmF0dXJl"
+}
+
+func TestParseTokenClaims(t *testing.T) {
+	token := createToken(`{"sub":"jfac@01h/users/alice","scp":"applied-permissions/user","aud":["*@*"],"iat":1700000000,"exp":1707776000,"jti":"b7e1c0f4"}`)
+	claims, err := parseTokenClaims(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", claims.username())
+	assert.Equal(t, "applied-permissions/user", claims.Scope)
+	assert.Equal(t, "b7e1c0f4", claims.TokenId)
+	assert.Equal(t, uint(90*24*60*60), claims.lifetime())
+	assert.Equal(t, "*@*", claims.audience())
+
+	// A token which never expires, with a single audience.
+	claims, err = parseTokenClaims(createToken(`{"sub":"jfrt@01h/users/bob","aud":"jfrt@*","iat":1700000000,"jti":"a1"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), claims.lifetime())
+	assert.Equal(t, "jfrt@*", claims.audience())
+
+	_, err = parseTokenClaims("cmVmdGtuOjAxOjE3MzQ1Njc4OTA6YWJjZGVm")
+	assert.ErrorContains(t, err, "not a JWT")
+	_, err = parseTokenClaims(createToken(`{"sub":"jfac@01h/users/alice"}`))
+	assert.ErrorContains(t, err, "has no ID")
+}
+
+func TestFilterTokens(t *testing.T) {
+	tokens := []TokenInfo{
+		{TokenId: "1", Subject: "jfac@01h/users/alice"},
+		{TokenId: "2", Subject: "jfac@01h/users/bob"},
+		{TokenId: "3", Subject: "jfrt@01h"},
+	}
+	assert.Len(t, filterTokens(tokens, ""), 3)
+	filtered := filterTokens(tokens, "bob")
+	assert.Len(t, filtered, 1)
+	assert.Equal(t, "2", filtered[0].TokenId)
+	assert.Empty(t, filterTokens(tokens, "carol"))
+}
+
+func TestUpdateServerToken(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	assert.NoError(t, config.SaveServersConf([]*config.ServerDetails{
+		{ServerId: "prod", Url: "https://acme.jfrog.io/", AccessToken: "old-token"},
+		{ServerId: "dev", Url: "https://dev.jfrog.io/", AccessToken: "dev-token"},
+	}))
+	newToken := auth.CreateTokenResponseData{CommonTokenParams: auth.CommonTokenParams{AccessToken: "new-token", RefreshToken: "refresh"}}
+	assert.NoError(t, updateServerToken("prod", "old-token", newToken))
+
+	serverDetails, err := config.GetSpecificConfig("prod", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "new-token", serverDetails.AccessToken)
+	assert.Equal(t, "refresh", serverDetails.RefreshToken)
+	serverDetails, err = config.GetSpecificConfig("dev", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "dev-token", serverDetails.AccessToken)
+
+	// The token was changed since it was read.
+	assert.ErrorContains(t, updateServerToken("prod", "old-token", newToken), "changed by another process")
+	assert.ErrorContains(t, updateServerToken("missing", "old-token", newToken), "doesn't exist")
+}
+
+func TestRevokeUnsavedToken(t *testing.T) {
+	var revoked []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			revoked = append(revoked, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rotateCmd := NewAccessTokenRotateCommand().SetServerDetails(&config.ServerDetails{ServerId: "prod", Url: server.URL + "/", AccessUrl: server.URL + "/access/", AccessToken: "old-token"})
+	saveErr := errors.New("changed by another process")
+	newToken := auth.CreateTokenResponseData{CommonTokenParams: auth.CommonTokenParams{AccessToken: createToken(`{"jti":"new-id"}`)}}
+	assert.Equal(t, saveErr, rotateCmd.revokeUnsavedToken(newToken, saveErr))
+	assert.Equal(t, []string{"/access/api/v1/tokens/new-id"}, revoked)
+}
+
+func TestRevokeUnsavedTokenFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	rotateCmd := NewAccessTokenRotateCommand().SetServerDetails(&config.ServerDetails{ServerId: "prod", Url: server.URL + "/", AccessUrl: server.URL + "/access/", AccessToken: "old-token"})
+	newToken := auth.CreateTokenResponseData{CommonTokenParams: auth.CommonTokenParams{AccessToken: createToken(`{"jti":"new-id"}`)}}
+	err := rotateCmd.revokeUnsavedToken(newToken, errors.New("changed by another process"))
+	// Both the save error and the revocation error are reported.
+	assert.ErrorContains(t, err, "changed by another process")
+	assert.ErrorContains(t, err, "403")
+}
+
+func TestRotateCredentialsProvider(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	assert.NoError(t, credentialhelper.SetCredentialHelper("prod", "helper"))
+	rotateCmd := NewAccessTokenRotateCommand().SetServerDetails(&config.ServerDetails{ServerId: "prod", Url: "https://acme.jfrog.io/", AccessToken: createToken(`{"sub":"jfac@01h0/users/ci"}`)})
+	assert.ErrorContains(t, rotateCmd.Run(), "credential helper")
+}
+
+func TestRevokeServerTokenExpired(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Contains(t, string(body), `"refresh_token":"refresh"`)
+			_, err = w.Write([]byte(`{"access_token":"` + createToken(`{"jti":"refreshed-id"}`) + `","refresh_token":"new-refresh"}`))
+			assert.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The expired token is refreshed, and the refreshed token is revoked.
+	expiredToken := createToken(`{"jti":"expired-id","exp":1}`)
+	serverDetails := &config.ServerDetails{ServerId: "prod", Url: server.URL + "/", AccessUrl: server.URL + "/access/", AccessToken: expiredToken, RefreshToken: "refresh"}
+	assert.NoError(t, RevokeServerToken(serverDetails))
+	assert.Equal(t, []string{"POST /access/api/v1/tokens", "DELETE /access/api/v1/tokens/refreshed-id"}, requests)
+
+	// An expired token without a refresh token can't be used, so there's nothing to revoke.
+	requests = nil
+	serverDetails.RefreshToken = ""
+	assert.NoError(t, RevokeServerToken(serverDetails))
+	assert.Empty(t, requests)
+}
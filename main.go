@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/agnivade/levenshtein"
+	corecommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	corecommon "github.com/jfrog/jfrog-cli-core/v2/docs/common"
 	setupcore "github.com/jfrog/jfrog-cli-core/v2/general/envsetup"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
@@ -21,13 +22,21 @@ import (
 	"github.com/jfrog/jfrog-cli/config"
 	"github.com/jfrog/jfrog-cli/distribution"
 	"github.com/jfrog/jfrog-cli/docs/common"
+	apiDocs "github.com/jfrog/jfrog-cli/docs/general/api"
 	"github.com/jfrog/jfrog-cli/docs/general/cisetup"
+	doctorDocs "github.com/jfrog/jfrog-cli/docs/general/doctor"
 	loginDocs "github.com/jfrog/jfrog-cli/docs/general/login"
+	logoutDocs "github.com/jfrog/jfrog-cli/docs/general/logout"
+	supportBundleDocs "github.com/jfrog/jfrog-cli/docs/general/supportbundle"
 	tokenDocs "github.com/jfrog/jfrog-cli/docs/general/token"
+	"github.com/jfrog/jfrog-cli/general/api"
 	cisetupcommand "github.com/jfrog/jfrog-cli/general/cisetup"
+	"github.com/jfrog/jfrog-cli/general/doctor"
 	"github.com/jfrog/jfrog-cli/general/envsetup"
 	"github.com/jfrog/jfrog-cli/general/login"
+	"github.com/jfrog/jfrog-cli/general/logout"
 	"github.com/jfrog/jfrog-cli/general/project"
+	"github.com/jfrog/jfrog-cli/general/supportbundle"
 	"github.com/jfrog/jfrog-cli/general/token"
 	"github.com/jfrog/jfrog-cli/lifecycle"
 	"github.com/jfrog/jfrog-cli/missioncontrol"
@@ -35,6 +44,7 @@ import (
 	"github.com/jfrog/jfrog-cli/plugins"
 	"github.com/jfrog/jfrog-cli/plugins/utils"
 	"github.com/jfrog/jfrog-cli/utils/cliutils"
+	"github.com/jfrog/jfrog-cli/utils/servercontext"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
@@ -134,7 +144,8 @@ func execMain() error {
 		if warningMessage != "" {
 			clientlog.Warn(warningMessage)
 		}
-		return nil
+		// The server and project of the working directory's context override the default server.
+		return servercontext.Apply()
 	}
 	err = app.Run(args)
 	return err
@@ -228,6 +239,12 @@ func getCommands() ([]cli.Command, error) {
 			Subcommands: project.GetCommands(),
 			Category:    otherCategory,
 		},
+		{
+			Name:        cliutils.CmdAccessToken,
+			Usage:       "Access tokens management commands.",
+			Subcommands: token.GetCommands(),
+			Category:    otherCategory,
+		},
 		{
 			Name:         "ci-setup",
 			Usage:        cisetup.GetDescription(),
@@ -280,6 +297,17 @@ func getCommands() ([]cli.Command, error) {
 			Category:     otherCategory,
 			Action:       login.LoginCmd,
 		},
+		{
+			Name:         "logout",
+			Usage:        logoutDocs.GetDescription(),
+			Flags:        cliutils.GetCommandFlags(cliutils.Logout),
+			HelpName:     corecommon.CreateUsage("logout", logoutDocs.GetDescription(), logoutDocs.Usage),
+			UsageText:    logoutDocs.GetArguments(),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(corecommands.GetAllServerIds()...),
+			Category:     otherCategory,
+			Action:       logout.LogoutCmd,
+		},
 		{
 			Name:         "access-token-create",
 			Aliases:      []string{"atc"},
@@ -291,6 +319,38 @@ func getCommands() ([]cli.Command, error) {
 			BashComplete: corecommon.CreateBashCompletionFunc(),
 			Action:       token.AccessTokenCreateCmd,
 		},
+		{
+			Name:            "api",
+			Flags:           cliutils.GetCommandFlags(cliutils.Api),
+			Usage:           apiDocs.GetDescription(),
+			HelpName:        corecommon.CreateUsage("api", apiDocs.GetDescription(), apiDocs.Usage),
+			UsageText:       apiDocs.GetArguments(),
+			ArgsUsage:       common.CreateEnvVars(),
+			BashComplete:    corecommon.CreateBashCompletionFunc(),
+			SkipFlagParsing: true,
+			Category:        otherCategory,
+			Action:          api.ApiCmd,
+		},
+		{
+			Name:         "doctor",
+			Flags:        cliutils.GetCommandFlags(cliutils.Doctor),
+			Usage:        doctorDocs.GetDescription(),
+			HelpName:     corecommon.CreateUsage("doctor", doctorDocs.GetDescription(), doctorDocs.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Category:     otherCategory,
+			Action:       doctor.DoctorCmd,
+		},
+		{
+			Name:         "support-bundle",
+			Flags:        cliutils.GetCommandFlags(cliutils.SupportBundle),
+			Usage:        supportBundleDocs.GetDescription(),
+			HelpName:     corecommon.CreateUsage("support-bundle", supportBundleDocs.GetDescription(), supportBundleDocs.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: corecommon.CreateBashCompletionFunc(),
+			Category:     otherCategory,
+			Action:       supportbundle.SupportBundleCmd,
+		},
 	}
 
 	securityCmds, err := ConvertEmbeddedPlugin(securityCLI.GetJfrogCliSecurityApp())
@@ -304,7 +304,7 @@ func TestSearchSimilarCmds(t *testing.T) {
 		{"rtt", cmds, []string{"rt"}},
 		{"bp", cmds, []string{"rt bp"}},
 		{"asdfewrwqfaxf", cmds, []string{}},
-		{"bpp", artifactory.GetCommands(), []string{"bpr", "bp", "pp"}},
+		{"bpp", artifactory.GetCommands(), []string{"bpr", "bps", "bp", "pp"}},
 		{"uplid", artifactory.GetCommands(), []string{"upload"}},
 		{"downlo", artifactory.GetCommands(), []string{"download"}},
 		{"ownload", artifactory.GetCommands(), []string{"download"}},
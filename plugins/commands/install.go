@@ -73,6 +73,25 @@ func runInstallCmd(requestedPlugin string) error {
 	return downloadPlugin(pluginsDir, pluginName, execDownloadUrl, commandsUtils.CreatePluginsHttpDetails(&serverDetails))
 }
 
+// Checks whether the installed plugin is identical to the latest version of the plugin in registry.
+func IsLatestPluginInstalled(pluginName string) (bool, error) {
+	pluginsDir, err := coreutils.GetJfrogPluginsDir()
+	if err != nil {
+		return false, err
+	}
+	url, serverDetails, err := getServerDetails()
+	if err != nil {
+		return false, err
+	}
+	pluginRtDirPath, err := getRequiredPluginRtDirPath(pluginName, commandsUtils.LatestVersionName)
+	if err != nil {
+		return false, err
+	}
+	execDownloadUrl := clientUtils.AddTrailingSlashIfNeeded(url) + pluginRtDirPath + "/" + plugins.GetLocalPluginExecutableName(pluginName)
+	should, err := shouldDownloadPlugin(pluginsDir, pluginName, execDownloadUrl, commandsUtils.CreatePluginsHttpDetails(&serverDetails))
+	return !should, err
+}
+
 // Assert repo env is not passed without server env.
 func assertValidEnv(c *cli.Context) error {
 	repo := os.Getenv(commandsUtils.PluginsRepoEnv)
@@ -0,0 +1,209 @@
+package buildindex
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/lock"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+)
+
+// The build-info which is collected before it's published is kept in a directory per build, which is named after a hash
+// of the build name, number and project. The index maps these directories back to the builds, so that they can be listed.
+const (
+	indexFileName = "builds-index.json"
+	lockDirName   = "builds-index.lock"
+	partialsDir   = "partials"
+)
+
+type Build struct {
+	Name    string `json:"name"`
+	Number  string `json:"number"`
+	Project string `json:"project,omitempty"`
+}
+
+// PendingBuild is a build whose build-info was collected locally, and wasn't published yet.
+type PendingBuild struct {
+	// Empty if the build isn't in the index.
+	Build
+	// The name of the build directory, which identifies the build if it isn't in the index.
+	Dir     string
+	Started time.Time
+}
+
+// The index file, which maps the names of the build directories to the builds.
+type index struct {
+	Builds map[string]Build `json:"builds"`
+}
+
+// GetBuildsDir returns the directory which holds the local build-info of all the builds.
+func GetBuildsDir() string {
+	return filepath.Join(coreutils.GetCliPersistentTempDirPath(), build.BuildTempPath)
+}
+
+// Returns the name of the build directory. The name is calculated the same way as in build.GetBuildDir,
+// which creates the directory, while this package only checks whether it exists.
+func getDirName(b Build) string {
+	hash := sha256.Sum256([]byte(b.Name + "_" + b.Number + "_" + b.Project))
+	return hex.EncodeToString(hash[:])
+}
+
+// Exists returns true if build-info was collected locally for the build.
+func Exists(b Build) (bool, error) {
+	return fileutils.IsFileExists(filepath.Join(GetBuildsDir(), getDirName(b), partialsDir, build.BuildInfoDetails), false)
+}
+
+// Record adds the builds whose build-info was collected locally to the index. Builds without local build-info are ignored.
+func Record(builds ...Build) error {
+	var existing []Build
+	for _, b := range builds {
+		if b.Name == "" || b.Number == "" {
+			continue
+		}
+		exists, err := Exists(b)
+		if err != nil {
+			return err
+		}
+		if exists {
+			existing = append(existing, b)
+		}
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+	return updateIndex(func(idx *index) {
+		for _, b := range existing {
+			idx.Builds[getDirName(b)] = b
+		}
+	})
+}
+
+// List returns the pending builds, sorted by the time they were started.
+// The builds which are not in the index, such as builds collected by commands which don't index them, are returned without their names.
+func List() (pending []PendingBuild, err error) {
+	idx, err := readIndex()
+	if err != nil {
+		return
+	}
+	entries, err := os.ReadDir(GetBuildsDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	known := 0
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		exists, e := fileutils.IsFileExists(filepath.Join(GetBuildsDir(), entry.Name(), partialsDir, build.BuildInfoDetails), false)
+		if e != nil {
+			return nil, e
+		}
+		if !exists {
+			continue
+		}
+		b, isKnown := idx.Builds[entry.Name()]
+		if isKnown {
+			known++
+		}
+		started, e := getStarted(entry.Name())
+		if e != nil {
+			return nil, e
+		}
+		pending = append(pending, PendingBuild{Build: b, Dir: entry.Name(), Started: started})
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].Started.Before(pending[j].Started)
+	})
+	// Builds which were published or cleaned are removed from the index.
+	if known < len(idx.Builds) {
+		err = updateIndex(func(idx *index) {
+			for dirName, b := range idx.Builds {
+				if exists, e := Exists(b); e == nil && !exists {
+					delete(idx.Builds, dirName)
+				}
+			}
+		})
+	}
+	return
+}
+
+// Returns the time the collection of the build-info in the build directory started.
+func getStarted(dirName string) (time.Time, error) {
+	detailsPath := filepath.Join(GetBuildsDir(), dirName, partialsDir, build.BuildInfoDetails)
+	content, err := os.ReadFile(detailsPath)
+	if err != nil {
+		return time.Time{}, errorutils.CheckError(err)
+	}
+	details := new(buildinfo.General)
+	if err = json.Unmarshal(content, details); err != nil {
+		return time.Time{}, errorutils.CheckErrorf("failed to read %s: %s", detailsPath, err.Error())
+	}
+	return details.Timestamp, nil
+}
+
+// FormatTime formats a time the same way the build-info does.
+func FormatTime(t time.Time) string {
+	return t.Format(buildinfo.TimeFormat)
+}
+
+func getIndexFilePath() string {
+	return filepath.Join(coreutils.GetCliPersistentTempDirPath(), "jfrog", indexFileName)
+}
+
+func readIndex() (*index, error) {
+	idx := &index{Builds: map[string]Build{}}
+	content, err := os.ReadFile(getIndexFilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return idx, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	if err = json.Unmarshal(content, idx); err != nil {
+		return nil, errorutils.CheckErrorf("failed to read %s: %s", getIndexFilePath(), err.Error())
+	}
+	if idx.Builds == nil {
+		idx.Builds = map[string]Build{}
+	}
+	return idx, nil
+}
+
+// Updates the index file while it is locked against changes by other processes.
+func updateIndex(update func(idx *index)) (err error) {
+	unlockFunc, err := lock.CreateLock(filepath.Join(coreutils.GetCliPersistentTempDirPath(), "jfrog", lockDirName))
+	// Defer the lockFile.Unlock() function before throwing a possible error to avoid deadlock situations.
+	defer func() {
+		e := unlockFunc()
+		if err == nil {
+			err = e
+		}
+	}()
+	if err != nil {
+		return
+	}
+	idx, err := readIndex()
+	if err != nil {
+		return
+	}
+	update(idx)
+	content, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(getIndexFilePath()), 0700); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(getIndexFilePath(), content, 0600))
+}
@@ -0,0 +1,57 @@
+package buildindex
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordAndList(t *testing.T) {
+	localBuild := Build{Name: fmt.Sprintf("buildindex-test-%d", time.Now().UnixNano()), Number: "1", Project: "acme"}
+	defer func() {
+		assert.NoError(t, build.RemoveBuildDir(localBuild.Name, localBuild.Number, localBuild.Project))
+	}()
+	// A build without local build-info is not recorded.
+	assert.NoError(t, Record(localBuild))
+	assert.NotContains(t, listBuilds(t), localBuild)
+
+	assert.NoError(t, build.SaveBuildGeneralDetails(localBuild.Name, localBuild.Number, localBuild.Project))
+	exists, err := Exists(localBuild)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+	// A build which isn't in the index is listed by its directory, without its name.
+	assert.NotContains(t, listBuilds(t), localBuild)
+	assert.Contains(t, listDirs(t), getDirName(localBuild))
+
+	assert.NoError(t, Record(localBuild, Build{Name: localBuild.Name}))
+	assert.Contains(t, listBuilds(t), localBuild)
+
+	// Builds which were published or cleaned are removed from the index.
+	assert.NoError(t, build.RemoveBuildDir(localBuild.Name, localBuild.Number, localBuild.Project))
+	assert.NotContains(t, listBuilds(t), localBuild)
+	idx, err := readIndex()
+	assert.NoError(t, err)
+	assert.NotContains(t, idx.Builds, getDirName(localBuild))
+}
+
+func listBuilds(t *testing.T) (builds []Build) {
+	pending, err := List()
+	assert.NoError(t, err)
+	for _, pendingBuild := range pending {
+		assert.False(t, pendingBuild.Started.IsZero())
+		builds = append(builds, pendingBuild.Build)
+	}
+	return
+}
+
+func listDirs(t *testing.T) (dirs []string) {
+	pending, err := List()
+	assert.NoError(t, err)
+	for _, pendingBuild := range pending {
+		dirs = append(dirs, pendingBuild.Dir)
+	}
+	return
+}
@@ -0,0 +1,23 @@
+package buildindex
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// RecordCollected adds the build of the build configuration to the index, if build-info was collected for it.
+// It should be called by the commands which collect build-info. The index is only used for listing the pending builds,
+// so a failure to update it doesn't fail the command.
+func RecordCollected(buildConfiguration *build.BuildConfiguration) {
+	name, err := buildConfiguration.GetBuildName()
+	if err != nil {
+		return
+	}
+	number, err := buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return
+	}
+	if err = Record(Build{Name: name, Number: number, Project: buildConfiguration.GetProject()}); err != nil {
+		log.Debug("Failed to add the build to the builds index: " + err.Error())
+	}
+}
@@ -0,0 +1,175 @@
+package buildqueue
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/lock"
+	"github.com/jfrog/jfrog-cli/utils/buildindex"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The build-info of builds which failed to be published is kept in the queue under the JFrog home directory,
+// so that it outlives the workspace of the build. Each queued build is kept in a file named after a hash of
+// the build name, number and project, so queuing a build again replaces it.
+const (
+	queueDirName = "build-queue"
+	lockDirName  = "build-queue.lock"
+	entryFileExt = ".json"
+)
+
+// ArtifactReference is an artifact of a queued build, which was deployed to Artifactory before the build-info failed to be published.
+type ArtifactReference struct {
+	Module string `json:"module"`
+	Path   string `json:"path"`
+	Sha1   string `json:"sha1,omitempty"`
+}
+
+// Entry is a build in the queue.
+type Entry struct {
+	buildindex.Build
+	// The server the build-info should be published to.
+	ServerId string    `json:"serverId,omitempty"`
+	Url      string    `json:"url"`
+	QueuedAt time.Time `json:"queuedAt"`
+	// The failed attempts to publish the build-info, including the attempt which queued it.
+	Attempts    int                  `json:"attempts"`
+	LastAttempt time.Time            `json:"lastAttempt"`
+	LastError   string               `json:"lastError,omitempty"`
+	Artifacts   []ArtifactReference  `json:"artifacts,omitempty"`
+	BuildInfo   *buildinfo.BuildInfo `json:"buildInfo"`
+}
+
+// NewEntry creates a queue entry of the build-info, which failed to be published with the error.
+func NewEntry(buildInfo *buildinfo.BuildInfo, project, serverId, url string, publishErr error, now time.Time) *Entry {
+	entry := &Entry{
+		Build:       buildindex.Build{Name: buildInfo.Name, Number: buildInfo.Number, Project: project},
+		ServerId:    serverId,
+		Url:         url,
+		QueuedAt:    now,
+		Attempts:    1,
+		LastAttempt: now,
+		BuildInfo:   buildInfo,
+	}
+	if publishErr != nil {
+		entry.LastError = publishErr.Error()
+	}
+	for _, module := range buildInfo.Modules {
+		for _, artifact := range module.Artifacts {
+			entry.Artifacts = append(entry.Artifacts, ArtifactReference{Module: module.Id, Path: getArtifactPath(artifact), Sha1: artifact.Sha1})
+		}
+	}
+	return entry
+}
+
+// Returns the path of the artifact in its repository, which the build-info holds without the repository.
+func getArtifactPath(artifact buildinfo.Artifact) string {
+	if artifact.Path != "" {
+		return artifact.Path
+	}
+	return artifact.Name
+}
+
+// GetQueueDir returns the directory which holds the queued builds.
+func GetQueueDir() (string, error) {
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, queueDirName), nil
+}
+
+// Lock locks the queue against changes by other processes, until the returned function is called.
+// The queue should be locked while it's changed, and while its builds are published, so that they aren't published twice.
+func Lock() (unlock func() error, err error) {
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return func() error { return nil }, err
+	}
+	return lock.CreateLock(filepath.Join(homeDir, lockDirName))
+}
+
+// List returns the queued builds, from the earliest queued.
+func List() ([]*Entry, error) {
+	queueDir, err := GetQueueDir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(queueDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	var entries []*Entry
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), entryFileExt) {
+			continue
+		}
+		filePath := filepath.Join(queueDir, file.Name())
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		entry := new(Entry)
+		if err = json.Unmarshal(content, entry); err != nil {
+			return nil, errorutils.CheckErrorf("failed to read the queued build %s: %s", filePath, err.Error())
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].QueuedAt.Before(entries[j].QueuedAt)
+	})
+	return entries, nil
+}
+
+// Save adds the build to the queue, or updates it if it's already queued.
+func Save(entry *Entry) error {
+	filePath, err := getEntryFilePath(entry.Build)
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+		return errorutils.CheckError(err)
+	}
+	// The entry is written to a temporary file first, so that a failure doesn't leave a partial entry in the queue.
+	tempFilePath := filePath + ".tmp"
+	if err = os.WriteFile(tempFilePath, content, 0600); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.Rename(tempFilePath, filePath))
+}
+
+// Remove removes the build from the queue.
+func Remove(b buildindex.Build) error {
+	filePath, err := getEntryFilePath(b)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return errorutils.CheckError(err)
+	}
+	return nil
+}
+
+func getEntryFilePath(b buildindex.Build) (string, error) {
+	queueDir, err := GetQueueDir()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(b.Name + "_" + b.Number + "_" + b.Project))
+	return filepath.Join(queueDir, hex.EncodeToString(hash[:])+entryFileExt), nil
+}
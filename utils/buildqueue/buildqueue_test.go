@@ -0,0 +1,67 @@
+package buildqueue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEntry(t *testing.T) {
+	buildInfo := &buildinfo.BuildInfo{Name: "app", Number: "7", Modules: []buildinfo.Module{{Id: "app", Artifacts: []buildinfo.Artifact{
+		{Name: "app.jar", Path: "org/app/app.jar", Checksum: buildinfo.Checksum{Sha1: "1"}},
+		{Name: "app.pom", Checksum: buildinfo.Checksum{Sha1: "2"}},
+	}}}}
+	now := time.Now()
+	entry := NewEntry(buildInfo, "acme", "main", "https://acme.jfrog.io/artifactory/", errors.New("connection reset"), now)
+	assert.Equal(t, "app", entry.Name)
+	assert.Equal(t, "7", entry.Number)
+	assert.Equal(t, "acme", entry.Project)
+	assert.Equal(t, 1, entry.Attempts)
+	assert.Equal(t, now, entry.LastAttempt)
+	assert.Equal(t, "connection reset", entry.LastError)
+	assert.Equal(t, []ArtifactReference{
+		{Module: "app", Path: "org/app/app.jar", Sha1: "1"},
+		{Module: "app", Path: "app.pom", Sha1: "2"},
+	}, entry.Artifacts)
+}
+
+func TestSaveListRemove(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	entries, err := List()
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	now := time.Now().UTC()
+	second := NewEntry(&buildinfo.BuildInfo{Name: "app", Number: "2"}, "", "", "", nil, now)
+	first := NewEntry(&buildinfo.BuildInfo{Name: "app", Number: "1"}, "", "", "", nil, now.Add(-time.Hour))
+	assert.NoError(t, Save(second))
+	assert.NoError(t, Save(first))
+	// Queuing a build again replaces it.
+	second.Attempts = 2
+	assert.NoError(t, Save(second))
+
+	entries, err = List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, "1", entries[0].Number)
+		assert.Equal(t, "2", entries[1].Number)
+		assert.Equal(t, 2, entries[1].Attempts)
+	}
+
+	assert.NoError(t, Remove(first.Build))
+	assert.NoError(t, Remove(first.Build))
+	entries, err = List()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestLock(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	unlock, err := Lock()
+	assert.NoError(t, err)
+	assert.NoError(t, unlock())
+}
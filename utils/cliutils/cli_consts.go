@@ -18,6 +18,7 @@ const (
 	CmdOptions        = "options"
 	CmdProject        = "project"
 	CmdPipelines      = "pl"
+	CmdAccessToken    = "access-token"
 
 	// Download
 	DownloadMinSplitKb    = 5120
@@ -36,4 +37,6 @@ const (
 	EnvExclude                     = "JFROG_CLI_ENV_EXCLUDE"
 	UserAgent                      = "JFROG_CLI_USER_AGENT"
 	JfrogCliAvoidNewVersionWarning = "JFROG_CLI_AVOID_NEW_VERSION_WARNING"
+	ProvenanceSigningKey           = "JFROG_CLI_PROVENANCE_SIGNING_KEY"
+	ContainerImage                 = "JFROG_CLI_CONTAINER_IMAGE"
 )
@@ -24,13 +24,28 @@ const (
 	Move                   = "move"
 	Copy                   = "copy"
 	Delete                 = "delete"
+	Restore                = "restore"
+	TrashList              = "trash-list"
 	Properties             = "properties"
 	Search                 = "search"
+	Aql                    = "aql"
 	BuildPublish           = "build-publish"
 	BuildAppend            = "build-append"
 	BuildScanLegacy        = "build-scan-legacy"
 	BuildPromote           = "build-promote"
+	BuildPromoteSet        = "build-promote-set"
 	BuildDiscard           = "build-discard"
+	BuildQueueList         = "build-queue-list"
+	BuildQueueFlush        = "build-queue-flush"
+	BuildQueueDrop         = "build-queue-drop"
+	BuildExport            = "build-export"
+	BuildImport            = "build-import"
+	BuildDiff              = "build-diff"
+	BuildShow              = "build-show"
+	BuildList              = "build-list"
+	BuildSbom              = "build-sbom"
+	BuildProvenance        = "build-provenance"
+	BuildProvenanceVerify  = "build-provenance-verify"
 	BuildAddDependencies   = "build-add-dependencies"
 	BuildAddGit            = "build-add-git"
 	BuildCollectEnv        = "build-collect-env"
@@ -75,7 +90,14 @@ const (
 	RtCurl                 = "rt-curl"
 	TemplateConsumer       = "template-consumer"
 	RepoDelete             = "repo-delete"
+	RepoExport             = "repo-export"
+	RepoApply              = "repo-apply"
+	AccessExport           = "access-export"
+	AccessApply            = "access-apply"
 	ReplicationDelete      = "replication-delete"
+	ReplicationList        = "replication-list"
+	ReplicationStatus      = "replication-status"
+	ReplicationRun         = "replication-run"
 	PermissionTargetDelete = "permission-target-delete"
 	// #nosec G101 -- False positive - no hardcoded credentials.
 	ArtifactoryAccessTokenCreate = "artifactory-access-token-create"
@@ -84,6 +106,10 @@ const (
 	UsersDelete                  = "users-delete"
 	GroupCreate                  = "group-create"
 	GroupAddUsers                = "group-add-users"
+	GroupRemoveUsers             = "group-remove-users"
+	GroupsList                   = "groups-list"
+	UsersList                    = "users-list"
+	UsersExport                  = "users-export"
 	GroupDelete                  = "group-delete"
 	TransferConfig               = "transfer-config"
 	TransferConfigMerge          = "transfer-config-merge"
@@ -105,8 +131,11 @@ const (
 	JpdDelete      = "jpd-delete"
 
 	// Config commands keys
-	AddConfig  = "config-add"
-	EditConfig = "config-edit"
+	AddConfig     = "config-add"
+	EditConfig    = "config-edit"
+	CurrentConfig = "config-current"
+	ExportConfig  = "config-export"
+	ImportConfig  = "config-import"
 
 	// Project commands keys
 	InitProject = "project-init"
@@ -122,8 +151,21 @@ const (
 	ReleaseBundlePromote    = "release-bundle-promote"
 	ReleaseBundleDistribute = "release-bundle-distribute"
 
-	// Access Token Create commands keys
+	// Access Token commands keys
 	AccessTokenCreate = "access-token-create"
+	AccessTokenList   = "access-token-list"
+	AccessTokenRevoke = "access-token-revoke"
+	AccessTokenRotate = "access-token-rotate"
+	Logout            = "logout"
+
+	// Api commands keys
+	Api = "api"
+
+	// Doctor commands keys
+	Doctor = "doctor"
+
+	// Support bundle commands keys
+	SupportBundle = "support-bundle"
 
 	// *** Artifactory Commands' flags ***
 	// Base flags
@@ -192,12 +234,38 @@ const (
 	fromRt                  = "from-rt"
 	transitive              = "transitive"
 	Status                  = "status"
+	outputFormat            = "output-format"
 
 	// Config flags
-	interactive   = "interactive"
-	EncPassword   = "enc-password"
-	BasicAuthOnly = "basic-auth-only"
-	Overwrite     = "overwrite"
+	interactive      = "interactive"
+	EncPassword      = "enc-password"
+	BasicAuthOnly    = "basic-auth-only"
+	Overwrite        = "overwrite"
+	CredentialHelper = "credential-helper"
+	OidcProvider     = "oidc-provider"
+	OidcTokenEnv     = "oidc-token-env"
+	OidcAudience     = "oidc-audience"
+
+	// Config export and import flags
+	ExportAll       = "all"
+	ExportOutput    = "output"
+	PassphraseStdin = "passphrase-stdin"
+	SkipExisting    = "skip-existing"
+	Rename          = "rename"
+	ImportDryRun    = dryRun
+
+	// Unique config export and import flags
+	configExportPrefix          = "config-export-"
+	configExportAll             = configExportPrefix + ExportAll
+	configExportOutput          = configExportPrefix + ExportOutput
+	configExportPassphraseStdin = configExportPrefix + PassphraseStdin
+	configImportPrefix          = "config-import-"
+	configImportOverwrite       = configImportPrefix + Overwrite
+	configImportSkipExisting    = configImportPrefix + SkipExisting
+	configImportRename          = configImportPrefix + Rename
+	configImportDryRun          = configImportPrefix + dryRun
+	configImportQuiet           = configImportPrefix + quiet
+	configImportPassphraseStdin = configImportPrefix + PassphraseStdin
 
 	// Unique upload flags
 	uploadPrefix      = "upload-"
@@ -246,6 +314,14 @@ const (
 	deleteProps        = deletePrefix + props
 	deleteExcludeProps = deletePrefix + excludeProps
 	deleteQuiet        = deletePrefix + quiet
+	toTrash            = "to-trash"
+	trashRepo          = "trash-repo"
+
+	// Unique restore flags
+	restorePrefix = "restore-"
+	restoreDryRun = restorePrefix + dryRun
+	restoreQuiet  = restorePrefix + quiet
+	before        = "before"
 
 	// Unique search flags
 	searchInclude      = "include"
@@ -256,6 +332,57 @@ const (
 	count              = "count"
 	searchTransitive   = searchPrefix + transitive
 
+	// Unique aql flags
+	aqlPrefix       = "aql-"
+	aqlOutputFormat = aqlPrefix + outputFormat
+	columns         = "columns"
+	pageSize        = "page-size"
+
+	// Unique build-diff flags
+	bdiffPrefix       = "bdiff-"
+	bdiffOutputFormat = bdiffPrefix + outputFormat
+
+	// Unique build-show and build-list flags
+	bshLocal  = "bsh-local"
+	blsPrefix = "bls-"
+	blsLocal  = blsPrefix + "local"
+	blsName   = blsPrefix + "name"
+	blsLimit  = blsPrefix + limit
+
+	// Unique build-sbom flags
+	bsbomPrefix = "bsbom-"
+	bsbomFormat = bsbomPrefix + outputFormat
+	bsbomLocal  = bsbomPrefix + "local"
+	bsbomUpload = bsbomPrefix + "upload"
+
+	// Unique build-collect-env flags
+	bcePrefix     = "bce-"
+	bceTools      = bcePrefix + "tools"
+	bceHost       = bcePrefix + "host"
+	bceEnvExclude = bcePrefix + envExclude
+
+	// Unique build-promote-set flags
+	bpsPrefix = "bps-"
+	bpsMove   = bpsPrefix + "move"
+	bpsDryRun = bpsPrefix + dryRun
+
+	// Unique build-queue flags
+	bqPrefix        = "bq-"
+	bqRetries       = bqPrefix + retries
+	bqRetryWaitTime = bqPrefix + retryWaitTime
+	bqAll           = bqPrefix + "all"
+
+	// Unique build-export and build-import flags
+	bexpOutput          = "bexp-output"
+	bimpMergeDuplicates = "bimp-merge-duplicates"
+
+	// Unique build-provenance flags
+	bprovPrefix     = "bprov-"
+	bprovLocal      = bprovPrefix + "local"
+	bprovSigningKey = bprovPrefix + SigningKey
+	bprovTarget     = bprovPrefix + "target"
+	bprovPublicKey  = bprovPrefix + "public-key"
+
 	// Unique properties flags
 	propertiesPrefix  = "props-"
 	propsRecursive    = propertiesPrefix + recursive
@@ -269,6 +396,10 @@ const (
 	buildPublishPrefix = "bp-"
 	bpDryRun           = buildPublishPrefix + dryRun
 	bpDetailedSummary  = buildPublishPrefix + detailedSummary
+	bpProvenance       = buildPublishPrefix + "provenance"
+	bpSigningKey       = buildPublishPrefix + SigningKey
+	bpProvenanceTarget = buildPublishPrefix + "provenance-target"
+	bpQueueOnFailure   = buildPublishPrefix + "queue-on-failure"
 	envInclude         = "env-include"
 	envExclude         = "env-exclude"
 	buildUrl           = "build-url"
@@ -293,6 +424,7 @@ const (
 	buildPromotePrefix  = "bpr-"
 	bprDryRun           = buildPromotePrefix + dryRun
 	bprProps            = buildPromotePrefix + props
+	bprGates            = buildPromotePrefix + "gates"
 	comment             = "comment"
 	sourceRepo          = "source-repo"
 	includeDependencies = "include-dependencies"
@@ -304,6 +436,9 @@ const (
 	// Unique build-discard flags
 	buildDiscardPrefix = "bdi-"
 	bdiAsync           = buildDiscardPrefix + Async
+	bdiDryRun          = buildDiscardPrefix + dryRun
+	bdiQuiet           = buildDiscardPrefix + quiet
+	buildNamePattern   = "build-name-pattern"
 	maxDays            = "max-days"
 	maxBuilds          = "max-builds"
 	excludeBuilds      = "exclude-builds"
@@ -377,10 +512,31 @@ const (
 	// Template user flags
 	vars = "vars"
 
+	// Unique repo-export and repo-apply flags
+	repoConfigPrefix = "repo-config-"
+	repoInclude      = repoConfigPrefix + "include"
+	prune            = "prune"
+	repoApplyDryRun  = repoConfigPrefix + dryRun
+	repoApplyQuiet   = repoConfigPrefix + quiet
+
+	// Unique replication-run flags
+	replicationPrefix  = "replication-"
+	replicationWait    = replicationPrefix + "wait"
+	replicationTimeout = replicationPrefix + "timeout"
+
+	// Unique access-apply flags
+	accessPrefix = "access-"
+	accessPrune  = accessPrefix + prune
+	accessPlan   = accessPrefix + "plan"
+	accessQuiet  = accessPrefix + quiet
+
 	// User Management flags
 	csv            = "csv"
 	usersCreateCsv = "users-create-csv"
 	usersDeleteCsv = "users-delete-csv"
+	usersExportCsv = "users-export-csv"
+	usersGroup     = "users-group"
+	usersFormat    = "users-format"
 	UsersGroups    = "users-groups"
 	Replace        = "replace"
 	Admin          = "admin"
@@ -415,6 +571,24 @@ const (
 	atcRefreshable          = accessTokenCreatePrefix + Refreshable
 	atcAudience             = accessTokenCreatePrefix + Audience
 
+	// Unique access-token list and revoke flags
+	accessTokenPrefix = "access-token-"
+	atlUsername       = accessTokenPrefix + "username"
+	atrTokenFile      = accessTokenPrefix + "token-file"
+
+	// Unique logout flags
+	logoutPrefix = "logout-"
+	logoutAll    = logoutPrefix + "all"
+	logoutQuiet  = logoutPrefix + quiet
+
+	// Unique api flags
+	paginate = "paginate"
+	raw      = "raw"
+
+	// Unique support-bundle flags
+	bundleOutput = "bundle-output"
+	bundleUpload = "bundle-upload"
+
 	// Unique Xray Flags for upload/publish commands
 	xrayScan = "scan"
 
@@ -740,6 +914,65 @@ var flagsMap = map[string]cli.Flag{
 		Name:  Overwrite,
 		Usage: "[Default: false] Overwrites the instance configuration if an instance with the same ID already exists.` `",
 	},
+	CredentialHelper: cli.StringFlag{
+		Name: CredentialHelper,
+		Usage: "[Optional] A command which provides the credentials of the server, instead of storing them in the config. " +
+			"The command is run with the 'get' argument and the server URL in its standard input, and should print {\"user\", \"password\", \"accessToken\", \"expiresAt\"} as JSON. " +
+			"Commands which are implemented by JFrog CLI Core, such as 'jf rt upload', 'jf rt download' and 'jf rt build-publish', use the credentials fetched when they start, " +
+			"so the credentials should outlive the command. Other commands fetch fresh credentials before they expire, and once more if a request is rejected with a 401 response.` `",
+	},
+	OidcProvider: cli.StringFlag{
+		Name: OidcProvider,
+		Usage: "[Optional] The name of an OIDC integration in the JFrog Platform. When the server is used, the OIDC ID token issued by the CI provider " +
+			"is exchanged for a short-lived access token, instead of storing credentials in the config. " +
+			"Commands which are implemented by JFrog CLI Core, such as 'jf rt upload' and 'jf rt build-publish', use the token exchanged when they start, " +
+			"so the lifetime of the tokens of the OIDC integration should exceed the duration of the command. Other commands exchange a new token before it expires.` `",
+	},
+	OidcTokenEnv: cli.StringFlag{
+		Name: OidcTokenEnv,
+		Usage: "[Optional] The environment variable which holds the OIDC ID token. Used with --oidc-provider. " +
+			"If not set, the ID token is requested from GitHub Actions, using the ACTIONS_ID_TOKEN_REQUEST_URL environment variable.` `",
+	},
+	OidcAudience: cli.StringFlag{
+		Name:  OidcAudience,
+		Usage: "[Optional] The audience of the OIDC ID token requested from GitHub Actions. Used with --oidc-provider. If not set, GitHub's default audience is used.` `",
+	},
+	configExportAll: cli.BoolFlag{
+		Name:  ExportAll,
+		Usage: "[Default: false] Set to true to export all the servers to an encrypted bundle file. Requires --output.` `",
+	},
+	configExportOutput: cli.StringFlag{
+		Name:  ExportOutput + ", o",
+		Usage: "[Optional] The path of an encrypted bundle file to write the servers to, instead of printing a Config Token.` `",
+	},
+	configExportPassphraseStdin: cli.BoolFlag{
+		Name:  PassphraseStdin,
+		Usage: "[Default: false] Set to true to read the passphrase which encrypts the bundle from stdin. By default, the passphrase is prompted for.` `",
+	},
+	configImportOverwrite: cli.BoolFlag{
+		Name:  Overwrite,
+		Usage: "[Default: false] Set to true to replace configured servers that have the same IDs as servers in the bundle.` `",
+	},
+	configImportSkipExisting: cli.BoolFlag{
+		Name:  SkipExisting,
+		Usage: "[Default: false] Set to true to skip servers in the bundle whose IDs are already configured.` `",
+	},
+	configImportRename: cli.StringFlag{
+		Name:  Rename,
+		Usage: "[Optional] Servers to import with other IDs, in the form of \"bundleId1=newId1;bundleId2=newId2;...\" (wrapped by quotes).` `",
+	},
+	configImportDryRun: cli.BoolFlag{
+		Name:  ImportDryRun,
+		Usage: "[Default: false] Set to true to print the changes without importing the servers.` `",
+	},
+	configImportQuiet: cli.BoolFlag{
+		Name:  quiet,
+		Usage: "[Default: $CI] Set to true to import the servers without confirmation.` `",
+	},
+	configImportPassphraseStdin: cli.BoolFlag{
+		Name:  PassphraseStdin,
+		Usage: "[Default: false] Set to true to read the passphrase of the bundle from stdin. By default, the passphrase is prompted for.` `",
+	},
 	BasicAuthOnly: cli.BoolFlag{
 		Name: BasicAuthOnly,
 		Usage: "[Default: false] Set to true to disable replacing username and password/API key with an automatically created access token that's refreshed hourly. " +
@@ -888,6 +1121,110 @@ var flagsMap = map[string]cli.Flag{
 		Name:  quiet,
 		Usage: "[Default: $CI] Set to true to skip the delete confirmation message.` `",
 	},
+	toTrash: cli.BoolFlag{
+		Name:  toTrash,
+		Usage: "[Default: false] Set to true to keep the deleted items restorable. The items are moved to the repository set by --trash-repo, or to the Artifactory trash can if --trash-repo is not set. In the latter case, the command fails if the trash can is disabled.` `",
+	},
+	trashRepo: cli.StringFlag{
+		Name:  trashRepo,
+		Usage: "[Optional] A quarantine repository used instead of the Artifactory trash can. The trashed items are stored in it under <original repository>/<original path>, with properties recording their original location.` `",
+	},
+	restoreDryRun: cli.BoolFlag{
+		Name:  dryRun,
+		Usage: "[Default: false] Set to true to only list the items that would be restored.` `",
+	},
+	restoreQuiet: cli.BoolFlag{
+		Name:  quiet,
+		Usage: "[Default: $CI] Set to true to skip the restore confirmation message.` `",
+	},
+	before: cli.StringFlag{
+		Name:  before,
+		Usage: "[Optional] Only items deleted before this time are matched. The accepted formats are 2006-01-02, 2006-01-02T15:04:05 and RFC3339.` `",
+	},
+	outputFormat: cli.StringFlag{
+		Name:  "format",
+		Usage: "[Default: table] Defines the output format of the command. Acceptable values are: table, json.` `",
+	},
+	aqlOutputFormat: cli.StringFlag{
+		Name:  "format",
+		Usage: "[Default: ndjson] Defines the output format of the command. Acceptable values are: ndjson, table.` `",
+	},
+	bdiffOutputFormat: cli.StringFlag{
+		Name:  "format",
+		Usage: "[Default: table] Defines the output format of the command. Acceptable values are: table, json, markdown.` `",
+	},
+	bshLocal: cli.BoolFlag{
+		Name:  "local",
+		Usage: "[Default: false] Set to true to show the build-info collected locally, which wasn't published yet. The build-info is aggregated the same way 'jf rt build-publish' does, including the --build-url, --env-include and --env-exclude options.` `",
+	},
+	blsLocal: cli.BoolFlag{
+		Name:  "local",
+		Usage: "[Default: false] Set to true to list the builds whose build-info was collected locally, and wasn't published yet.` `",
+	},
+	blsName: cli.StringFlag{
+		Name:  "name",
+		Usage: "[Optional] A wildcard pattern of the names of the builds to list.` `",
+	},
+	blsLimit: cli.StringFlag{
+		Name:  limit,
+		Usage: "[Default: 10] The maximum number of published build numbers to list per build, starting from the most recent one.` `",
+	},
+	bsbomFormat: cli.StringFlag{
+		Name:  "format",
+		Usage: "[Default: cyclonedx-json] The format of the SBOM. Acceptable values are: cyclonedx-json, spdx-json.` `",
+	},
+	bsbomLocal: cli.BoolFlag{
+		Name:  "local",
+		Usage: "[Default: false] Set to true to generate the SBOM from the build-info collected locally, which wasn't published yet.` `",
+	},
+	bsbomUpload: cli.StringFlag{
+		Name:  "upload",
+		Usage: "[Optional] The path in Artifactory to upload the SBOM to, in the form of repo/path. If the path ends with a slash, the SBOM is uploaded to it as <build name>-<build number>.cdx.json or .spdx.json. The SBOM is added to the artifacts of the build, when the local build-info is published or immediately for a published build. If not set, the SBOM is printed.` `",
+	},
+	bceTools: cli.BoolFlag{
+		Name:  "tools",
+		Usage: "[Default: false] Set to true to add the versions of go, java, mvn, gradle, node, npm, python and dotnet found on the PATH to the build-info, as 'buildInfo.tools.*' properties.` `",
+	},
+	bceHost: cli.BoolFlag{
+		Name:  "host",
+		Usage: "[Default: false] Set to true to add the operating system, its release and kernel, the CPU architecture and the container the command runs in to the build-info, as 'buildInfo.host.*' properties.` `",
+	},
+	bceEnvExclude: cli.StringFlag{
+		Name:  envExclude,
+		Usage: "[Default: *password*;*psw*;*secret*;*key*;*token*;*auth*] List of case insensitive patterns in the form of \"value1;value2;...\". Tool and host facts whose names, such as 'tools.go.version', match those patterns will be excluded. Environment variables are excluded by the build-publish command.` `",
+	},
+	bpsMove: cli.BoolFlag{
+		Name:  "move",
+		Usage: "[Default: false] Set to true to move the artifacts of the builds to the target repositories, rather than copy them. On failure, the promoted artifacts are moved back.` `",
+	},
+	bpsDryRun: cli.BoolFlag{
+		Name:  dryRun,
+		Usage: "[Default: false] Set to true to only validate that all the builds can be promoted. The builds are not promoted.` `",
+	},
+	bprovLocal: cli.BoolFlag{
+		Name:  "local",
+		Usage: "[Default: false] Set to true to generate the provenance of the build-info collected locally, which wasn't published yet. The provenance is added to the build when it's published.` `",
+	},
+	bprovSigningKey: cli.StringFlag{
+		Name:  SigningKey,
+		Usage: "[Optional] Path to a PEM file of an ed25519 or ECDSA private key, used to sign the provenance. If not set, the JFROG_CLI_PROVENANCE_SIGNING_KEY environment variable is used.` `",
+	},
+	bprovTarget: cli.StringFlag{
+		Name:  "target",
+		Usage: "[Optional] The path in Artifactory to upload the provenance to, in the form of repo/path. If the path ends with a slash, the provenance is uploaded to it as <build name>-<build number>.intoto.jsonl. If not set, the provenance is uploaded next to the artifacts of the build.` `",
+	},
+	bprovPublicKey: cli.StringFlag{
+		Name:  "public-key",
+		Usage: "[Mandatory] Path to a PEM file of the ed25519 or ECDSA public key, which the provenance should be signed by.` `",
+	},
+	columns: cli.StringFlag{
+		Name:  columns,
+		Usage: "[Optional] Comma-separated list of the result fields to print, such as 'repo,path,name,size'. Nested fields are separated by dots, such as 'stats.downloads'. If not set, all fields are printed.` `",
+	},
+	pageSize: cli.StringFlag{
+		Name:  pageSize,
+		Usage: "[Default: 1000] Number of results fetched from Artifactory in each request. Set to 0 to send the query as is, without paging.` `",
+	},
 	searchRecursive: cli.BoolTFlag{
 		Name:  recursive,
 		Usage: "[Default: true] Set to false if you do not wish to search artifacts inside sub-folders in Artifactory.` `",
@@ -940,6 +1277,22 @@ var flagsMap = map[string]cli.Flag{
 		Name:  detailedSummary,
 		Usage: "[Default: false] Set to true to get a command summary with details about the build info artifact.` `",
 	},
+	bpProvenance: cli.BoolFlag{
+		Name:  "provenance",
+		Usage: "[Default: false] Set to true to generate a signed SLSA provenance of the artifacts of the build once the build-info is published, upload it to Artifactory and add it to the artifacts of the published build.` `",
+	},
+	bpSigningKey: cli.StringFlag{
+		Name:  SigningKey,
+		Usage: "[Optional] Path to a PEM file of an ed25519 or ECDSA private key, used to sign the provenance. If not set, the JFROG_CLI_PROVENANCE_SIGNING_KEY environment variable is used.` `",
+	},
+	bpProvenanceTarget: cli.StringFlag{
+		Name:  "provenance-target",
+		Usage: "[Optional] The path in Artifactory to upload the provenance to, in the form of repo/path. If the path ends with a slash, the provenance is uploaded to it as <build name>-<build number>.intoto.jsonl. If not set, the provenance is uploaded next to the artifacts of the build.` `",
+	},
+	bpQueueOnFailure: cli.BoolFlag{
+		Name:  "queue-on-failure",
+		Usage: "[Default: false] Set to true to queue the build info under the JFrog home directory if it fails to be published because Artifactory is unreachable or returns a 5xx response. The queued builds can be published later by running 'jf rt build-queue flush'. The command still fails when the build info is queued.` `",
+	},
 	envInclude: cli.StringFlag{
 		Name:  envInclude,
 		Usage: "[Default: *] List of patterns in the form of \"value1;value2;...\" Only environment variables match those patterns will be included.` `",
@@ -1008,6 +1361,10 @@ var flagsMap = map[string]cli.Flag{
 		Name:  props,
 		Usage: "[Optional] List of properties in the form of \"key1=value1;key2=value2,...\". A list of properties to attach to the build artifacts.` `",
 	},
+	bprGates: cli.StringFlag{
+		Name:  "gates",
+		Usage: "[Optional] Path to a YAML file with the quality gates the build must pass to be promoted. The gates are evaluated and reported before the promotion, and the build is promoted only if all of them pass.` `",
+	},
 	targetDockerImage: cli.StringFlag{
 		Name:  "target-docker-image",
 		Usage: "[Optional] Docker target image name.` `",
@@ -1044,6 +1401,38 @@ var flagsMap = map[string]cli.Flag{
 		Name:  Async,
 		Usage: "[Default: false] If set to true, build discard will run asynchronously and will not wait for response.` `",
 	},
+	bdiDryRun: cli.BoolFlag{
+		Name:  dryRun,
+		Usage: "[Default: false] Set to true to only list an estimate of the builds which would be discarded, along with the number and size of their artifacts if --delete-artifacts is set. No builds are discarded.` `",
+	},
+	bdiQuiet: cli.BoolFlag{
+		Name:  quiet,
+		Usage: "[Default: $CI] Set to true to skip the confirmation before discarding the builds of the build names matching --build-name-pattern.` `",
+	},
+	buildNamePattern: cli.StringFlag{
+		Name:  buildNamePattern,
+		Usage: "[Optional] A wildcard pattern of build names, such as 'frontend-*'. The retention parameters are applied to all the published builds whose names match the pattern, instead of the build name argument.` `",
+	},
+	bqRetries: cli.StringFlag{
+		Name:  retries,
+		Usage: "[Default: " + strconv.Itoa(Retries) + "] Number of times to retry publishing each queued build.` `",
+	},
+	bqRetryWaitTime: cli.StringFlag{
+		Name:  retryWaitTime,
+		Usage: "[Default: 10s] Number of seconds or milliseconds to wait before the first retry. The wait time is doubled after each retry. The numeric value should either end with s for seconds or ms for milliseconds.` `",
+	},
+	bqAll: cli.BoolFlag{
+		Name:  "all",
+		Usage: "[Default: false] Set to true to drop all the queued builds.` `",
+	},
+	bexpOutput: cli.StringFlag{
+		Name:  "output, o",
+		Usage: "[Default: <build name>-<build number>.tgz] The path of the created tar.gz archive.` `",
+	},
+	bimpMergeDuplicates: cli.BoolFlag{
+		Name:  "merge-duplicates",
+		Usage: "[Default: false] Set to true to import modules which were already collected locally, or imported from another archive, and merge them with the local modules. By default, the import fails if the archive has such modules.` `",
+	},
 	refs: cli.StringFlag{
 		Name:  refs,
 		Usage: "[Default: refs/remotes/*] List of Git references in the form of \"ref1,ref2,...\" which should be preserved.` `",
@@ -1161,6 +1550,42 @@ var flagsMap = map[string]cli.Flag{
 		Name:  vars,
 		Usage: "[Optional] List of variables in the form of \"key1=value1;key2=value2;...\" (wrapped by quotes) to be replaced in the template. In the template, the variables should be used as follows: ${key1}.` `",
 	},
+	repoInclude: cli.StringFlag{
+		Name:  "include",
+		Usage: "[Optional] A wildcard pattern of the keys of the repositories to manage, such as 'libs-*'. Other repositories and templates are ignored.` `",
+	},
+	prune: cli.BoolFlag{
+		Name:  prune,
+		Usage: "[Default: false] Set to true to delete the repositories which have no template in the directory. Use together with --include, to limit the deleted repositories.` `",
+	},
+	repoApplyDryRun: cli.BoolFlag{
+		Name:  dryRun,
+		Usage: "[Default: false] Set to true to print the plan without applying it.` `",
+	},
+	repoApplyQuiet: cli.BoolFlag{
+		Name:  quiet,
+		Usage: "[Default: $CI] Set to true to apply the plan without confirmation.` `",
+	},
+	accessPrune: cli.BoolFlag{
+		Name:  prune,
+		Usage: "[Default: false] Set to true to delete the users, groups and permission targets which are not in the file. The admin user and the user running the command are never deleted.` `",
+	},
+	accessPlan: cli.BoolFlag{
+		Name:  "plan",
+		Usage: "[Default: false] Set to true to print the plan without applying it.` `",
+	},
+	replicationWait: cli.BoolFlag{
+		Name:  "wait",
+		Usage: "[Default: false] Set to true to wait for the replication to complete. The command fails if the replication failed.` `",
+	},
+	replicationTimeout: cli.StringFlag{
+		Name:  "timeout",
+		Usage: "[Default: 1h] The maximum time to wait for the replication to complete, such as 30m or 2h. Used only with --wait.` `",
+	},
+	accessQuiet: cli.BoolFlag{
+		Name:  quiet,
+		Usage: "[Default: $CI] Set to true to apply the plan without confirmation.` `",
+	},
 	rtAtcGroups: cli.StringFlag{
 		Name: Groups,
 		Usage: "[Default: *] A list of comma-separated groups for the access token to be associated with. " +
@@ -1191,6 +1616,18 @@ var flagsMap = map[string]cli.Flag{
 		Name:  csv,
 		Usage: "[Optional] Path to a CSV file with the users' details. The first row of the file is reserved for the cells' headers. It must include \"username\"` `",
 	},
+	usersExportCsv: cli.StringFlag{
+		Name:  csv,
+		Usage: "[Mandatory] Path to the CSV file to write the users' details to. The file can be used as the input of the users-create and users-delete commands.` `",
+	},
+	usersGroup: cli.StringFlag{
+		Name:  "group",
+		Usage: "[Optional] If set, only the members of this group are included.` `",
+	},
+	usersFormat: cli.StringFlag{
+		Name:  "format",
+		Usage: "[Default: table] Defines the output format of the command. Acceptable values are: table, json, csv.` `",
+	},
 	UsersGroups: cli.StringFlag{
 		Name:  UsersGroups,
 		Usage: "[Optional] A list of comma-separated groups for the new users to be associated with.` `",
@@ -1700,24 +2137,67 @@ var flagsMap = map[string]cli.Flag{
 		Name:  Audience,
 		Usage: "[Optional] A space-separated list of the other instances or services that should accept this token identified by their Service-IDs.` `",
 	},
+	atlUsername: cli.StringFlag{
+		Name:  "username",
+		Usage: "[Optional] If set, only the tokens of this user are listed.` `",
+	},
+	atrTokenFile: cli.StringFlag{
+		Name:  "token-file",
+		Usage: "[Optional] Path to a file holding the access token to revoke, instead of providing the ID of the token.` `",
+	},
+	logoutAll: cli.BoolFlag{
+		Name:  "all",
+		Usage: "[Default: false] Set to true to log out of all the configured servers.` `",
+	},
+	logoutQuiet: cli.BoolFlag{
+		Name:  quiet,
+		Usage: "[Default: $CI] Set to true to log out of all the servers without confirmation.` `",
+	},
 	atcReference: cli.BoolFlag{
 		Name:  Reference,
 		Usage: "[Default: false] Generate a Reference Token (alias to Access Token) in addition to the full token (available from Artifactory 7.38.10)` `",
 	},
+	paginate: cli.BoolFlag{
+		Name:  paginate,
+		Usage: "[Default: false] Set to true to fetch all the pages of a paginated REST API and merge them. The page size should be set in the REST API path, using the 'limit' or 'num_of_rows' query parameter. Up to 1000 pages are fetched.` `",
+	},
+	raw: cli.BoolFlag{
+		Name:  raw,
+		Usage: "[Default: false] Set to true to print the response as is. By default, JSON responses are indented.` `",
+	},
+	bundleOutput: cli.StringFlag{
+		Name:  "output, o",
+		Usage: "[Default: jfrog-support-bundle-<timestamp>.zip] The path of the created zip archive.` `",
+	},
+	bundleUpload: cli.StringFlag{
+		Name:  "upload",
+		Usage: "[Optional] An Artifactory path in the form of repo/path, to upload the created archive to. If the path ends with a slash, the archive name is kept.` `",
+	},
 }
 
 var commandFlags = map[string][]string{
 	AddConfig: {
 		interactive, EncPassword, configPlatformUrl, configRtUrl, configDistUrl, configXrUrl, configMcUrl, configPlUrl, configUser, configPassword, configAccessToken, sshKeyPath, sshPassphrase, ClientCertPath,
-		ClientCertKeyPath, BasicAuthOnly, configInsecureTls, Overwrite, passwordStdin, accessTokenStdin,
+		ClientCertKeyPath, BasicAuthOnly, configInsecureTls, Overwrite, passwordStdin, accessTokenStdin, CredentialHelper,
+		OidcProvider, OidcTokenEnv, OidcAudience,
 	},
 	EditConfig: {
 		interactive, EncPassword, configPlatformUrl, configRtUrl, configDistUrl, configXrUrl, configMcUrl, configPlUrl, configUser, configPassword, configAccessToken, sshKeyPath, sshPassphrase, ClientCertPath,
-		ClientCertKeyPath, BasicAuthOnly, configInsecureTls, passwordStdin, accessTokenStdin,
+		ClientCertKeyPath, BasicAuthOnly, configInsecureTls, passwordStdin, accessTokenStdin, CredentialHelper,
+		OidcProvider, OidcTokenEnv, OidcAudience,
 	},
 	DeleteConfig: {
 		deleteQuiet,
 	},
+	CurrentConfig: {
+		serverId,
+	},
+	ExportConfig: {
+		configExportAll, configExportOutput, configExportPassphraseStdin,
+	},
+	ImportConfig: {
+		configImportOverwrite, configImportSkipExisting, configImportRename, configImportDryRun, configImportQuiet, configImportPassphraseStdin,
+	},
 	Upload: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath, uploadTargetProps,
 		ClientCertKeyPath, specFlag, specVars, buildName, buildNumber, module, uploadExclusions, deb,
@@ -1749,7 +2229,19 @@ var commandFlags = map[string][]string{
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset,
 		deleteRecursive, dryRun, build, includeDeps, excludeArtifacts, deleteQuiet, deleteProps, deleteExcludeProps, failNoOp, threads, archiveEntries,
-		InsecureTls, retries, retryWaitTime, Project,
+		InsecureTls, retries, retryWaitTime, Project, toTrash, trashRepo,
+	},
+	Restore: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, trashRepo, before, restoreDryRun, restoreQuiet, failNoOp, InsecureTls, retries,
+	},
+	TrashList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, trashRepo, before, outputFormat, InsecureTls,
+	},
+	Aql: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, specVars, aqlOutputFormat, columns, pageSize, failNoOp, InsecureTls, retries,
 	},
 	Search: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
@@ -1765,7 +2257,8 @@ var commandFlags = map[string][]string{
 	},
 	BuildPublish: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, buildUrl, bpDryRun,
-		envInclude, envExclude, InsecureTls, Project, bpDetailedSummary,
+		envInclude, envExclude, InsecureTls, Project, bpDetailedSummary, bpProvenance, bpSigningKey, bpProvenanceTarget,
+		bpQueueOnFailure,
 	},
 	BuildAppend: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, buildUrl, bpDryRun,
@@ -1778,7 +2271,7 @@ var commandFlags = map[string][]string{
 		configFlag, serverId, Project,
 	},
 	BuildCollectEnv: {
-		Project,
+		Project, bceTools, bceHost, bceEnvExclude,
 	},
 	BuildDockerCreate: {
 		buildName, buildNumber, module, url, user, password, accessToken, sshPassphrase, sshKeyPath,
@@ -1793,11 +2286,50 @@ var commandFlags = map[string][]string{
 	},
 	BuildPromote: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, Status, comment,
-		sourceRepo, includeDependencies, copyFlag, failFast, bprDryRun, bprProps, InsecureTls, Project,
+		sourceRepo, includeDependencies, copyFlag, failFast, bprDryRun, bprProps, bprGates, InsecureTls, Project,
+	},
+	BuildPromoteSet: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, bpsMove, bpsDryRun, InsecureTls, Project,
 	},
 	BuildDiscard: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, maxDays, maxBuilds,
-		excludeBuilds, deleteArtifacts, bdiAsync, InsecureTls, Project,
+		excludeBuilds, deleteArtifacts, bdiAsync, bdiDryRun, bdiQuiet, buildNamePattern, InsecureTls, Project,
+	},
+	BuildQueueList: {},
+	BuildQueueFlush: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, bqRetries, bqRetryWaitTime, InsecureTls, Project,
+	},
+	BuildQueueDrop: {
+		bqAll, Project,
+	},
+	BuildExport: {
+		bexpOutput, Project,
+	},
+	BuildImport: {
+		bimpMergeDuplicates,
+	},
+	BuildDiff: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, envInclude, envExclude,
+		bdiffOutputFormat, InsecureTls, Project,
+	},
+	BuildShow: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, bshLocal, buildUrl, envInclude, envExclude,
+		outputFormat, InsecureTls, Project,
+	},
+	BuildList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, blsLocal, blsName, blsLimit,
+		outputFormat, InsecureTls, Project,
+	},
+	BuildSbom: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, bsbomFormat, bsbomLocal, bsbomUpload,
+		InsecureTls, Project,
+	},
+	BuildProvenance: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, bprovLocal, bprovSigningKey, bprovTarget,
+		buildUrl, envInclude, envExclude, InsecureTls, Project,
+	},
+	BuildProvenanceVerify: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, bprovPublicKey, InsecureTls, Project,
 	},
 	GitLfsClean: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, refs, glcRepo, glcDryRun,
@@ -1945,10 +2477,38 @@ var commandFlags = map[string][]string{
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, deleteQuiet,
 	},
+	RepoExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoInclude,
+	},
+	RepoApply: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, vars, repoInclude, prune, repoApplyDryRun, repoApplyQuiet,
+	},
+	AccessExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	AccessApply: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, accessPrune, accessPlan, accessQuiet,
+	},
 	ReplicationDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, deleteQuiet,
 	},
+	ReplicationList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, outputFormat,
+	},
+	ReplicationStatus: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, outputFormat,
+	},
+	ReplicationRun: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, replicationWait, replicationTimeout, outputFormat,
+	},
 	PermissionTargetDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, deleteQuiet,
@@ -1957,11 +2517,32 @@ var commandFlags = map[string][]string{
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, rtAtcGroups, rtAtcGrantAdmin, rtAtcExpiry, rtAtcRefreshable, rtAtcAudience,
 	},
+	Api: {
+		serverId, paginate, raw,
+	},
+	Doctor: {
+		serverId, outputFormat,
+	},
+	SupportBundle: {
+		serverId, bundleOutput, bundleUpload,
+	},
 	AccessTokenCreate: {
 		platformUrl, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath, ClientCertKeyPath,
 		atcProject, atcGrantAdmin, atcGroups, atcScope, atcExpiry,
 		atcRefreshable, atcDescription, atcAudience, atcReference,
 	},
+	AccessTokenList: {
+		platformUrl, accessToken, serverId, ClientCertPath, ClientCertKeyPath, atlUsername, outputFormat,
+	},
+	AccessTokenRevoke: {
+		platformUrl, accessToken, serverId, ClientCertPath, ClientCertKeyPath, atrTokenFile,
+	},
+	AccessTokenRotate: {
+		serverId, ClientCertPath, ClientCertKeyPath,
+	},
+	Logout: {
+		logoutAll, logoutQuiet,
+	},
 	UserCreate: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
 		UsersGroups, Replace, Admin,
@@ -1981,6 +2562,18 @@ var commandFlags = map[string][]string{
 	GroupAddUsers: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
 	},
+	GroupRemoveUsers: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
+	},
+	GroupsList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, usersFormat,
+	},
+	UsersList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, usersGroup, usersFormat,
+	},
+	UsersExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, usersExportCsv, usersGroup,
+	},
 	GroupDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, deleteQuiet,
 	},
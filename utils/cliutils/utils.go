@@ -23,10 +23,12 @@ import (
 	"github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	commonCommands "github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/common/format"
 	"github.com/jfrog/jfrog-cli-core/v2/common/project"
 	speccore "github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	coreConfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli/utils/credentialhelper"
 	"github.com/jfrog/jfrog-cli/utils/summary"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -315,6 +317,10 @@ func GetEnvExclude(envExclude string) string {
 	return getOrDefaultEnv(envExclude, EnvExclude)
 }
 
+func GetProvenanceSigningKey(signingKey string) string {
+	return getOrDefaultEnv(signingKey, ProvenanceSigningKey)
+}
+
 // Return argument if not empty or retrieve from environment variable
 func getOrDefaultEnv(arg, envKey string) string {
 	if arg != "" {
@@ -408,7 +414,14 @@ func overrideIntIfSet(field *int, c *cli.Context, fieldName string) {
 // Exclude refreshable tokens parameter should be true when working with external tools (build tools, curl, etc)
 // or when sending requests not via ArtifactoryHttpClient.
 func CreateServerDetailsWithConfigOffer(c *cli.Context, excludeRefreshableTokens bool, domain cliutils.CommandDomain) (*coreConfig.ServerDetails, error) {
-	return cliutils.CreateServerDetailsWithConfigOffer(func() (*coreConfig.ServerDetails, error) { return createServerDetailsFromFlags(c, domain) }, excludeRefreshableTokens)
+	details, err := cliutils.CreateServerDetailsWithConfigOffer(func() (*coreConfig.ServerDetails, error) { return createServerDetailsFromFlags(c, domain) }, excludeRefreshableTokens)
+	if err != nil {
+		return nil, err
+	}
+	// Servers configured with a credential helper have no credentials in the config.
+	// The credentials are applied once, so commands which create their clients with the JFrog CLI Core use them until they end.
+	// The commands which create their clients with credentialhelper.CreateServiceManager refresh them as needed.
+	return details, credentialhelper.ApplyCredentials(details)
 }
 
 func createServerDetailsFromFlags(c *cli.Context, domain cliutils.CommandDomain) (details *coreConfig.ServerDetails, err error) {
@@ -634,6 +647,15 @@ func CheckNewCliVersionAvailable(currentVersion string) (warningMessage string,
 	return
 }
 
+// Returns the latest JFrog CLI version released on GitHub.
+func GetLatestCliVersion() (string, error) {
+	githubVersionInfo, err := getLatestCliVersionFromGithubAPI()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(githubVersionInfo.TagName, "v"), nil
+}
+
 func shouldCheckLatestCliVersion() (shouldCheck bool, err error) {
 	if strings.ToLower(os.Getenv(JfrogCliAvoidNewVersionWarning)) == "true" {
 		return
@@ -695,3 +717,15 @@ func GetProject(c *cli.Context) string {
 	projectKey := c.String("project")
 	return getOrDefaultEnv(projectKey, coreutils.Project)
 }
+
+// Get the value of the --format option, for commands that support table and JSON outputs only.
+func GetTableOrJsonOutputFormat(c *cli.Context) (format.OutputFormat, error) {
+	outputFormat, err := format.GetOutputFormat(c.String("format"))
+	if err != nil {
+		return "", err
+	}
+	if outputFormat != format.Table && outputFormat != format.Json {
+		return "", errorutils.CheckErrorf("only the following output formats are supported: %s, %s", format.Table, format.Json)
+	}
+	return outputFormat, nil
+}
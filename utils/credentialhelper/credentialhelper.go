@@ -0,0 +1,301 @@
+package credentialhelper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/lock"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The file in the JFrog CLI home directory, which maps server IDs to their credential helpers.
+// Only the helper commands are stored in it, never the credentials they return.
+const helpersFileName = "credential-helpers.json"
+
+// The helpers are run again when the credentials they returned are about to expire.
+const expiryMargin = 30 * time.Second
+
+var helperTimeout = time.Minute
+
+// Credentials are the credentials returned by a credential helper.
+type Credentials struct {
+	User        string    `json:"user,omitempty"`
+	Password    string    `json:"password,omitempty"`
+	AccessToken string    `json:"accessToken,omitempty"`
+	ExpiresAt   time.Time `json:"expiresAt,omitempty"`
+}
+
+func (c *Credentials) isExpired(now time.Time) bool {
+	return !c.ExpiresAt.IsZero() && now.Add(expiryMargin).After(c.ExpiresAt)
+}
+
+// Returns the access token, or the password if there's no access token.
+func (c *Credentials) secret() string {
+	if c.AccessToken != "" {
+		return c.AccessToken
+	}
+	return c.Password
+}
+
+// Identifies a password or an access token returned by a helper for a server.
+type issuedSecret struct {
+	serverId string
+	secret   string
+}
+
+type helpersConfig struct {
+	// Server ID to helper command.
+	CredentialHelpers map[string]string `json:"credentialHelpers"`
+	// Server ID to the OIDC configuration used to exchange ID tokens for access tokens.
+	Oidc map[string]*OidcConfig `json:"oidc,omitempty"`
+}
+
+var (
+	mutex sync.Mutex
+	// The credentials returned by the helpers, cached for the lifetime of the process, mapped by the server IDs.
+	cache = map[string]*Credentials{}
+	// The passwords and access tokens returned by the helpers, which tell them from credentials which were set explicitly.
+	issued = map[issuedSecret]bool{}
+)
+
+// GetCredentialHelper returns the credential helper command of the server, or an empty string if it has none.
+func GetCredentialHelper(serverId string) (string, error) {
+	helpers, err := readHelpersConfig()
+	if err != nil {
+		return "", err
+	}
+	return helpers.CredentialHelpers[serverId], nil
+}
+
+// SetCredentialHelper sets the credential helper command of the server, replacing its OIDC configuration if it has one.
+// An empty command removes the helper.
+func SetCredentialHelper(serverId, command string) error {
+	return updateHelpersConfig(func(helpers *helpersConfig) {
+		if command == "" {
+			delete(helpers.CredentialHelpers, serverId)
+			return
+		}
+		delete(helpers.Oidc, serverId)
+		helpers.CredentialHelpers[serverId] = command
+	})
+}
+
+// HasCredentialsProvider returns true if the server has a credential helper or an OIDC configuration, which provide its credentials.
+func HasCredentialsProvider(serverId string) (bool, error) {
+	helpers, err := readHelpersConfig()
+	if err != nil {
+		return false, err
+	}
+	return helpers.CredentialHelpers[serverId] != "" || helpers.Oidc[serverId] != nil, nil
+}
+
+// RemoveServer removes the credential helper and the OIDC configuration of the server.
+func RemoveServer(serverId string) error {
+	return updateHelpersConfig(func(helpers *helpersConfig) {
+		delete(helpers.CredentialHelpers, serverId)
+		delete(helpers.Oidc, serverId)
+	})
+}
+
+// RemoveAllCredentialHelpers removes the credential helpers and the OIDC configurations of all the servers.
+func RemoveAllCredentialHelpers() error {
+	return updateHelpersConfig(func(helpers *helpersConfig) {
+		helpers.CredentialHelpers = map[string]string{}
+		helpers.Oidc = map[string]*OidcConfig{}
+	})
+}
+
+// ApplyCredentials sets the credentials of the server details using the credential helper of the server, or by exchanging
+// an OIDC ID token for an access token. Credentials which were applied before are replaced if they are about to expire.
+// The details are left unchanged if the server has neither, or if they include a password or an access token which were set explicitly.
+func ApplyCredentials(serverDetails *config.ServerDetails) error {
+	if serverDetails == nil || serverDetails.ServerId == "" {
+		return nil
+	}
+	if (serverDetails.Password != "" || serverDetails.AccessToken != "") && !isApplied(serverDetails) {
+		return nil
+	}
+	fetch, err := getFetchFunc(serverDetails)
+	if err != nil || fetch == nil {
+		return err
+	}
+	credentials, err := getCredentials(serverDetails.ServerId, fetch)
+	if err != nil {
+		return err
+	}
+	setServerCredentials(serverDetails, credentials)
+	return nil
+}
+
+// Returns the function which fetches the credentials of the server from its credential helper or by an OIDC token exchange,
+// or nil if the server has neither.
+func getFetchFunc(serverDetails *config.ServerDetails) (func() (*Credentials, error), error) {
+	helpers, err := readHelpersConfig()
+	if err != nil {
+		return nil, err
+	}
+	if command := helpers.CredentialHelpers[serverDetails.ServerId]; command != "" {
+		return func() (*Credentials, error) { return runHelper(command, serverDetails.Url) }, nil
+	}
+	if oidcConfig := helpers.Oidc[serverDetails.ServerId]; oidcConfig != nil {
+		return func() (*Credentials, error) { return exchangeOidcToken(oidcConfig, serverDetails) }, nil
+	}
+	return nil, nil
+}
+
+func setServerCredentials(serverDetails *config.ServerDetails, credentials *Credentials) {
+	if credentials.User != "" {
+		serverDetails.User = credentials.User
+	}
+	serverDetails.Password = credentials.Password
+	serverDetails.AccessToken = credentials.AccessToken
+}
+
+// Returns true if the credentials of the server details were returned by a helper, rather than set explicitly.
+func isApplied(serverDetails *config.ServerDetails) bool {
+	mutex.Lock()
+	defer mutex.Unlock()
+	credentials := Credentials{Password: serverDetails.Password, AccessToken: serverDetails.AccessToken}
+	return issued[issuedSecret{serverDetails.ServerId, credentials.secret()}]
+}
+
+// Returns the cached credentials of the server, or fetches them if they are missing or about to expire.
+func getCredentials(serverId string, fetch func() (*Credentials, error)) (*Credentials, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if credentials, exists := cache[serverId]; exists && !credentials.isExpired(time.Now()) {
+		return credentials, nil
+	}
+	credentials, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	cache[serverId] = credentials
+	issued[issuedSecret{serverId, credentials.secret()}] = true
+	return credentials, nil
+}
+
+// Runs the helper as '<command> get', with the server URL in its standard input, the same way as Docker credential helpers are run.
+// The helper should print the credentials as JSON to its standard output.
+func runHelper(command, serverUrl string) (*Credentials, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, errorutils.CheckErrorf("the credential helper command is empty")
+	}
+	log.Debug("Running the credential helper:", args[0])
+	ctx, cancel := context.WithTimeout(context.Background(), helperTimeout)
+	defer cancel()
+	// #nosec G204 -- The helper command is configured by the user.
+	cmd := exec.CommandContext(ctx, args[0], append(args[1:], "get")...)
+	cmd.Stdin = strings.NewReader(serverUrl)
+	cmd.Stderr = os.Stderr
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil, errorutils.CheckErrorf("the credential helper '%s' failed: %s", args[0], err.Error())
+	}
+	credentials := new(Credentials)
+	if err := json.Unmarshal(stdout.Bytes(), credentials); err != nil {
+		return nil, errorutils.CheckErrorf("the output of the credential helper '%s' is not valid: %s", args[0], err.Error())
+	}
+	if credentials.Password == "" && credentials.AccessToken == "" {
+		return nil, errorutils.CheckErrorf("the credential helper '%s' returned neither a password nor an access token", args[0])
+	}
+	return credentials, nil
+}
+
+// Invalidate removes the cached credentials, so that the helpers are run again on the next use.
+func Invalidate() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	cache = map[string]*Credentials{}
+	issued = map[issuedSecret]bool{}
+}
+
+// Removes the cached credentials of the server, so that they are fetched again on the next use.
+func invalidateServer(serverId string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(cache, serverId)
+}
+
+func getHelpersFilePath() (string, error) {
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, helpersFileName), nil
+}
+
+func readHelpersConfig() (*helpersConfig, error) {
+	helpers := &helpersConfig{CredentialHelpers: map[string]string{}, Oidc: map[string]*OidcConfig{}}
+	path, err := getHelpersFilePath()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := fileutils.IsFileExists(path, false)
+	if err != nil || !exists {
+		return helpers, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if err = json.Unmarshal(content, helpers); err != nil {
+		return nil, errorutils.CheckErrorf("failed to read %s: %s", path, err.Error())
+	}
+	if helpers.CredentialHelpers == nil {
+		helpers.CredentialHelpers = map[string]string{}
+	}
+	if helpers.Oidc == nil {
+		helpers.Oidc = map[string]*OidcConfig{}
+	}
+	return helpers, nil
+}
+
+// Updates the helpers file while the configuration is locked against changes by other processes.
+func updateHelpersConfig(update func(helpers *helpersConfig)) (err error) {
+	lockDirPath, err := coreutils.GetJfrogConfigLockDir()
+	if err != nil {
+		return
+	}
+	unlockFunc, err := lock.CreateLock(lockDirPath)
+	// Defer the lockFile.Unlock() function before throwing a possible error to avoid deadlock situations.
+	defer func() {
+		e := unlockFunc()
+		if err == nil {
+			err = e
+		}
+	}()
+	if err != nil {
+		return
+	}
+	helpers, err := readHelpersConfig()
+	if err != nil {
+		return
+	}
+	update(helpers)
+	path, err := getHelpersFilePath()
+	if err != nil {
+		return
+	}
+	content, err := json.MarshalIndent(helpers, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(path, content, 0600))
+}
@@ -0,0 +1,126 @@
+package credentialhelper
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Creates a helper script which prints the given output, and counts its runs in the 'runs' file.
+func createHelper(t *testing.T, output string) (command, runsFile string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The credential helper tests use shell scripts.")
+	}
+	dir := t.TempDir()
+	runsFile = filepath.Join(dir, "runs")
+	command = filepath.Join(dir, "helper.sh")
+	script := "#!/bin/sh\n[ \"$1\" = get ] || exit 1\nread url\necho \"$url\" >> " + runsFile + "\ncat <<'EOF'\n" + output + "\nEOF\n"
+	assert.NoError(t, os.WriteFile(command, []byte(script), 0700))
+	return
+}
+
+func countRuns(t *testing.T, runsFile string) int {
+	content, err := os.ReadFile(runsFile)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	assert.NoError(t, err)
+	return strings.Count(string(content), "\n")
+}
+
+func setUp(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	Invalidate()
+}
+
+func TestApplyCredentials(t *testing.T) {
+	setUp(t)
+	command, runsFile := createHelper(t, `{"user": "ci", "accessToken": "token-from-helper"}`)
+	assert.NoError(t, SetCredentialHelper("prod", command))
+
+	serverDetails := &config.ServerDetails{ServerId: "prod", Url: "https://acme.jfrog.io/"}
+	assert.NoError(t, ApplyCredentials(serverDetails))
+	assert.Equal(t, "ci", serverDetails.User)
+	assert.Equal(t, "token-from-helper", serverDetails.AccessToken)
+
+	// The credentials are cached for the process.
+	serverDetails = &config.ServerDetails{ServerId: "prod", Url: "https://acme.jfrog.io/"}
+	assert.NoError(t, ApplyCredentials(serverDetails))
+	assert.Equal(t, "token-from-helper", serverDetails.AccessToken)
+	assert.Equal(t, 1, countRuns(t, runsFile))
+
+	// Credentials which were already set are kept.
+	serverDetails = &config.ServerDetails{ServerId: "prod", Url: "https://acme.jfrog.io/", AccessToken: "explicit"}
+	assert.NoError(t, ApplyCredentials(serverDetails))
+	assert.Equal(t, "explicit", serverDetails.AccessToken)
+
+	// Servers without a helper are left unchanged.
+	serverDetails = &config.ServerDetails{ServerId: "dev", Url: "https://dev.jfrog.io/"}
+	assert.NoError(t, ApplyCredentials(serverDetails))
+	assert.Empty(t, serverDetails.AccessToken)
+
+	assert.NoError(t, SetCredentialHelper("prod", ""))
+	command, err := GetCredentialHelper("prod")
+	assert.NoError(t, err)
+	assert.Empty(t, command)
+}
+
+func TestApplyCredentialsExpired(t *testing.T) {
+	setUp(t)
+	expiresAt := time.Now().Add(10 * time.Second).UTC().Format(time.RFC3339)
+	command, runsFile := createHelper(t, `{"password": "secret", "expiresAt": "`+expiresAt+`"}`)
+	assert.NoError(t, SetCredentialHelper("prod", command))
+	serverDetails := &config.ServerDetails{ServerId: "prod", Url: "https://acme.jfrog.io/", User: "admin"}
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, ApplyCredentials(serverDetails))
+		assert.Equal(t, "admin", serverDetails.User)
+		assert.Equal(t, "secret", serverDetails.Password)
+	}
+	// The credentials expire within the expiry margin, so the helper runs every time, even though they were already applied to the details.
+	assert.Equal(t, 2, countRuns(t, runsFile))
+}
+
+func TestApplyCredentialsInvalidOutput(t *testing.T) {
+	setUp(t)
+	command, _ := createHelper(t, `{"user": "ci"}`)
+	assert.NoError(t, SetCredentialHelper("prod", command))
+	assert.ErrorContains(t, ApplyCredentials(&config.ServerDetails{ServerId: "prod"}), "neither a password nor an access token")
+}
+
+func TestRetryOnUnauthorized(t *testing.T) {
+	setUp(t)
+	command, runsFile := createHelper(t, `{"accessToken": "token"}`)
+	assert.NoError(t, SetCredentialHelper("prod", command))
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		if requests == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err := w.Write([]byte("OK"))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+
+	serverDetails := &config.ServerDetails{ServerId: "prod", Url: server.URL + "/", ArtifactoryUrl: server.URL + "/artifactory/"}
+	assert.NoError(t, ApplyCredentials(serverDetails))
+	servicesManager, err := CreateServiceManager(serverDetails, 0, 0, false)
+	assert.NoError(t, err)
+	_, err = servicesManager.Ping()
+	assert.NoError(t, err)
+	// Only the rejected request was sent again, after the helper was asked for fresh credentials.
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 2, countRuns(t, runsFile))
+}
@@ -0,0 +1,160 @@
+package credentialhelper
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The environment variables GitHub Actions sets in jobs with the 'id-token: write' permission.
+	githubTokenRequestUrlEnv   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	githubTokenRequestTokenEnv = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+
+	oidcTokenExchangeApi = "access/api/v1/oidc/token"
+)
+
+// OidcConfig configures the exchange of the OIDC ID tokens issued by a CI provider for short-lived access tokens.
+// It contains no secrets. The ID token is read from the environment when the server is used.
+type OidcConfig struct {
+	// The name of the OIDC integration in the JFrog Platform.
+	ProviderName string `json:"providerName"`
+	// The environment variable which holds the ID token. If empty, the ID token is requested from GitHub Actions.
+	TokenEnv string `json:"tokenEnv,omitempty"`
+	// The audience of the ID token requested from GitHub Actions. If empty, GitHub's default audience is used.
+	Audience string `json:"audience,omitempty"`
+}
+
+type oidcTokenExchangeRequest struct {
+	GrantType        string `json:"grant_type"`
+	SubjectTokenType string `json:"subject_token_type"`
+	SubjectToken     string `json:"subject_token"`
+	ProviderName     string `json:"provider_name"`
+}
+
+type oidcTokenExchangeResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Username    string `json:"username"`
+}
+
+// GetOidcConfig returns the OIDC configuration of the server, or nil if it has none.
+func GetOidcConfig(serverId string) (*OidcConfig, error) {
+	helpers, err := readHelpersConfig()
+	if err != nil {
+		return nil, err
+	}
+	return helpers.Oidc[serverId], nil
+}
+
+// SetOidcConfig sets the OIDC configuration of the server, replacing its credential helper if it has one.
+// A nil configuration removes the OIDC configuration.
+func SetOidcConfig(serverId string, oidcConfig *OidcConfig) error {
+	return updateHelpersConfig(func(helpers *helpersConfig) {
+		if oidcConfig == nil {
+			delete(helpers.Oidc, serverId)
+			return
+		}
+		delete(helpers.CredentialHelpers, serverId)
+		helpers.Oidc[serverId] = oidcConfig
+	})
+}
+
+// Exchanges the ID token of the CI provider for an access token, using the token exchange endpoint of the JFrog Platform.
+func exchangeOidcToken(oidcConfig *OidcConfig, serverDetails *config.ServerDetails) (*Credentials, error) {
+	if serverDetails.Url == "" {
+		return nil, errorutils.CheckErrorf("the OIDC token exchange requires the JFrog Platform URL of the server '%s'", serverDetails.ServerId)
+	}
+	client, err := httpclient.ClientBuilder().SetInsecureTls(serverDetails.InsecureTls).Build()
+	if err != nil {
+		return nil, err
+	}
+	idToken, err := getIdToken(client, oidcConfig)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(oidcTokenExchangeRequest{
+		GrantType:        "urn:ietf:params:oauth:grant-type:token-exchange",
+		SubjectTokenType: "urn:ietf:params:oauth:token-type:id_token",
+		SubjectToken:     idToken,
+		ProviderName:     oidcConfig.ProviderName,
+	})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	log.Debug("Exchanging the OIDC ID token using the provider", oidcConfig.ProviderName)
+	httpDetails := httputils.HttpClientDetails{Headers: map[string]string{"Content-Type": "application/json"}}
+	resp, body, err := client.SendPost(strings.TrimSuffix(serverDetails.Url, "/")+"/"+oidcTokenExchangeApi, content, httpDetails, "")
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, errorutils.CheckErrorf("the OIDC token exchange failed: %s", err.Error())
+	}
+	response := new(oidcTokenExchangeResponse)
+	if err = json.Unmarshal(body, response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if response.AccessToken == "" {
+		return nil, errorutils.CheckErrorf("the OIDC token exchange returned no access token")
+	}
+	credentials := &Credentials{User: response.Username, AccessToken: response.AccessToken}
+	if response.ExpiresIn > 0 {
+		credentials.ExpiresAt = time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+	return credentials, nil
+}
+
+// Returns the ID token from the configured environment variable, or requests it from GitHub Actions.
+func getIdToken(client *httpclient.HttpClient, oidcConfig *OidcConfig) (string, error) {
+	if oidcConfig.TokenEnv != "" {
+		idToken := strings.TrimSpace(os.Getenv(oidcConfig.TokenEnv))
+		if idToken == "" {
+			return "", errorutils.CheckErrorf("the environment variable %s, which should hold the OIDC ID token, is not set", oidcConfig.TokenEnv)
+		}
+		return idToken, nil
+	}
+	requestUrl, requestToken := os.Getenv(githubTokenRequestUrlEnv), os.Getenv(githubTokenRequestTokenEnv)
+	if requestUrl == "" || requestToken == "" {
+		return "", errorutils.CheckErrorf("no OIDC ID token is available. Set the environment variable which holds it with --oidc-token-env, "+
+			"or run in a GitHub Actions job with the 'id-token: write' permission, where %s is set", githubTokenRequestUrlEnv)
+	}
+	if oidcConfig.Audience != "" {
+		parsedUrl, err := url.Parse(requestUrl)
+		if err != nil {
+			return "", errorutils.CheckErrorf("the %s environment variable holds an invalid URL: %s", githubTokenRequestUrlEnv, err.Error())
+		}
+		query := parsedUrl.Query()
+		query.Set("audience", oidcConfig.Audience)
+		parsedUrl.RawQuery = query.Encode()
+		requestUrl = parsedUrl.String()
+	}
+	log.Debug("Requesting an OIDC ID token from GitHub Actions")
+	httpDetails := httputils.HttpClientDetails{Headers: map[string]string{"Authorization": "Bearer " + requestToken}}
+	resp, body, _, err := client.SendGet(requestUrl, true, httpDetails, "")
+	if err != nil {
+		return "", err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return "", errorutils.CheckErrorf("failed requesting an OIDC ID token from GitHub Actions: %s", err.Error())
+	}
+	var response struct {
+		Value string `json:"value"`
+	}
+	if err = json.Unmarshal(body, &response); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	if response.Value == "" {
+		return "", errorutils.CheckErrorf("GitHub Actions returned no OIDC ID token")
+	}
+	return response.Value, nil
+}
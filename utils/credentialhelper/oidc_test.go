@@ -0,0 +1,105 @@
+package credentialhelper
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// Creates a stub of the JFrog Platform token exchange endpoint, which accepts the given ID token and counts the exchanges.
+func createExchangeStub(t *testing.T, idToken string, expiresIn int64, exchanges *int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/"+oidcTokenExchangeApi, r.URL.Path)
+		request := new(oidcTokenExchangeRequest)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		assert.Equal(t, "github-ci", request.ProviderName)
+		assert.Equal(t, "urn:ietf:params:oauth:token-type:id_token", request.SubjectTokenType)
+		if request.SubjectToken != idToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		*exchanges++
+		assert.NoError(t, json.NewEncoder(w).Encode(oidcTokenExchangeResponse{AccessToken: "short-lived-token", ExpiresIn: expiresIn, Username: "ci-user"}))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestOidcTokenEnv(t *testing.T) {
+	setUp(t)
+	exchanges := 0
+	platform := createExchangeStub(t, "id-token", 3600, &exchanges)
+	assert.NoError(t, SetOidcConfig("prod", &OidcConfig{ProviderName: "github-ci", TokenEnv: "CI_ID_TOKEN"}))
+
+	assert.ErrorContains(t, ApplyCredentials(&config.ServerDetails{ServerId: "prod", Url: platform.URL + "/"}), "CI_ID_TOKEN")
+
+	t.Setenv("CI_ID_TOKEN", "id-token")
+	for i := 0; i < 2; i++ {
+		serverDetails := &config.ServerDetails{ServerId: "prod", Url: platform.URL + "/"}
+		assert.NoError(t, ApplyCredentials(serverDetails))
+		assert.Equal(t, "short-lived-token", serverDetails.AccessToken)
+		assert.Equal(t, "ci-user", serverDetails.User)
+	}
+	// The access token is kept in memory until it is about to expire.
+	assert.Equal(t, 1, exchanges)
+
+	Invalidate()
+	t.Setenv("CI_ID_TOKEN", "wrong-token")
+	assert.ErrorContains(t, ApplyCredentials(&config.ServerDetails{ServerId: "prod", Url: platform.URL + "/"}), "401")
+}
+
+func TestOidcExpiry(t *testing.T) {
+	setUp(t)
+	exchanges := 0
+	platform := createExchangeStub(t, "id-token", 10, &exchanges)
+	t.Setenv("CI_ID_TOKEN", "id-token")
+	assert.NoError(t, SetOidcConfig("prod", &OidcConfig{ProviderName: "github-ci", TokenEnv: "CI_ID_TOKEN"}))
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, ApplyCredentials(&config.ServerDetails{ServerId: "prod", Url: platform.URL + "/"}))
+	}
+	// The token expires within the expiry margin, so it is exchanged every time.
+	assert.Equal(t, 2, exchanges)
+}
+
+func TestOidcGithubActions(t *testing.T) {
+	setUp(t)
+	issuer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer request-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "2.0", r.URL.Query().Get("api-version"))
+		assert.Equal(t, "https://acme.jfrog.io", r.URL.Query().Get("audience"))
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]string{"value": "github-id-token"}))
+	}))
+	defer issuer.Close()
+	exchanges := 0
+	platform := createExchangeStub(t, "github-id-token", 3600, &exchanges)
+	assert.NoError(t, SetOidcConfig("prod", &OidcConfig{ProviderName: "github-ci", Audience: "https://acme.jfrog.io"}))
+
+	t.Setenv(githubTokenRequestUrlEnv, "")
+	assert.ErrorContains(t, ApplyCredentials(&config.ServerDetails{ServerId: "prod", Url: platform.URL + "/"}), "no OIDC ID token is available")
+
+	t.Setenv(githubTokenRequestUrlEnv, issuer.URL+"/token?api-version=2.0")
+	t.Setenv(githubTokenRequestTokenEnv, "request-token")
+	serverDetails := &config.ServerDetails{ServerId: "prod", Url: platform.URL + "/"}
+	assert.NoError(t, ApplyCredentials(serverDetails))
+	assert.Equal(t, "short-lived-token", serverDetails.AccessToken)
+	assert.Equal(t, 1, exchanges)
+}
+
+func TestSetOidcConfig(t *testing.T) {
+	setUp(t)
+	assert.NoError(t, SetCredentialHelper("prod", "/usr/bin/helper"))
+	assert.NoError(t, SetOidcConfig("prod", &OidcConfig{ProviderName: "github-ci"}))
+	// A server has either a credential helper or an OIDC configuration.
+	command, err := GetCredentialHelper("prod")
+	assert.NoError(t, err)
+	assert.Empty(t, command)
+
+	assert.NoError(t, RemoveServer("prod"))
+	oidcConfig, err := GetOidcConfig("prod")
+	assert.NoError(t, err)
+	assert.Nil(t, oidcConfig)
+}
@@ -0,0 +1,121 @@
+package credentialhelper
+
+import (
+	"net/http"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientConfig "github.com/jfrog/jfrog-client-go/config"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// CreateServiceManager creates an Artifactory service manager the same way as the JFrog CLI Core does.
+// If the credentials of the server are returned by a credential helper or an OIDC token exchange, they are checked before every
+// request and replaced once they are about to expire, and a request which is rejected with a 401 response is sent once more with fresh credentials.
+// The JFrog CLI Core has no hook for the requests of the clients it creates, so the commands it implements don't get the refresh and the retry,
+// and use the credentials applied when they start.
+func CreateServiceManager(serverDetails *config.ServerDetails, httpRetries, httpRetryWaitMilliSecs int, isDryRun bool) (artifactory.ArtifactoryServicesManager, error) {
+	if err := ApplyCredentials(serverDetails); err != nil {
+		return nil, err
+	}
+	fetch, err := getFetchFunc(serverDetails)
+	if err != nil {
+		return nil, err
+	}
+	if fetch == nil || !isApplied(serverDetails) {
+		return rtUtils.CreateServiceManager(serverDetails, httpRetries, httpRetryWaitMilliSecs, isDryRun)
+	}
+	certsPath, err := coreutils.GetJfrogCertsDir()
+	if err != nil {
+		return nil, err
+	}
+	client, err := httpclient.ClientBuilder().
+		SetCertificatesPath(certsPath).
+		SetInsecureTls(serverDetails.InsecureTls).
+		SetClientCertPath(serverDetails.ClientCertPath).
+		SetClientCertKeyPath(serverDetails.ClientCertKeyPath).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	httpClient := client.GetClient()
+	httpClient.Transport = &helperTransport{serverId: serverDetails.ServerId, user: serverDetails.User, fetch: fetch, base: httpClient.Transport}
+	artAuth, err := serverDetails.CreateArtAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	configBuilder := clientConfig.NewConfigBuilder().
+		SetServiceDetails(artAuth).
+		SetCertificatesPath(certsPath).
+		SetInsecureTls(serverDetails.InsecureTls).
+		SetDryRun(isDryRun).
+		SetHttpClient(httpClient)
+	if httpRetries >= 0 {
+		configBuilder.SetHttpRetries(httpRetries)
+		configBuilder.SetHttpRetryWaitMilliSecs(httpRetryWaitMilliSecs)
+	}
+	serviceConfig, err := configBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return artifactory.New(serviceConfig)
+}
+
+// helperTransport authenticates the requests with the credentials returned by the helper of the server.
+// A request which is rejected with a 401 response is sent once more with fresh credentials, but only if its body can be read again.
+type helperTransport struct {
+	serverId string
+	// The user the credentials belong to, if the helper returns no user.
+	user  string
+	fetch func() (*Credentials, error)
+	base  http.RoundTripper
+}
+
+func (ht *helperTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests which aren't authenticated, such as the requests redirected to other hosts, are sent as they are.
+	if req.Header.Get("Authorization") == "" {
+		return ht.base.RoundTrip(req)
+	}
+	credentials, err := getCredentials(ht.serverId, ht.fetch)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ht.base.RoundTrip(ht.authorize(req, credentials))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+	log.Debug("The request was rejected with the credentials returned by the credential helper or the OIDC token exchange. Retrying with fresh credentials...")
+	invalidateServer(ht.serverId)
+	if credentials, err = getCredentials(ht.serverId, ht.fetch); err != nil {
+		log.Debug("Failed to refresh the credentials of the server:", err.Error())
+		return resp, nil
+	}
+	retryReq := ht.authorize(req, credentials)
+	if req.GetBody != nil {
+		if retryReq.Body, err = req.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	if closeErr := resp.Body.Close(); closeErr != nil {
+		log.Debug("Failed to close the response body:", closeErr.Error())
+	}
+	return ht.base.RoundTrip(retryReq)
+}
+
+// Returns a copy of the request, which is authenticated with the credentials the same way as by the JFrog HTTP client.
+func (ht *helperTransport) authorize(req *http.Request, credentials *Credentials) *http.Request {
+	req = req.Clone(req.Context())
+	if credentials.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+credentials.AccessToken)
+		return req
+	}
+	user := credentials.User
+	if user == "" {
+		user = ht.user
+	}
+	req.SetBasicAuth(user, credentials.Password)
+	return req
+}
@@ -0,0 +1,198 @@
+package servercontext
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// The path of a context file, or of a directory containing a .jfrog/context.yaml file, which overrides the context of the working directory.
+	ContextEnv = "JFROG_CLI_CONTEXT"
+
+	contextFileName = "context.yaml"
+)
+
+// Source is the reason a server was chosen.
+type Source string
+
+const (
+	Flag      Source = "flag"
+	Env       Source = "env"
+	Directory Source = "directory"
+	Default   Source = "default"
+)
+
+// Context is the server and project used by the commands running in a directory tree.
+// It is read from the .jfrog/context.yaml file of the tree, and overrides the default server set by 'jf c use'.
+type Context struct {
+	ServerId string `yaml:"serverId,omitempty"`
+	Project  string `yaml:"project,omitempty"`
+
+	// The path of the context file.
+	path string
+	// True if the context was found using the JFROG_CLI_CONTEXT environment variable.
+	fromEnv bool
+	// True if the server ID and project environment variables were set by Apply.
+	appliedServerId bool
+	appliedProject  bool
+}
+
+func (c *Context) Path() string {
+	return c.path
+}
+
+// The context applied by the current process.
+var applied *Context
+
+// Load returns the context of the working directory, or nil if there is none.
+// If the JFROG_CLI_CONTEXT environment variable is set, the context it points to is returned instead.
+func Load() (*Context, error) {
+	if contextPath := os.Getenv(ContextEnv); contextPath != "" {
+		filePath, err := getContextFileFromEnv(contextPath)
+		if err != nil {
+			return nil, err
+		}
+		context, err := readContext(filePath)
+		if err != nil {
+			return nil, err
+		}
+		context.fromEnv = true
+		return context, nil
+	}
+	projectDir, exists, err := fileutils.FindUpstream(".jfrog", fileutils.Dir)
+	if err != nil || !exists {
+		return nil, err
+	}
+	filePath := filepath.Join(projectDir, ".jfrog", contextFileName)
+	exists, err = fileutils.IsFileExists(filePath, false)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return readContext(filePath)
+}
+
+func getContextFileFromEnv(contextPath string) (string, error) {
+	isDir, err := fileutils.IsDirExists(contextPath, false)
+	if err != nil {
+		return "", err
+	}
+	if isDir {
+		contextPath = filepath.Join(contextPath, ".jfrog", contextFileName)
+	}
+	exists, err := fileutils.IsFileExists(contextPath, false)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", errorutils.CheckErrorf("the context file %s, set by the %s environment variable, does not exist", contextPath, ContextEnv)
+	}
+	return contextPath, nil
+}
+
+func readContext(filePath string) (*Context, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	context := &Context{path: filePath}
+	if err = yaml.UnmarshalStrict(content, context); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing the context file %s: %s", filePath, err.Error())
+	}
+	return context, nil
+}
+
+// Apply makes the commands use the server and project of the current context, by setting the JFROG_CLI_SERVER_ID and
+// JFROG_CLI_BUILD_PROJECT environment variables of the process. Variables which are already set are not overridden.
+func Apply() error {
+	context, err := Load()
+	if err != nil || context == nil {
+		return err
+	}
+	log.Debug("Using the context file " + context.path)
+	if context.ServerId != "" && os.Getenv(coreutils.ServerID) == "" {
+		if err = os.Setenv(coreutils.ServerID, context.ServerId); err != nil {
+			return errorutils.CheckError(err)
+		}
+		context.appliedServerId = true
+	}
+	if context.Project != "" && os.Getenv(coreutils.Project) == "" {
+		if err = os.Setenv(coreutils.Project, context.Project); err != nil {
+			return errorutils.CheckError(err)
+		}
+		context.appliedProject = true
+	}
+	applied = context
+	return nil
+}
+
+// Resolution is the server used by the commands, and the reason it was chosen.
+type Resolution struct {
+	ServerDetails *config.ServerDetails
+	Project       string
+	Source        Source
+	Reason        string
+}
+
+// Resolve returns the server used by the commands, given the value of the --server-id option.
+// The option comes first, then the JFROG_CLI_SERVER_ID environment variable, the context and finally the default server.
+func Resolve(serverIdFlag string) (*Resolution, error) {
+	resolution := &Resolution{Project: os.Getenv(coreutils.Project)}
+	context, err := getContext()
+	if err != nil {
+		return nil, err
+	}
+	serverId := serverIdFlag
+	switch {
+	case serverIdFlag != "":
+		resolution.Source, resolution.Reason = Flag, "the --server-id option"
+	case os.Getenv(coreutils.ServerID) != "" && (context == nil || !context.appliedServerId):
+		serverId = os.Getenv(coreutils.ServerID)
+		resolution.Source, resolution.Reason = Env, fmt.Sprintf("the %s environment variable", coreutils.ServerID)
+	case context != nil && context.ServerId != "":
+		serverId = context.ServerId
+		resolution.Source, resolution.Reason = Directory, context.describe()
+	default:
+		resolution.Source, resolution.Reason = Default, "the default server, set by 'jf c use'"
+	}
+	if context != nil && context.Project != "" && (resolution.Project == "" || context.appliedProject) {
+		resolution.Project = context.Project
+	}
+	if serverId == "" {
+		resolution.ServerDetails, err = config.GetDefaultServerConf()
+		if err != nil {
+			return nil, err
+		}
+		if resolution.ServerDetails == nil {
+			return nil, errorutils.CheckErrorf("no server is configured. Use the 'jf c add' command to configure one")
+		}
+		return resolution, nil
+	}
+	resolution.ServerDetails, err = config.GetSpecificConfig(serverId, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return resolution, nil
+}
+
+// Returns the context applied by the current process, or loads it if it wasn't applied.
+func getContext() (*Context, error) {
+	if applied != nil {
+		return applied, nil
+	}
+	return Load()
+}
+
+func (c *Context) describe() string {
+	if c.fromEnv {
+		return fmt.Sprintf("the context file %s, set by the %s environment variable", c.path, ContextEnv)
+	}
+	return "the context file " + c.path
+}
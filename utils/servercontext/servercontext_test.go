@@ -0,0 +1,129 @@
+package servercontext
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Creates two servers, with 'global' as the default, and a project tree whose context uses 'prod'.
+// The working directory is changed to a subdirectory of the tree.
+func setUp(t *testing.T) (projectDir string) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	t.Setenv(coreutils.ServerID, "")
+	t.Setenv(coreutils.Project, "")
+	t.Setenv(ContextEnv, "")
+	applied = nil
+	assert.NoError(t, config.SaveServersConf([]*config.ServerDetails{
+		{ServerId: "global", Url: "https://global.jfrog.io/", IsDefault: true},
+		{ServerId: "prod", Url: "https://prod.jfrog.io/"},
+	}))
+
+	projectDir = t.TempDir()
+	writeContext(t, projectDir, "serverId: prod\nproject: acme\n")
+	workingDir := filepath.Join(projectDir, "module", "src")
+	assert.NoError(t, os.MkdirAll(workingDir, 0755))
+	originalDir, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(workingDir))
+	t.Cleanup(func() {
+		assert.NoError(t, os.Chdir(originalDir))
+		applied = nil
+	})
+	return
+}
+
+func writeContext(t *testing.T, dir, content string) {
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, ".jfrog"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ".jfrog", contextFileName), []byte(content), 0644))
+}
+
+func TestLoad(t *testing.T) {
+	projectDir := setUp(t)
+	context, err := Load()
+	assert.NoError(t, err)
+	if assert.NotNil(t, context) {
+		assert.Equal(t, "prod", context.ServerId)
+		assert.Equal(t, "acme", context.Project)
+		assert.Equal(t, filepath.Join(projectDir, ".jfrog", contextFileName), context.Path())
+	}
+
+	// JFROG_CLI_CONTEXT may point to a directory or to a context file.
+	otherDir := t.TempDir()
+	writeContext(t, otherDir, "serverId: global\n")
+	t.Setenv(ContextEnv, otherDir)
+	context, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "global", context.ServerId)
+	t.Setenv(ContextEnv, filepath.Join(otherDir, ".jfrog", contextFileName))
+	context, err = Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "global", context.ServerId)
+
+	t.Setenv(ContextEnv, filepath.Join(otherDir, "missing.yaml"))
+	_, err = Load()
+	assert.ErrorContains(t, err, "does not exist")
+
+	t.Setenv(ContextEnv, "")
+	writeContext(t, projectDir, "server: prod\n")
+	_, err = Load()
+	assert.ErrorContains(t, err, "failed parsing the context file")
+}
+
+func TestLoadNoContext(t *testing.T) {
+	projectDir := setUp(t)
+	assert.NoError(t, os.Remove(filepath.Join(projectDir, ".jfrog", contextFileName)))
+	context, err := Load()
+	assert.NoError(t, err)
+	assert.Nil(t, context)
+}
+
+func TestApplyAndResolve(t *testing.T) {
+	setUp(t)
+	assert.NoError(t, Apply())
+	assert.Equal(t, "prod", os.Getenv(coreutils.ServerID))
+	assert.Equal(t, "acme", os.Getenv(coreutils.Project))
+
+	resolution, err := Resolve("")
+	assert.NoError(t, err)
+	assert.Equal(t, "prod", resolution.ServerDetails.ServerId)
+	assert.Equal(t, "acme", resolution.Project)
+	assert.Equal(t, Directory, resolution.Source)
+
+	resolution, err = Resolve("global")
+	assert.NoError(t, err)
+	assert.Equal(t, "global", resolution.ServerDetails.ServerId)
+	assert.Equal(t, Flag, resolution.Source)
+
+	_, err = Resolve("missing")
+	assert.Error(t, err)
+}
+
+func TestApplyKeepsEnv(t *testing.T) {
+	setUp(t)
+	t.Setenv(coreutils.ServerID, "global")
+	t.Setenv(coreutils.Project, "other")
+	assert.NoError(t, Apply())
+	assert.Equal(t, "global", os.Getenv(coreutils.ServerID))
+	assert.Equal(t, "other", os.Getenv(coreutils.Project))
+
+	resolution, err := Resolve("")
+	assert.NoError(t, err)
+	assert.Equal(t, "global", resolution.ServerDetails.ServerId)
+	assert.Equal(t, "other", resolution.Project)
+	assert.Equal(t, Env, resolution.Source)
+}
+
+func TestResolveDefault(t *testing.T) {
+	projectDir := setUp(t)
+	assert.NoError(t, os.Remove(filepath.Join(projectDir, ".jfrog", contextFileName)))
+	assert.NoError(t, Apply())
+	resolution, err := Resolve("")
+	assert.NoError(t, err)
+	assert.Equal(t, "global", resolution.ServerDetails.ServerId)
+	assert.Equal(t, Default, resolution.Source)
+}